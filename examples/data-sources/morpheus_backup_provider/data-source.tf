@@ -0,0 +1,3 @@
+data "morpheus_backup_provider" "tf_example_backup_provider" {
+  name = "Veeam"
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "morpheus_backup_restore_point" "tf_example_backup_restore_point" {
+  backup_id      = 1
+  created_before = "2026-10-01T00:00:00Z"
+}
\ No newline at end of file
@@ -0,0 +1,7 @@
+data "morpheus_backup_schedule" "tf_example_backup_schedule" {
+  name = "Daily Backup at 2AM"
+}
+
+data "morpheus_backup_schedule" "tf_example_default_backup_schedule" {
+  default = true
+}
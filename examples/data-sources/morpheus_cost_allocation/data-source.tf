@@ -0,0 +1,24 @@
+data "morpheus_cost_allocation" "october" {
+  period = "202510"
+
+  rule {
+    name       = "engineering"
+    tag_name   = "cost-center"
+    tag_values = ["eng", "platform"]
+  }
+
+  rule {
+    name       = "sales"
+    tag_name   = "cost-center"
+    tag_values = ["sales"]
+  }
+
+  rule {
+    name     = "other tagged"
+    tag_name = "cost-center"
+  }
+}
+
+output "untagged_cost" {
+  value = data.morpheus_cost_allocation.october.unallocated_cost
+}
\ No newline at end of file
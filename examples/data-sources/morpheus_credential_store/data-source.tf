@@ -0,0 +1,11 @@
+data "morpheus_credential_store" "tf_example_credential_store" {
+  name = "vault"
+}
+
+resource "morpheus_credential" "tf_example_credential" {
+  name     = "tf-example-credential"
+  type     = "username-password"
+  store_id = data.morpheus_credential_store.tf_example_credential_store.id
+  username = "admin"
+  password = "password123"
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "morpheus_cypher" "database_password" {
+  key = "password/15/dbpassword"
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "morpheus_network_floating_ip" "tf_example_network_floating_ip" {
+  ip_address = "203.0.113.10"
+}
\ No newline at end of file
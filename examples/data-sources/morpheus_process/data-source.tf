@@ -0,0 +1,9 @@
+data "morpheus_process" "provisioning" {
+  instance_id   = 12
+  process_type  = "provision"
+  started_after = "2024-01-01T00:00:00Z"
+}
+
+output "failed_tasks" {
+  value = [for event in flatten(data.morpheus_process.provisioning.processes[*].events) : event.display_name if event.status == "failed"]
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+data "morpheus_scale_threshold" "tf_example_scale_threshold" {
+  name = "tf_example_scale_threshold"
+}
+
+# Attach the shared scale threshold to several layouts, updating it
+# updates the scaling of every layout it is attached to
+resource "morpheus_instance_scale" "tf_example_layout_scale" {
+  for_each           = toset(["15", "16"])
+  layout_id          = each.value
+  scale_threshold_id = data.morpheus_scale_threshold.tf_example_scale_threshold.id
+}
\ No newline at end of file
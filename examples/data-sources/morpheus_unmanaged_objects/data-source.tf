@@ -0,0 +1,8 @@
+data "morpheus_unmanaged_objects" "ui_created_tasks" {
+  type  = "task"
+  label = "managed-by:terraform"
+}
+
+output "ui_created_tasks" {
+  value = data.morpheus_unmanaged_objects.ui_created_tasks.names
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+data "morpheus_whoami" "current" {
+  lifecycle {
+    postcondition {
+      condition     = self.username == "terraform-svc" && self.tenant_name == "Engineering"
+      error_message = "The provider must be authenticated as the terraform-svc service account of the Engineering tenant."
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "morpheus_wiki_pages" "runbooks" {
+  category              = "Runbooks"
+  include_subcategories = true
+}
\ No newline at end of file
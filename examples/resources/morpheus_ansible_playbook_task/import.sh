@@ -1 +1,4 @@
-terraform import morpheus_ansible_playbook_task.tf_example_ansible_playbook_task 1
\ No newline at end of file
+terraform import morpheus_ansible_playbook_task.tf_example_ansible_playbook_task 1
+
+# ansible playbook task created in another tenant, imported by <tenant_id>:<ansible_playbook_task_id>
+terraform import morpheus_ansible_playbook_task.tf_example_ansible_playbook_task 3:1
\ No newline at end of file
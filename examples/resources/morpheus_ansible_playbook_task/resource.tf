@@ -7,7 +7,8 @@ resource "morpheus_ansible_playbook_task" "ansplaybook" {
   playbook            = "mongo_install"
   tags                = "mongo"
   skip_tags           = "web"
-  command_options     = "-b"
+  become              = true
+  become_user         = "mongodb"
   execute_target      = "local"
   retryable           = true
   retry_count         = 1
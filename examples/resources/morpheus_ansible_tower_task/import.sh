@@ -1 +1,4 @@
-terraform import morpheus_ansible_tower_task.tf_example_ansible_tower_task 1
\ No newline at end of file
+terraform import morpheus_ansible_tower_task.tf_example_ansible_tower_task 1
+
+# ansible tower task created in another tenant, imported by <tenant_id>:<ansible_tower_task_id>
+terraform import morpheus_ansible_tower_task.tf_example_ansible_tower_task 3:1
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_app_blueprint_catalog_item.tf_example_app_blueprint_catalog_item 1
\ No newline at end of file
+terraform import morpheus_app_blueprint_catalog_item.tf_example_app_blueprint_catalog_item 1
+
+# catalog item created in another tenant, imported by <tenant_id>:<catalog_item_id>
+terraform import morpheus_app_blueprint_catalog_item.tf_example_app_blueprint_catalog_item 3:1
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_appliance_maintenance_mode.tf_example_maintenance_mode 1
\ No newline at end of file
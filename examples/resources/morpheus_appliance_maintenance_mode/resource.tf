@@ -0,0 +1,19 @@
+variable "maintenance_window" {
+  description = "Whether the maintenance window of the upgrade is open"
+  type        = bool
+  default     = false
+}
+
+resource "morpheus_appliance_maintenance_mode" "tf_example_maintenance_mode" {
+  count = var.maintenance_window ? 1 : 0
+}
+
+resource "morpheus_motd_policy" "tf_example_maintenance_banner" {
+  count        = var.maintenance_window ? 1 : 0
+  name         = "maintenance banner"
+  description  = "Banner shown during the maintenance window"
+  enabled      = true
+  title        = "Scheduled maintenance"
+  message      = "The appliance is being upgraded, provisioning is unavailable until the end of the maintenance window."
+  type         = "warning"
+}
\ No newline at end of file
@@ -17,5 +17,8 @@ resource "morpheus_aws_cloud" "tf_example_aws_cloud" {
   datacenter_id              = "tfawsdemo"
   guidance                   = "manual"
   costing                    = "full"
+  costing_report_name        = "morpheus-cur"
+  costing_bucket             = "example-billing-reports"
+  costing_bucket_region      = "us-east-1"
   agent_install_mode         = "cloudInit"
 }
\ No newline at end of file
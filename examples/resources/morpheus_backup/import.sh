@@ -0,0 +1 @@
+terraform import morpheus_backup.tf_example_backup 1
\ No newline at end of file
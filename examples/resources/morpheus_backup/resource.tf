@@ -0,0 +1,15 @@
+data "morpheus_storage_bucket" "backups" {
+  name = "Backups"
+}
+
+data "morpheus_backup_schedule" "nightly" {
+  name = "Nightly"
+}
+
+resource "morpheus_backup" "tf_example_backup" {
+  name              = "tf-example-backup"
+  instance_id       = 1
+  schedule_id       = data.morpheus_backup_schedule.nightly.id
+  retention_count   = 7
+  storage_bucket_id = data.morpheus_storage_bucket.backups.id
+}
\ No newline at end of file
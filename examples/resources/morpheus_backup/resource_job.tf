@@ -0,0 +1,11 @@
+resource "morpheus_backup_job" "nightly" {
+  name            = "nightly"
+  retention_count = 7
+  schedule_id     = 2
+}
+
+resource "morpheus_backup" "tf_example_backup_job" {
+  name      = "tf-example-backup-job"
+  server_id = 1
+  job_id    = morpheus_backup_job.nightly.id
+}
\ No newline at end of file
@@ -0,0 +1,28 @@
+data "morpheus_storage_bucket" "backups" {
+  name = "Backups"
+}
+
+data "morpheus_backup_schedule" "nightly" {
+  name = "Nightly"
+}
+
+resource "morpheus_backup_setting" "tf_example_backup_setting" {
+  scheduled_backups                = true
+  create_backups                   = true
+  backup_appliance                 = false
+  default_backup_storage_bucket_id = data.morpheus_storage_bucket.backups.id
+  default_backup_schedule_id       = data.morpheus_backup_schedule.nightly.id
+  retention_days                   = 21
+}
+
+resource "morpheus_backup_creation_policy" "tf_example_backup_creation_policy_cloud" {
+  name             = "tf_example_backup_creation_policy_cloud"
+  description      = "terraform example cloud backup creation policy"
+  enabled          = true
+  enforcement_type = "fixed"
+  create_backup    = true
+  scope            = "cloud"
+  cloud_id         = 1
+
+  depends_on = [morpheus_backup_setting.tf_example_backup_setting]
+}
\ No newline at end of file
@@ -1,10 +1,10 @@
 resource "morpheus_backup_creation_policy" "tf_example_backup_creation_policy_role" {
-  name             = "tf_example_backup_creation_policy_role"
-  description      = "tfvsphere"
-  enabled          = true
-  enforcement_type = "fixed"
-  create_backup    = true
-  scope            = "role"
-  role_id          = 1
-  apply_each_user  = true
+  name               = "tf_example_backup_creation_policy_role"
+  description        = "tfvsphere"
+  enabled            = true
+  enforcement_type   = "fixed"
+  create_backup      = true
+  scope              = "role"
+  role_id            = 1
+  apply_to_each_user = true
 }
\ No newline at end of file
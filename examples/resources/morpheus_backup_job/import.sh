@@ -0,0 +1 @@
+terraform import morpheus_backup_job.tf_example_backup_job 1
\ No newline at end of file
@@ -0,0 +1,9 @@
+data "morpheus_backup_schedule" "nightly" {
+  name = "Nightly"
+}
+
+resource "morpheus_backup_job" "tf_example_backup_job" {
+  name            = "tf-example-backup-job"
+  retention_count = 7
+  schedule_id     = data.morpheus_backup_schedule.nightly.id
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_backup_provider.tf_example_backup_provider 1
\ No newline at end of file
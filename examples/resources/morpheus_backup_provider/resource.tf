@@ -0,0 +1,14 @@
+variable "veeam_password" {
+  description = "The password of the Veeam backup server"
+  type        = string
+  sensitive   = true
+}
+
+resource "morpheus_backup_provider" "tf_example_backup_provider_veeam" {
+  name     = "veeam"
+  type     = "veeam"
+  enabled  = true
+  url      = "https://veeam.example.com:9398"
+  username = "DOMAIN\\morpheus"
+  password = var.veeam_password
+}
\ No newline at end of file
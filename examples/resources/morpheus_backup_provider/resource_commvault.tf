@@ -0,0 +1,14 @@
+variable "commvault_password" {
+  description = "The password of the Commvault CommServe"
+  type        = string
+  sensitive   = true
+}
+
+resource "morpheus_backup_provider" "tf_example_backup_provider_commvault" {
+  name     = "commvault"
+  type     = "commvault"
+  enabled  = true
+  url      = "https://commvault.example.com/webconsole/api"
+  username = "morpheus"
+  password = var.commvault_password
+}
\ No newline at end of file
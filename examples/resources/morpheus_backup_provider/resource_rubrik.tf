@@ -0,0 +1,14 @@
+variable "rubrik_password" {
+  description = "The password of the Rubrik cluster"
+  type        = string
+  sensitive   = true
+}
+
+resource "morpheus_backup_provider" "tf_example_backup_provider_rubrik" {
+  name     = "rubrik"
+  type     = "rubrik"
+  enabled  = true
+  url      = "https://rubrik.example.com"
+  username = "morpheus"
+  password = var.rubrik_password
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_budget.tf_example_budget 1
\ No newline at end of file
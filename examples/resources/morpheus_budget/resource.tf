@@ -0,0 +1,12 @@
+resource "morpheus_budget" "tf_example_budget" {
+  name          = "tf_example_budget"
+  description   = "Quarterly budget of the production group"
+  enabled       = true
+  scope         = "group"
+  group_id      = 1
+  year          = "2026"
+  interval      = "quarter"
+  costs         = [25000, 25000, 30000, 30000]
+  warning_limit = 80
+  over_limit    = 100
+}
\ No newline at end of file
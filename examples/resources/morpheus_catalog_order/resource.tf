@@ -0,0 +1,12 @@
+data "morpheus_catalog_item_type" "example_catalog_item" {
+  name = "Ubuntu Web Server"
+}
+
+resource "morpheus_catalog_order" "tf_example_catalog_order" {
+  catalog_item_type_id = data.morpheus_catalog_item_type.example_catalog_item.id
+  config = {
+    "hostname" = "web01"
+    "size"     = "small"
+  }
+  wait_for_completion = true
+}
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_chef_bootstrap_task.tf_example_chef_bootstrap_task 1
\ No newline at end of file
+terraform import morpheus_chef_bootstrap_task.tf_example_chef_bootstrap_task 1
+
+# chef bootstrap task created in another tenant, imported by <tenant_id>:<chef_bootstrap_task_id>
+terraform import morpheus_chef_bootstrap_task.tf_example_chef_bootstrap_task 3:1
\ No newline at end of file
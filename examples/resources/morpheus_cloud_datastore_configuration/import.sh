@@ -0,0 +1 @@
+terraform import morpheus_cloud_datastore_configuration.tf_example_datastore 2:15
\ No newline at end of file
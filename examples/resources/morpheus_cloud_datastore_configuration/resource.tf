@@ -0,0 +1,20 @@
+data "morpheus_cloud_datastore" "tf_example_datastore" {
+  cloud_id = 2
+  name     = "Example_Datastore"
+}
+
+resource "morpheus_cloud_datastore_configuration" "tf_example_datastore" {
+  cloud_id          = 2
+  datastore_id      = data.morpheus_cloud_datastore.tf_example_datastore.id
+  active            = true
+  visibility        = "public"
+  group_access_all  = false
+  group_access_ids  = [1]
+  group_default_ids = [2]
+
+  tenant_access {
+    id            = 1
+    default_store = true
+    image_target  = false
+  }
+}
\ No newline at end of file
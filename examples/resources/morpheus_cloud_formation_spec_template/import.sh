@@ -1 +1,4 @@
-terraform import morpheus_cloud_formation_spec_template.tf_example_cloud_formation_spec_template 1
\ No newline at end of file
+terraform import morpheus_cloud_formation_spec_template.tf_example_cloud_formation_spec_template 1
+
+# cloud formation spec template created in another tenant, imported by <tenant_id>:<cloud_formation_spec_template_id>
+terraform import morpheus_cloud_formation_spec_template.tf_example_cloud_formation_spec_template 3:1
\ No newline at end of file
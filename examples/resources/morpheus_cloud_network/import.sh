@@ -0,0 +1 @@
+terraform import morpheus_cloud_network.tf_example_cloud_network 1
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "morpheus_cloud_network" "tf_example_cloud_network" {
+  cloud_id         = 1
+  name             = "VM Network"
+  active           = true
+  dhcp_server      = false
+  pool_id          = 3
+  domain_id        = 2
+  visibility       = "private"
+  group_access_ids = [1, 2]
+  tenant_ids       = [1]
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_cloud_resource_pool.tf_example_resource_pool 2:15
\ No newline at end of file
@@ -0,0 +1,16 @@
+data "morpheus_resource_pool" "tf_example_resource_pool" {
+  cloud_id = 2
+  name     = "Example_VPC"
+}
+
+resource "morpheus_cloud_resource_pool" "tf_example_resource_pool" {
+  cloud_id           = 2
+  resource_pool_id   = data.morpheus_resource_pool.tf_example_resource_pool.id
+  active             = true
+  visibility         = "private"
+  group_access_all   = false
+  group_access_ids   = [1]
+  group_default_ids  = [2]
+  tenant_ids         = [1]
+  availability_zones = ["us-east-1a", "us-east-1b"]
+}
\ No newline at end of file
@@ -7,5 +7,5 @@ resource "morpheus_cluster_resource_name_policy" "tf_example_cluster_resource_na
   auto_resolve_conflicts = true
   scope                  = "role"
   role_id                = 1
-  apply_each_user        = true
+  apply_to_each_user     = true
 }
\ No newline at end of file
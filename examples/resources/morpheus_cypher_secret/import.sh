@@ -1 +1 @@
-terraform import morpheus_cypher_secret.tf_example_cypher_secret 1
\ No newline at end of file
+terraform import morpheus_cypher_secret.tf_example_cypher_secret apipassword
\ No newline at end of file
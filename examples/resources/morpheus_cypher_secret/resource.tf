@@ -2,4 +2,8 @@ resource "morpheus_cypher_secret" "tf_example_cypher_secret" {
   key   = "apipassword"
   value = "password123"
   ttl   = 86400
+
+  keepers = {
+    rotation = "2024-01"
+  }
 }
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "morpheus_cypher_secret" "tf_example_cypher_password" {
+  mount = "password"
+  key   = "dbpassword"
+}
\ No newline at end of file
@@ -0,0 +1,12 @@
+variable "api_token" {
+  description = "The API token stored in the cypher secret"
+  type        = string
+  sensitive   = true
+}
+
+resource "morpheus_cypher_secret" "tf_example_cypher_secret_write_only" {
+  key              = "apitoken"
+  value_wo         = var.api_token
+  value_wo_version = 1
+  ttl              = 86400
+}
\ No newline at end of file
@@ -1 +1 @@
-terraform import morpheus_cypher_tfvars.tf_example_cypher_tfvars 1
\ No newline at end of file
+terraform import morpheus_cypher_tfvars.tf_example_cypher_tfvars securetfvars
\ No newline at end of file
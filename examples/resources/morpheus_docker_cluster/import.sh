@@ -0,0 +1 @@
+terraform import morpheus_docker_cluster.tf_example_docker_cluster 1
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "morpheus_docker_cluster" "tf_example_docker_cluster" {
+  name              = "tfexample-docker"
+  description       = "Terraform example docker cluster"
+  group_id          = 1
+  cloud_id          = 2
+  cluster_layout_id = 120
+  plan_id           = 15
+  host_count        = 3
+  network_id        = 4
+  wait_for_ready    = true
+}
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_email_task.tf_example_email_task 1
\ No newline at end of file
+terraform import morpheus_email_task.tf_example_email_task 1
+
+# email task created in another tenant, imported by <tenant_id>:<email_task_id>
+terraform import morpheus_email_task.tf_example_email_task 3:1
\ No newline at end of file
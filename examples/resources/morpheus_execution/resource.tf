@@ -0,0 +1,14 @@
+data "morpheus_workflow" "example_workflow" {
+  name = "Deploy app"
+}
+
+resource "morpheus_execution" "tf_example_execution" {
+  workflow_id = data.morpheus_workflow.example_workflow.id
+  instance_id = 1
+  custom_options = {
+    "version" = "1.2.3"
+  }
+  triggers = {
+    "version" = "1.2.3"
+  }
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_expiration_policy.tf_example_expiration_policy 1
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "morpheus_expiration_policy" "tf_example_expiration_policy_cloud" {
+  name                       = "tf_example_expiration_policy_cloud"
+  description                = "terraform example cloud expiration policy"
+  enabled                    = true
+  enforcement_type           = "user"
+  expiration_days            = 30
+  extension_days             = 15
+  notification_days          = 7
+  notification_message       = "The instance reaches its expiration date in 7 days"
+  auto_approve_extensions    = false
+  extensions_before_approval = 2
+  scope                      = "cloud"
+  cloud_id                   = 1
+}
\ No newline at end of file
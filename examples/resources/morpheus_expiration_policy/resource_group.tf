@@ -0,0 +1,14 @@
+resource "morpheus_expiration_policy" "tf_example_expiration_policy_group" {
+  name                       = "tf_example_expiration_policy_group"
+  description                = "terraform example group expiration policy"
+  enabled                    = true
+  enforcement_type           = "user"
+  expiration_days            = 30
+  extension_days             = 15
+  notification_days          = 7
+  notification_message       = "The instance reaches its expiration date in 7 days"
+  auto_approve_extensions    = false
+  extensions_before_approval = 2
+  scope                      = "group"
+  group_id                   = 1
+}
\ No newline at end of file
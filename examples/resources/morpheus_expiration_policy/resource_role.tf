@@ -0,0 +1,15 @@
+resource "morpheus_expiration_policy" "tf_example_expiration_policy_role" {
+  name                       = "tf_example_expiration_policy_role"
+  description                = "terraform example role expiration policy"
+  enabled                    = true
+  enforcement_type           = "user"
+  expiration_days            = 30
+  extension_days             = 15
+  notification_days          = 7
+  notification_message       = "The instance reaches its expiration date in 7 days"
+  auto_approve_extensions    = false
+  extensions_before_approval = 2
+  scope                      = "role"
+  role_id                    = 1
+  apply_to_each_user         = true
+}
\ No newline at end of file
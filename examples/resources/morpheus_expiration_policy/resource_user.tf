@@ -0,0 +1,14 @@
+resource "morpheus_expiration_policy" "tf_example_expiration_policy_user" {
+  name                       = "tf_example_expiration_policy_user"
+  description                = "terraform example user expiration policy"
+  enabled                    = true
+  enforcement_type           = "user"
+  expiration_days            = 30
+  extension_days             = 15
+  notification_days          = 7
+  notification_message       = "The instance reaches its expiration date in 7 days"
+  auto_approve_extensions    = false
+  extensions_before_approval = 2
+  scope                      = "user"
+  user_id                    = 1
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_external_kubernetes_cluster.tf_example_external_kubernetes_cluster 1
\ No newline at end of file
@@ -0,0 +1,15 @@
+variable "service_token" {
+  description = "The token of the service account of the cluster"
+  type        = string
+  sensitive   = true
+}
+
+resource "morpheus_external_kubernetes_cluster" "tf_example_external_kubernetes_cluster" {
+  name              = "tfexample-external-cluster"
+  description       = "Terraform example external kubernetes cluster"
+  group_id          = 1
+  cloud_id          = 2
+  cluster_layout_id = 120
+  api_url           = "https://k8s.example.com:6443"
+  service_token     = var.service_token
+}
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_file_template.tf_example_file_template 1
\ No newline at end of file
+terraform import morpheus_file_template.tf_example_file_template 1
+
+# file template created in another tenant, imported by <tenant_id>:<file_template_id>
+terraform import morpheus_file_template.tf_example_file_template 3:1
\ No newline at end of file
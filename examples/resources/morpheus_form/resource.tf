@@ -61,21 +61,21 @@ resource "morpheus_form" "tf_example_form" {
   }
 
   option_type {
-    name                       = "tf checkbox example"
-    code                       = "checkbox-input"
-    description                = "Terraform checkbox example"
-    type                       = "checkbox"
-    field_label                = "checkbox input"
-    field_name                 = "checkboxInput"
-    default_chedefault_checked = true
-    placeholder                = "Testing 123"
-    help_block                 = "Is this working now"
-    required                   = true
-    export_meta                = true
-    display_value_on_details   = true
-    locked                     = true
-    hidden                     = true
-    exclude_from_search        = true
+    name                     = "tf checkbox example"
+    code                     = "checkbox-input"
+    description              = "Terraform checkbox example"
+    type                     = "checkbox"
+    field_label              = "checkbox input"
+    field_name               = "checkboxInput"
+    default_checked          = true
+    placeholder              = "Testing 123"
+    help_block               = "Is this working now"
+    required                 = true
+    export_meta              = true
+    display_value_on_details = true
+    locked                   = true
+    hidden                   = true
+    exclude_from_search      = true
   }
 
   option_type {
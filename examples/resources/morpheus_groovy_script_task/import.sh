@@ -1 +1,4 @@
-terraform import morpheus_groovy_script_task.tf_example_groovy_script 1
\ No newline at end of file
+terraform import morpheus_groovy_script_task.tf_example_groovy_script 1
+
+# groovy script task created in another tenant, imported by <tenant_id>:<groovy_script_task_id>
+terraform import morpheus_groovy_script_task.tf_example_groovy_script 3:1
\ No newline at end of file
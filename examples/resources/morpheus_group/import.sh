@@ -1 +1,4 @@
-terraform import morpheus_group.tf_example_group 1
\ No newline at end of file
+terraform import morpheus_group.tf_example_group 1
+
+# group created in another tenant, imported by <tenant_id>:<group_id>
+terraform import morpheus_group.tf_example_group 3:1
\ No newline at end of file
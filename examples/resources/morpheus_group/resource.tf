@@ -1,6 +1,8 @@
 resource "morpheus_group" "tf_example_group" {
-  name      = "tfgroup"
-  code      = "tfgroup"
-  location  = "denver"
-  cloud_ids = [1]
+  name                 = "tfgroup"
+  code                 = "tfgroup"
+  location             = "denver"
+  cloud_ids            = [1]
+  dns_integration_id   = 3
+  config_management_id = 5
 }
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_helm_spec_template.tf_example_helm_spec_template 1
\ No newline at end of file
+terraform import morpheus_helm_spec_template.tf_example_helm_spec_template 1
+
+# helm spec template created in another tenant, imported by <tenant_id>:<helm_spec_template_id>
+terraform import morpheus_helm_spec_template.tf_example_helm_spec_template 3:1
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_hidden_option_type.tf_example_hidden_option_type 1
\ No newline at end of file
+terraform import morpheus_hidden_option_type.tf_example_hidden_option_type 1
+
+# hidden option type created in another tenant, imported by <tenant_id>:<hidden_option_type_id>
+terraform import morpheus_hidden_option_type.tf_example_hidden_option_type 3:1
\ No newline at end of file
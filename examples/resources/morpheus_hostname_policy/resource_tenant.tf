@@ -0,0 +1,10 @@
+resource "morpheus_hostname_policy" "tf_example_hostname_policy_tenant" {
+  name               = "tf_example_hostname_policy_tenant"
+  description        = "terraform example tenant hostname policy"
+  enabled            = true
+  enforcement_type   = "fixed"
+  naming_pattern     = "$${userInitials.toLowerCase()}dm$${type.take(3).toLowerCase()}$${sequence+1000}"
+  scope              = "tenant"
+  tenant_id          = 2
+  apply_to_each_user = true
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_image_build.tf_example_image_build 1
\ No newline at end of file
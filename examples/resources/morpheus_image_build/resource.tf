@@ -0,0 +1,33 @@
+variable "build_password" {
+  description = "The password of the user the image build connects as"
+  type        = string
+  sensitive   = true
+}
+
+resource "morpheus_image_build" "tf_example_image_build" {
+  name                  = "tfexample-ubuntu-golden"
+  description           = "Terraform example image build"
+  image_build_type      = "vmware"
+  group_id              = 1
+  cloud_id              = 2
+  plan_id               = 15
+  source_image_id       = 120
+  network_id            = 4
+  boot_script_id        = 3
+  preseed_script_id     = 2
+  ssh_username          = "ubuntu"
+  ssh_password          = var.build_password
+  build_output_name     = "ubuntu-golden"
+  conversion_formats    = "ovf,qcow2"
+  is_cloud_init         = true
+  keep_results          = 3
+  execution_schedule_id = 1
+
+  build_triggers = {
+    preseed_version = "2"
+  }
+}
+
+output "latest_image_id" {
+  value = morpheus_image_build.tf_example_image_build.latest_image_id
+}
\ No newline at end of file
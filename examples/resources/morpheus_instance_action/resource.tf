@@ -0,0 +1,20 @@
+resource "morpheus_instance_action" "tf_example_instance_restart" {
+  instance_id           = 12
+  action                = "restart"
+  allow_disruptive      = true
+  confirm_instance_name = "tfexample-web-01"
+
+  triggers = {
+    patch_level = "2024-06"
+  }
+}
+
+resource "morpheus_instance_action" "tf_example_instance_workflow" {
+  instance_id = 12
+  action      = "run_workflow"
+  workflow_id = 5
+
+  custom_options = {
+    environment = "production"
+  }
+}
\ No newline at end of file
@@ -1,11 +1,23 @@
+data "morpheus_instance_type" "tf_example_instance_type" {
+  name = "todo_app"
+}
+
+data "morpheus_node_type" "ubuntu_base" {
+  name = "ubuntu_base"
+}
+
+data "morpheus_workflow" "tfexample_workflow" {
+  name = "tfexample_workflow"
+}
+
 resource "morpheus_instance_layout" "tf_example_instance_layout" {
-  instance_type_id = morpheus_instance_type.tf_example_instance_type.id
+  instance_type_id = data.morpheus_instance_type.tf_example_instance_type.id
   name             = "todo_app_frontend"
   labels           = ["demo", "layout", "terraform"]
   version          = "1.0"
   technology       = "vmware"
   node_type_ids = [
-    morpheus_node_type.ubuntu_base.id
+    data.morpheus_node_type.ubuntu_base.id
   ]
-  workflow_id = morpheus_provisioning_workflow.tfexample_workflow.id
+  workflow_id = data.morpheus_workflow.tfexample_workflow.id
 }
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "morpheus_instance_name_policy" "tf_example_instance_name_policy_tenant" {
+  name                   = "tf_example_instance_name_policy_tenant"
+  description            = "terraform example tenant instance name policy"
+  enabled                = true
+  enforcement_type       = "fixed"
+  naming_pattern         = "$${userInitials.toLowerCase()}dm$${type.take(3).toLowerCase()}$${sequence+1000}"
+  auto_resolve_conflicts = true
+  scope                  = "tenant"
+  tenant_id              = 2
+  apply_to_each_user     = true
+}
\ No newline at end of file
@@ -0,0 +1,2 @@
+terraform import morpheus_instance_scale.tf_example_instance_scale 12
+terraform import morpheus_instance_scale.tf_example_layout_scale layout:15
\ No newline at end of file
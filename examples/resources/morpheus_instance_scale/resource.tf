@@ -0,0 +1,31 @@
+data "morpheus_scale_threshold" "web_autoscale" {
+  name = "Web Autoscale"
+}
+
+# Use the settings of a shared scale threshold and override the node counts
+resource "morpheus_instance_scale" "tf_example_instance_scale" {
+  instance_id        = 12
+  scale_threshold_id = data.morpheus_scale_threshold.web_autoscale.id
+  min_count          = 2
+  max_count          = 6
+}
+
+resource "morpheus_instance_scale" "tf_example_custom_instance_scale" {
+  instance_id             = 13
+  auto_upscale            = true
+  auto_downscale          = true
+  min_count               = 1
+  max_count               = 4
+  enable_cpu_threshold    = true
+  min_cpu_percentage      = 20
+  max_cpu_percentage      = 80
+  enable_memory_threshold = true
+  min_memory_percentage   = 25
+  max_memory_percentage   = 85
+}
+
+# Configure the scaling of every instance provisioned with a layout
+resource "morpheus_instance_scale" "tf_example_layout_scale" {
+  layout_id          = 15
+  scale_threshold_id = data.morpheus_scale_threshold.web_autoscale.id
+}
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "morpheus_integration" "tf_example_integration" {
+  name    = "bluecat"
+  type    = "bluecat"
+  enabled = true
+  url     = "https://bluecat.example.com"
+
+  config = {
+    inventoryExisting = "on"
+    extraAttributes   = jsonencode({ zone = "example.com" })
+  }
+
+  credential {
+    username = "admin"
+    password = "password123"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "morpheus_ipv4_ip_pool" "tf_example_ipv4_pool_network" {
+  name            = "Terraform Example application pool"
+  netmask         = "255.255.255.0"
+  gateway         = "10.20.120.1"
+  dns_servers     = ["10.20.0.10", "10.20.0.11"]
+  dns_domain      = "app.example.com"
+  dns_search_path = "app.example.com,example.com"
+
+  ip_range {
+    starting_address = "10.20.120.50"
+    ending_address   = "10.20.120.200"
+  }
+}
+
+resource "morpheus_cloud_network" "tf_example_cloud_network" {
+  cloud_id = 1
+  name     = "VM Network"
+  pool_id  = morpheus_ipv4_ip_pool.tf_example_ipv4_pool_network.id
+}
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_javascript_task.tf_example_groovy_script 1
\ No newline at end of file
+terraform import morpheus_javascript_task.tf_example_groovy_script 1
+
+# javascript task created in another tenant, imported by <tenant_id>:<javascript_task_id>
+terraform import morpheus_javascript_task.tf_example_groovy_script 3:1
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_kubernetes_cluster.tf_example_kubernetes_cluster 1
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "morpheus_kubernetes_cluster" "tf_example_kubernetes_cluster" {
+  name              = "tfexample-mks"
+  description       = "Terraform example kubernetes cluster"
+  group_id          = 1
+  cloud_id          = 2
+  cluster_layout_id = 230
+  plan_id           = 15
+  worker_plan_id    = 16
+  worker_count      = 3
+  network_id        = 4
+  pod_cidr          = "172.20.0.0/16"
+  service_cidr      = "172.30.0.0/16"
+  wait_for_ready    = true
+}
+
+output "kubeconfig" {
+  value     = morpheus_kubernetes_cluster.tf_example_kubernetes_cluster.kubeconfig
+  sensitive = true
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_kvm_cluster.tf_example_kvm_cluster 1
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "morpheus_kvm_cluster" "tf_example_kvm_cluster" {
+  name              = "tfexample-kvm"
+  description       = "Terraform example kvm cluster"
+  group_id          = 1
+  cloud_id          = 2
+  cluster_layout_id = 140
+  plan_id           = 18
+  host_count        = 3
+  network_id        = 4
+  resource_pool_id  = 5
+  wait_for_ready    = true
+}
\ No newline at end of file
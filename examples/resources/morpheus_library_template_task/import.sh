@@ -1 +1,4 @@
-terraform import morpheus_library_template_task.tf_example_library_template_task 1
\ No newline at end of file
+terraform import morpheus_library_template_task.tf_example_library_template_task 1
+
+# library template task created in another tenant, imported by <tenant_id>:<library_template_task_id>
+terraform import morpheus_library_template_task.tf_example_library_template_task 3:1
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "morpheus_max_cores_policy" "tf_example_max_cores_policy_tenant" {
+  name               = "tf_example_max_cores_policy_tenant"
+  description        = "Terraform example Morpheus max cores policy"
+  enabled            = true
+  max_cores          = 35
+  scope              = "tenant"
+  tenant_id          = 2
+  apply_to_each_user = true
+}
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "morpheus_max_memory_policy" "tf_example_max_memory_policy_tenant" {
+  name               = "tf_example_max_memory_policy_tenant"
+  description        = "terraform example tenant max memory policy"
+  enabled            = true
+  max_memory         = 256
+  scope              = "tenant"
+  tenant_id          = 2
+  apply_to_each_user = true
+}
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "morpheus_max_vms_policy" "tf_example_max_vms_policy_tenant" {
+  name               = "tf_example_max_vms_policy_tenant"
+  description        = "Terraform example Morpheus max vms policy"
+  enabled            = true
+  max_vms            = 35
+  scope              = "tenant"
+  tenant_id          = 2
+  apply_to_each_user = true
+}
\ No newline at end of file
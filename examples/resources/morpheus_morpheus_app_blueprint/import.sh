@@ -0,0 +1 @@
+terraform import morpheus_morpheus_app_blueprint.tf_example_morpheus_app_blueprint 1
\ No newline at end of file
@@ -0,0 +1,29 @@
+resource "morpheus_morpheus_app_blueprint" "tf_example_morpheus_app_blueprint" {
+  name        = "morpheusappblueprint"
+  description = "tf example morpheus app blueprint"
+  category    = "web"
+
+  tier {
+    name         = "Web"
+    boot_order   = 1
+    linked_tiers = ["Database"]
+
+    instance {
+      instance_type_code = "nginx"
+      layout_id          = 1
+      plan_id            = 10
+    }
+  }
+
+  tier {
+    name       = "Database"
+    boot_order = 0
+
+    instance {
+      name               = "$${app.name}-db"
+      instance_type_code = "mysql"
+      layout_id          = 2
+      plan_id            = 10
+    }
+  }
+}
\ No newline at end of file
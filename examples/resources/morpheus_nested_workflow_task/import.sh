@@ -1 +1,4 @@
-terraform import morpheus_nested_workflow_task.tf_example_nested_workflow_task 1
\ No newline at end of file
+terraform import morpheus_nested_workflow_task.tf_example_nested_workflow_task 1
+
+# nested workflow task created in another tenant, imported by <tenant_id>:<nested_workflow_task_id>
+terraform import morpheus_nested_workflow_task.tf_example_nested_workflow_task 3:1
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_network.tf_example_network 1
\ No newline at end of file
@@ -0,0 +1,22 @@
+resource "morpheus_network" "tf_example_network" {
+  name                       = "tf-example-port-group"
+  description                = "Application port group"
+  cloud_id                   = 1
+  type                       = "vmwareDistributedPortGroup"
+  vlan_id                    = 120
+  cidr                       = "10.20.120.0/24"
+  gateway                    = "10.20.120.1"
+  dns_primary                = "10.20.0.10"
+  dns_secondary              = "10.20.0.11"
+  dhcp_server                = false
+  pool_id                    = 3
+  domain_id                  = 2
+  appliance_url_proxy_bypass = true
+  visibility                 = "private"
+  group_access_ids           = [1, 2]
+  tenant_ids                 = [1]
+
+  config                     = {
+    switchName = "dvs-prod"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "morpheus_network" "tf_example_amazon_subnet" {
+  name              = "tf-example-subnet-a"
+  cloud_id          = 2
+  type              = "amazonSubnet"
+  resource_pool_id  = 12
+  availability_zone = "us-east-1a"
+  cidr              = "10.30.1.0/24"
+  dhcp_server       = true
+  group_access_all  = true
+}
\ No newline at end of file
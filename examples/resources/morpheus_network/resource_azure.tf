@@ -0,0 +1,9 @@
+resource "morpheus_network" "tf_example_azure_subnet" {
+  name             = "tf-example-subnet-app"
+  cloud_id         = 3
+  type             = "azureSubnet"
+  resource_pool_id = 24
+  cidr             = "10.40.2.0/24"
+  dhcp_server      = true
+  group_access_ids = [1]
+}
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "morpheus_network" "tf_example_openstack_network" {
+  name         = "tf-example-private"
+  cloud_id     = 4
+  type         = "openstackPrivateNetwork"
+  cidr         = "192.168.50.0/24"
+  gateway      = "192.168.50.1"
+  dns_primary  = "192.168.50.2"
+  dhcp_server  = true
+  scan_network = true
+  no_proxy     = "192.168.50.0/24,.internal"
+  tenant_ids   = [1, 4]
+}
\ No newline at end of file
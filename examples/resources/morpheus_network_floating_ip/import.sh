@@ -0,0 +1 @@
+terraform import morpheus_network_floating_ip.tf_example_network_floating_ip 12
\ No newline at end of file
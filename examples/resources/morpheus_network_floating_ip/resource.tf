@@ -0,0 +1,4 @@
+resource "morpheus_network_floating_ip" "tf_example_network_floating_ip" {
+  cloud_id = 2
+  pool_id  = 5
+}
\ No newline at end of file
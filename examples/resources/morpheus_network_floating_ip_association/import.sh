@@ -0,0 +1 @@
+terraform import morpheus_network_floating_ip_association.tf_example_network_floating_ip_association 12
\ No newline at end of file
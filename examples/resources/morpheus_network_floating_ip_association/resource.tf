@@ -0,0 +1,8 @@
+resource "morpheus_network_floating_ip" "tf_example_network_floating_ip" {
+  cloud_id = 2
+}
+
+resource "morpheus_network_floating_ip_association" "tf_example_network_floating_ip_association" {
+  floating_ip_id = morpheus_network_floating_ip.tf_example_network_floating_ip.id
+  server_id      = 12
+}
\ No newline at end of file
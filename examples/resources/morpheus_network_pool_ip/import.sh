@@ -0,0 +1 @@
+terraform import morpheus_network_pool_ip.tf_example_network_pool_ip 1:25
\ No newline at end of file
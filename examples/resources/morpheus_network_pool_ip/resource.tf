@@ -0,0 +1,6 @@
+resource "morpheus_network_pool_ip" "tf_example_network_pool_ip" {
+  pool_id     = 1
+  ip_address  = "10.20.120.50"
+  hostname    = "app01"
+  description = "Static address of the application server"
+}
\ No newline at end of file
@@ -0,0 +1,20 @@
+resource "morpheus_ipv4_ip_pool" "tf_example_ipv4_pool" {
+  name = "Terraform Example IPv4 IP pool"
+  ip_range {
+    starting_address = "192.168.1.1"
+    ending_address   = "192.168.1.10"
+  }
+  ip_range {
+    starting_address = "10.0.0.1"
+    ending_address   = "10.0.0.10"
+  }
+}
+
+resource "morpheus_network_pool_ip" "tf_example_next_free_ip" {
+  pool_id  = morpheus_ipv4_ip_pool.tf_example_ipv4_pool.id
+  hostname = "db01"
+}
+
+output "db01_ip_address" {
+  value = morpheus_network_pool_ip.tf_example_next_free_ip.ip_address
+}
\ No newline at end of file
@@ -2,6 +2,18 @@ data "morpheus_virtual_image" "example_virtual_image" {
   name = "Ubuntu 20.04 Template"
 }
 
+data "morpheus_file_template" "tfexample" {
+  name = "tf-terraform-file-template"
+}
+
+data "morpheus_script_template" "tfscript1" {
+  name = "tf-terraform-script-template"
+}
+
+data "morpheus_script_template" "tfscript2" {
+  name = "tf-terraform-script-template-2"
+}
+
 resource "morpheus_node_type" "tf_example_node" {
   name             = "tf_example_node_type"
   short_name       = "tfexamplenodetype"
@@ -0,0 +1,22 @@
+resource "morpheus_nutanix_cloud" "tf_example_nutanix_cloud" {
+  name                       = "tf_example_nutanix_cloud"
+  code                       = "tfnutanix"
+  location                   = "denver"
+  visibility                 = "private"
+  enabled                    = true
+  automatically_power_on_vms = true
+  api_url                    = "https://prism.morpheus.local:9440"
+  username                   = "admin"
+  password                   = "password"
+  cluster                    = "all"
+  import_existing_vms        = true
+  inventory_level            = "basic"
+  sync_networks              = true
+  sync_images                = false
+  enable_hypervisor_console  = true
+  appliance_url              = "https://demo.morpheusdata.com"
+  time_zone                  = "America/Denver"
+  guidance                   = "manual"
+  costing                    = "costing"
+  agent_install_mode         = "cloudInit"
+}
\ No newline at end of file
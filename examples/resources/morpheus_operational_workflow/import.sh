@@ -1 +1,4 @@
-terraform import morpheus_operational_workflow.tf_example_operational_workflow 1
\ No newline at end of file
+terraform import morpheus_operational_workflow.tf_example_operational_workflow 1
+
+# operational workflow created in another tenant, imported by <tenant_id>:<operational_workflow_id>
+terraform import morpheus_operational_workflow.tf_example_operational_workflow 3:1
\ No newline at end of file
@@ -8,5 +8,11 @@ resource "morpheus_operational_workflow" "tf_example_operational_workflow" {
   option_types = [
     1730
   ]
-  task_ids = [18]
+  task_ids = [18, 19]
+
+  task_failure {
+    task_id             = 18
+    allow_retry         = true
+    failure_workflow_id = 4
+  }
 }
\ No newline at end of file
@@ -0,0 +1,8 @@
+resource "morpheus_personal_access_token" "tf_example_personal_access_token" {
+  user_id       = 12
+  client_id     = "morph-cli"
+  renewal_hours = 72
+  keepers = {
+    rotation = "2026-Q4"
+  }
+}
\ No newline at end of file
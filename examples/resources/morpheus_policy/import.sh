@@ -0,0 +1 @@
+terraform import morpheus_policy.tf_example_policy 1
\ No newline at end of file
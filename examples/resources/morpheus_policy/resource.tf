@@ -0,0 +1,15 @@
+resource "morpheus_policy" "tf_example_policy" {
+  name        = "tf_example_policy"
+  description = "Limit the number of load balancer pools per group"
+  enabled     = true
+  policy_type = "maxPools"
+
+  config = jsonencode({
+    maxPools = "10"
+  })
+
+  scope {
+    type = "group"
+    id   = 1
+  }
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_power_schedule.tf_example_power_schedule 1
\ No newline at end of file
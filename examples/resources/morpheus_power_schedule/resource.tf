@@ -0,0 +1,37 @@
+resource "morpheus_power_schedule" "tf_example_power_schedule" {
+  name          = "Business hours"
+  description   = "Powers the instances on during the business hours Mountain Time"
+  enabled       = true
+  schedule_type = "power_on"
+  time_zone     = "America/Denver"
+
+  window {
+    day   = "monday"
+    start = "07:00"
+    end   = "19:00"
+  }
+
+  window {
+    day   = "tuesday"
+    start = "07:00"
+    end   = "19:00"
+  }
+
+  window {
+    day   = "wednesday"
+    start = "07:00"
+    end   = "19:00"
+  }
+
+  window {
+    day   = "thursday"
+    start = "07:00"
+    end   = "19:00"
+  }
+
+  window {
+    day   = "friday"
+    start = "07:00"
+    end   = "17:30"
+  }
+}
\ No newline at end of file
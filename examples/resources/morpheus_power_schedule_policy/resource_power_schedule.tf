@@ -0,0 +1,27 @@
+resource "morpheus_power_schedule" "business_hours" {
+  name      = "Business hours"
+  time_zone = "America/Denver"
+
+  window {
+    day   = "monday"
+    start = "07:00"
+    end   = "19:00"
+  }
+
+  window {
+    day   = "friday"
+    start = "07:00"
+    end   = "17:30"
+  }
+}
+
+resource "morpheus_power_schedule_policy" "tf_example_power_schedule_policy_schedule" {
+  name                         = "tf_example_power_schedule_policy_schedule"
+  description                  = "terraform example group power schedule policy"
+  enabled                      = true
+  enforcement_type             = "fixed"
+  power_schedule_id            = morpheus_power_schedule.business_hours.id
+  hide_power_schedule_if_fixed = true
+  scope                        = "group"
+  group_id                     = 1
+}
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_powershell_script_task.tf_example_powershell_task_script 1
\ No newline at end of file
+terraform import morpheus_powershell_script_task.tf_example_powershell_task_script 1
+
+# powershell script task created in another tenant, imported by <tenant_id>:<powershell_script_task_id>
+terraform import morpheus_powershell_script_task.tf_example_powershell_task_script 3:1
\ No newline at end of file
@@ -7,6 +7,10 @@ data "morpheus_resource_pool" "morpheus_pool" {
   cloud_id = data.morpheus_cloud.vspherecloud.id
 }
 
+data "morpheus_price" "tf_example_price" {
+  name = "tf_example_price"
+}
+
 resource "morpheus_price_set" "tf_example_price_set_software" {
   name             = "terraform-test-everything"
   code             = "terraform-test-everything"
@@ -15,5 +19,5 @@ resource "morpheus_price_set" "tf_example_price_set_software" {
   resource_pool_id = data.morpheus_resource_pool.morpheus_pool.id
   price_unit       = "minute"
   type             = "fixed"
-  price_ids        = [morpheus_price.tf_example_price.id]
+  price_ids        = [data.morpheus_price.tf_example_price.id]
 }
\ No newline at end of file
@@ -0,0 +1,18 @@
+data "morpheus_integration" "servicenow_prod" {
+  name = "SNOW Production"
+}
+
+data "morpheus_servicenow_workflow" "morpheus_example" {
+  name           = "Requested Item"
+  integration_id = data.morpheus_integration.servicenow_prod.id
+}
+
+resource "morpheus_provision_approval_policy" "tf_example_provision_approval_policy_tenant" {
+  name           = "tf_example_provision_approval_policy_tenant"
+  description    = "terraform example tenant provision approval policy"
+  enabled        = true
+  integration_id = data.morpheus_integration.servicenow_prod.id
+  workflow_id    = data.morpheus_servicenow_workflow.morpheus_example.id
+  scope          = "tenant"
+  tenant_id      = 2
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_provisioning_license.tf_example_provisioning_license 1
\ No newline at end of file
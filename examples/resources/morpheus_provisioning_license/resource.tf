@@ -0,0 +1,22 @@
+variable "windows_license_key" {
+  description = "The Windows license key"
+  type        = string
+  sensitive   = true
+}
+
+data "morpheus_virtual_image" "windows_2022" {
+  name = "Windows Server 2022"
+}
+
+resource "morpheus_provisioning_license" "tf_example_provisioning_license" {
+  name              = "tf_example_provisioning_license"
+  description       = "Windows Server 2022 KMS key"
+  license_type_id   = 1
+  license_key       = var.windows_license_key
+  org_name          = "Example Org"
+  full_name         = "Example Admin"
+  license_version   = "2022"
+  copies            = 100
+  virtual_image_ids = [data.morpheus_virtual_image.windows_2022.id]
+  tenant_ids        = [1]
+}
\ No newline at end of file
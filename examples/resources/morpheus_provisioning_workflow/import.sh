@@ -1 +1,4 @@
-terraform import morpheus_provisioning_workflow.tf_example_provisioning_workflow 1
\ No newline at end of file
+terraform import morpheus_provisioning_workflow.tf_example_provisioning_workflow 1
+
+# provisioning workflow created in another tenant, imported by <tenant_id>:<provisioning_workflow_id>
+terraform import morpheus_provisioning_workflow.tf_example_provisioning_workflow 3:1
\ No newline at end of file
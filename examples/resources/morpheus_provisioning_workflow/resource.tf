@@ -8,4 +8,11 @@ resource "morpheus_provisioning_workflow" "tf_example_provisioning_workflow" {
     task_id    = 18
     task_phase = "configure"
   }
+  task {
+    task_id             = 19
+    task_phase          = "postProvision"
+    continue_on_failure = true
+    allow_retry         = true
+    failure_workflow_id = 4
+  }
 }
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_checkbox_option_type.tf_example_checkbox_option_type 1
\ No newline at end of file
+terraform import morpheus_checkbox_option_type.tf_example_checkbox_option_type 1
+
+# python script task created in another tenant, imported by <tenant_id>:<python_script_task_id>
+terraform import morpheus_checkbox_option_type.tf_example_checkbox_option_type 3:1
\ No newline at end of file
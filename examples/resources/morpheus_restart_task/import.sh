@@ -1 +1,4 @@
-terraform import morpheus_restart_task.tf_example_restart_task_script 1
\ No newline at end of file
+terraform import morpheus_restart_task.tf_example_restart_task_script 1
+
+# restart task created in another tenant, imported by <tenant_id>:<restart_task_id>
+terraform import morpheus_restart_task.tf_example_restart_task_script 3:1
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_ruby_script_task.tf_example_ruby_task_script 1
\ No newline at end of file
+terraform import morpheus_ruby_script_task.tf_example_ruby_task_script 1
+
+# ruby script task created in another tenant, imported by <tenant_id>:<ruby_script_task_id>
+terraform import morpheus_ruby_script_task.tf_example_ruby_task_script 3:1
\ No newline at end of file
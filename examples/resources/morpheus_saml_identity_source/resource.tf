@@ -3,7 +3,7 @@ data "morpheus_tenant" "demo_tenant" {
 }
 
 resource "morpheus_saml_identity_source" "addemo" {
-  tenant_id                      = morpheus_tenant.demo_tenant.id
+  tenant_id                      = data.morpheus_tenant.demo_tenant.id
   name                           = "samldemo"
   description                    = "TF example SAML identity source"
   login_redirect_url             = "https://tfexamplesaml.test.local:8443/realms/master/protocol/saml"
@@ -4,6 +4,7 @@ resource "morpheus_scale_threshold" "tf_example_scale_threshold" {
   auto_downscale          = true
   min_count               = 1
   max_count               = 3
+  scale_increment         = 1
   enable_cpu_threshold    = true
   min_cpu_percentage      = 30.0
   max_cpu_percentage      = 75.0
@@ -1 +1,4 @@
-terraform import morpheus_script_template.tf_example_script_template 1
\ No newline at end of file
+terraform import morpheus_script_template.tf_example_script_template 1
+
+# script template created in another tenant, imported by <tenant_id>:<script_template_id>
+terraform import morpheus_script_template.tf_example_script_template 3:1
\ No newline at end of file
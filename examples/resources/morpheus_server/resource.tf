@@ -0,0 +1,19 @@
+variable "ssh_password" {
+  description = "The password of the user the agent is installed as"
+  type        = string
+  sensitive   = true
+}
+
+resource "morpheus_server" "tf_example_server" {
+  server_id         = 42
+  name              = "tfexample-bare-metal-01"
+  description       = "Bare metal host managed by terraform"
+  labels            = ["bare-metal", "production"]
+  managed           = true
+  install_agent     = true
+  group_id          = 3
+  server_os_id      = 12
+  ssh_username      = "morpheus"
+  ssh_password      = var.ssh_password
+  power_schedule_id = 2
+}
\ No newline at end of file
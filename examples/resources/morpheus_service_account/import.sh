@@ -0,0 +1 @@
+terraform import morpheus_service_account.tf_example_service_account 1
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "morpheus_service_account" "tf_example_service_account" {
+  username      = "ci-pipeline"
+  email         = "platform-team@test.local"
+  role_ids      = [19]
+  renewal_hours = 24
+  keepers = {
+    rotation = "2026-Q4"
+  }
+}
+
+output "ci_pipeline_access_token" {
+  value     = morpheus_service_account.tf_example_service_account.access_token
+  sensitive = true
+}
\ No newline at end of file
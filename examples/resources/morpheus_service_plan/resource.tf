@@ -1,3 +1,7 @@
+data "morpheus_price_set" "tf_example_price_set_software" {
+  name = "terraform-test-everything"
+}
+
 resource "morpheus_service_plan" "tf_example_service_plan" {
   name           = "terraform-test-sp"
   code           = "terraform-test-sp1"
@@ -29,7 +33,7 @@ resource "morpheus_service_plan" "tf_example_service_plan" {
     maximum = 5000
   }
 
-  price_set_ids = [morpheus_price_set.tf_example_price_set_software.id,
+  price_set_ids = [data.morpheus_price_set.tf_example_price_set_software.id,
     203,
     645,
   202]
@@ -0,0 +1 @@
+terraform import morpheus_shutdown_policy.tf_example_shutdown_policy 1
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "morpheus_shutdown_policy" "tf_example_shutdown_policy_group" {
+  name                       = "tf_example_shutdown_policy_group"
+  description                = "terraform example group shutdown policy"
+  enabled                    = true
+  enforcement_type           = "user"
+  shutdown_days              = 30
+  extension_days             = 15
+  notification_days          = 7
+  notification_message       = "The instance reaches its shutdown date in 7 days"
+  auto_approve_extensions    = false
+  extensions_before_approval = 2
+  scope                      = "group"
+  group_id                   = 1
+}
\ No newline at end of file
@@ -0,0 +1,15 @@
+resource "morpheus_shutdown_policy" "tf_example_shutdown_policy_role" {
+  name                       = "tf_example_shutdown_policy_role"
+  description                = "terraform example role shutdown policy"
+  enabled                    = true
+  enforcement_type           = "user"
+  shutdown_days              = 30
+  extension_days             = 15
+  notification_days          = 7
+  notification_message       = "The instance reaches its shutdown date in 7 days"
+  auto_approve_extensions    = false
+  extensions_before_approval = 2
+  scope                      = "role"
+  role_id                    = 1
+  apply_to_each_user         = true
+}
\ No newline at end of file
@@ -0,0 +1,21 @@
+resource "morpheus_manual_option_list" "cost_centers" {
+  name        = "cost_centers"
+  description = "The allowed values of the cost_center tag"
+  dataset     = <<POLICY
+[{"name": "Engineering","value":"engineering"},
+ {"name": "Marketing","value":"marketing"},
+ {"name": "Sales","value":"sales"}
+]
+POLICY
+}
+
+resource "morpheus_tag_policy" "tf_example_tag_policy_allowed_values" {
+  name               = "tf_example_tag_policy_allowed_values"
+  description        = "terraform example cloud tag policy with allowed values"
+  enabled            = true
+  strict_enforcement = true
+  tag_key            = "cost_center"
+  option_list_id     = morpheus_manual_option_list.cost_centers.id
+  scope              = "cloud"
+  cloud_id           = 1
+}
\ No newline at end of file
@@ -12,7 +12,7 @@ resource "morpheus_task_job" "tf_example_task_job_schedule" {
   labels                = ["aws", "demo"]
   task_id               = data.morpheus_task.example_task.id
   schedule_mode         = "scheduled"
-  execution_schedule_id = data.morpheus_execute_schedule.jobtest.id
+  execution_schedule_id = data.morpheus_execute_schedule.example_schedule.id
   context_type          = "instance"
   instance_ids          = [91]
   custom_config         = "{\"test\":\"new\"}"
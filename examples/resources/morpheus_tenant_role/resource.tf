@@ -52,7 +52,7 @@ data "morpheus_permission_set" "base_permission_set" {
   }
 
   cloud_permission {
-    id     = data.morpheus_group.demo.id
+    id     = data.morpheus_cloud.demo.id
     access = "full"
   }
 
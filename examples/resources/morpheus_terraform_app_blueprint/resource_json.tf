@@ -9,5 +9,4 @@ EOF
   terraform_version = "1.1.1"
   terraform_options = "-var 'foo=bar'"
   tfvar_secret      = "tfvars/rdsdemo-secrets"
-  visibility        = "public"
 }
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_terraform_spec_template.tfexample_terraform_spec_template 1
\ No newline at end of file
+terraform import morpheus_terraform_spec_template.tfexample_terraform_spec_template 1
+
+# terraform spec template created in another tenant, imported by <tenant_id>:<terraform_spec_template_id>
+terraform import morpheus_terraform_spec_template.tfexample_terraform_spec_template 3:1
\ No newline at end of file
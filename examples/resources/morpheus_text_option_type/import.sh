@@ -1 +1,4 @@
-terraform import morpheus_text_option_type.tf_example_text_option_type 1
\ No newline at end of file
+terraform import morpheus_text_option_type.tf_example_text_option_type 1
+
+# text option type created in another tenant, imported by <tenant_id>:<text_option_type_id>
+terraform import morpheus_text_option_type.tf_example_text_option_type 3:1
\ No newline at end of file
@@ -6,6 +6,7 @@ resource "morpheus_user" "tf_example_user" {
   password              = "PmWFEAE#92331"
   password_expired      = true
   role_ids              = [19, 10]
+  default_persona       = "serviceCatalog"
   receive_notifications = true
   linux_username        = "testuser"
   linux_password        = "PmWFEAE#92331"
@@ -1,10 +1,10 @@
 resource "morpheus_user_creation_policy" "tf_example_user_creation_policy_role" {
-  name             = "tf_example_user_creation_policy_role"
-  description      = "terraform example role user creation policy"
-  enabled          = true
-  enforcement_type = "fixed"
-  create_user      = true
-  scope            = "role"
-  role_id          = 1
-  apply_each_user  = true
+  name               = "tf_example_user_creation_policy_role"
+  description        = "terraform example role user creation policy"
+  enabled            = true
+  enforcement_type   = "fixed"
+  create_user        = true
+  scope              = "role"
+  role_id            = 1
+  apply_to_each_user = true
 }
\ No newline at end of file
@@ -3,6 +3,7 @@ resource "morpheus_user_role" "tfexample_resource_user_role" {
   description        = "Terraform provider example user role"
   multitenant_role   = false
   multitenant_locked = false
+  landing_url        = "/provisioning/catalog"
   permission_set     = data.morpheus_permission_set.base_permission_set.json
 }
 
@@ -0,0 +1 @@
+terraform import morpheus_vcd_cloud.tf_example_vcd_cloud 1
\ No newline at end of file
@@ -0,0 +1,24 @@
+resource "morpheus_vcd_cloud" "tf_example_vcd_cloud" {
+  name                      = "tf_example_vcd_cloud"
+  code                      = "tfvcd"
+  location                  = "denver"
+  visibility                = "public"
+  enabled                   = true
+  api_url                   = "https://vcd.morpheus.local"
+  username                  = "administrator@morpheus"
+  password                  = "password"
+  api_version               = "37.0"
+  org_id                    = "morpheus"
+  vdc_id                    = "morpheus-vdc"
+  catalog                   = "morpheus-catalog"
+  default_storage_profile   = "standard"
+  import_existing_vms       = true
+  inventory_level           = "basic"
+  enable_hypervisor_console = true
+  keyboard_layout           = "us"
+  appliance_url             = "https://demo.morpheusdata.com"
+  time_zone                 = "America/Denver"
+  guidance                  = "manual"
+  costing                   = "costing"
+  agent_install_mode        = "cloudInit"
+}
\ No newline at end of file
@@ -0,0 +1,29 @@
+variable "image_password" {
+  description = "The password of the user of the image"
+  type        = string
+  sensitive   = true
+}
+
+resource "morpheus_virtual_image" "tf_example_virtual_image" {
+  name          = "tfexample-ubuntu-22"
+  labels        = ["demo", "terraform"]
+  image_type    = "qcow2"
+  url           = "https://cloud-images.ubuntu.com/jammy/current/jammy-server-cloudimg-amd64.img"
+  file_name     = "jammy-server-cloudimg-amd64.qcow2"
+  os_type_id    = 72
+  is_cloud_init = true
+  install_agent = true
+  ssh_username  = "ubuntu"
+  ssh_password  = var.image_password
+  visibility    = "public"
+  tenant_ids    = [1]
+}
+
+resource "morpheus_virtual_image" "tf_example_virtual_image_local" {
+  name          = "tfexample-centos-9"
+  image_type    = "qcow2"
+  file_path     = "${path.module}/images/centos-9.qcow2"
+  is_cloud_init = true
+  ssh_username  = "cloud-user"
+  ssh_key       = file("~/.ssh/id_rsa")
+}
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_vro_task.tf_example_vro_task 1
\ No newline at end of file
+terraform import morpheus_vro_task.tf_example_vro_task 1
+
+# vro task created in another tenant, imported by <tenant_id>:<vro_task_id>
+terraform import morpheus_vro_task.tf_example_vro_task 3:1
\ No newline at end of file
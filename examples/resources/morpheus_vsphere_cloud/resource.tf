@@ -20,6 +20,8 @@ resource "morpheus_vsphere_cloud" "tf_example_vsphere_cloud" {
   rpc_mode                                = "guestexec"
   hide_host_selection                     = true
   import_existing_vms                     = true
+  inventory_level                         = "basic"
+  sync_interval                           = 600
   enable_hypervisor_console               = true
   keyboard_layout                         = "us"
   enable_disk_type_selection              = true
@@ -3,17 +3,17 @@ resource "morpheus_vsphere_cloud_datastore_configuration" "tf_example_datastore"
   name             = "Example_Datastore"
   active           = true
   group_access_all = true
-  group_access     = [1, 2]
+  group_access_ids = [1, 2]
   visibility       = "public"
   tenant_access {
-    id           = 1
-    default      = true
-    image_target = false
+    id            = 1
+    default_store = true
+    image_target  = false
   }
 
   tenant_access {
-    id           = 2
-    default      = true
-    image_target = true
+    id            = 2
+    default_store = true
+    image_target  = true
   }
 }
\ No newline at end of file
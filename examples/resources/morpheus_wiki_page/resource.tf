@@ -2,4 +2,10 @@ resource "morpheus_wiki_page" "tfexample_wiki_page" {
   name     = "tfexample_wiki_page"
   category = "morpheus-terraform"
   content  = file("${path.module}/terraform-wiki.md")
+}
+
+resource "morpheus_wiki_page" "tfexample_runbook_page" {
+  name          = "tfexample_database_restore"
+  category_path = ["Runbooks", "Databases"]
+  content       = file("${path.module}/terraform-wiki.md")
 }
\ No newline at end of file
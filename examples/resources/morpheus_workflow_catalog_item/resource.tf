@@ -13,4 +13,31 @@ resource "morpheus_workflow_catalog_item" "tfexample_workflow_catalog_item" {
   context_type         = "appliance"
   content              = file("${path.module}/catalog-data.md")
   visibility           = "public"
+
+  option_type {
+    name           = "tfexample_workflow_catalog_item_environment"
+    field_name     = "environment"
+    field_label    = "Environment"
+    type           = "select"
+    option_list_id = 12
+    required       = true
+  }
+}
+
+data "morpheus_tenants" "customers" {
+  filter {
+    name   = "name"
+    values = ["Customer*"]
+  }
+}
+
+resource "morpheus_workflow_catalog_item" "tfexample_tenant_workflow_catalog_item" {
+  for_each     = toset(data.morpheus_tenants.customers.ids)
+  tenant_id    = each.value
+  name         = "tfexample_workflow_catalog_item"
+  description  = "Example Terraform workflow catalog item created in each customer tenant"
+  enabled      = true
+  workflow_id  = 1
+  context_type = "appliance"
+  visibility   = "private"
 }
\ No newline at end of file
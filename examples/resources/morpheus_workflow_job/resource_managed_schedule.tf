@@ -0,0 +1,22 @@
+data "morpheus_workflow" "example_workflow" {
+  name = "Deploy app"
+}
+
+resource "morpheus_execute_schedule" "weeknights" {
+  name      = "Weeknights at 10 PM"
+  time_zone = "America/Denver"
+  schedule  = "0 22 * * 1-5"
+}
+
+resource "morpheus_workflow_job" "tf_example_workflow_job_managed_schedule" {
+  name                  = "TF Example Workflow Job Managed Schedule"
+  enabled               = true
+  workflow_id           = data.morpheus_workflow.example_workflow.id
+  schedule_mode         = "scheduled"
+  execution_schedule_id = morpheus_execute_schedule.weeknights.id
+  context_type          = "server-label"
+  server_label          = "nightly"
+  custom_config = jsonencode({
+    environment = "staging"
+  })
+}
\ No newline at end of file
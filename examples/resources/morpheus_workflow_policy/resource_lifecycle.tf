@@ -0,0 +1,23 @@
+resource "morpheus_provisioning_workflow" "compliance" {
+  name        = "compliance"
+  description = "Registers the instances in the CMDB when provisioned and unregisters them when deleted"
+  platform    = "all"
+  visibility  = "private"
+  task {
+    task_id    = 18
+    task_phase = "postProvision"
+  }
+  task {
+    task_id    = 19
+    task_phase = "teardown"
+  }
+}
+
+resource "morpheus_workflow_policy" "tf_example_workflow_policy_lifecycle" {
+  name        = "tf_example_workflow_policy_lifecycle"
+  description = "terraform example group workflow policy"
+  enabled     = true
+  workflow_id = morpheus_provisioning_workflow.compliance.id
+  scope       = "group"
+  group_id    = 1
+}
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import morpheus_write_attributes.tfexample_write_attributes 1
\ No newline at end of file
+terraform import morpheus_write_attributes.tfexample_write_attributes 1
+
+# write attributes task created in another tenant, imported by <tenant_id>:<write_attributes_task_id>
+terraform import morpheus_write_attributes.tfexample_write_attributes 3:1
\ No newline at end of file
@@ -11,9 +11,11 @@ import (
 )
 
 func main() {
+	provider := morpheus.Provider()
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
-			return morpheus.Provider()
+			return provider
 		},
 	})
+	morpheus.WriteAPISummary(provider)
 }
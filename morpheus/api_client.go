@@ -0,0 +1,35 @@
+package morpheus
+
+import (
+	"github.com/gomorpheus/morpheus-go-sdk"
+)
+
+//go:generate go run ../tools/gen-api-client
+
+// apiClient is the client of the appliance used by the resources and data
+// sources. The sdk client builds a new HTTP client for every request and has
+// no hook on the requests, the api calls of the sdk are wrapped instead so
+// the response of every call is recorded in the api summary of the provider.
+// The wrappers are generated in api_client_gen.go, run go generate after an
+// upgrade of the sdk.
+type apiClient struct {
+	*morpheus.Client
+
+	// summary collects the api usage, nil when no summary file is configured
+	summary *apiSummary
+}
+
+// record records the response of an api call in the summary of the client
+func (c *apiClient) record(resp *morpheus.Response, err error) (*morpheus.Response, error) {
+	if c.summary != nil {
+		c.summary.record(resp)
+	}
+	return resp, err
+}
+
+// recordRetry records a request sent again because the object was locked
+func (c *apiClient) recordRetry() {
+	if c.summary != nil {
+		c.summary.recordRetry()
+	}
+}
@@ -0,0 +1,158 @@
+package morpheus
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiSummary collects the responses received by the client of a provider
+// instance, aggregated by endpoint
+type apiSummary struct {
+	mu          sync.Mutex
+	file        string
+	url         string
+	succeeded   int
+	failed      int
+	retries     int
+	rateLimited int
+	locked      int
+	endpoints   map[string]*apiEndpointStats
+}
+
+type apiEndpointStats struct {
+	name  string
+	count int
+	total time.Duration
+	max   time.Duration
+}
+
+func newAPISummary(file string, applianceUrl string) *apiSummary {
+	return &apiSummary{
+		file:      file,
+		url:       applianceUrl,
+		endpoints: make(map[string]*apiEndpointStats),
+	}
+}
+
+// record records the response of an api call, the method, path and duration
+// of the request being read from the response itself since the requests of
+// the resources run in parallel
+func (s *apiSummary) record(resp *morpheus.Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if resp == nil || !resp.Success {
+		s.failed++
+	} else {
+		s.succeeded++
+	}
+	if resp == nil {
+		return
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		s.rateLimited++
+	case http.StatusConflict, http.StatusLocked:
+		s.locked++
+	}
+
+	// the request is not available when it failed before being sent
+	if resp.RestyResponse == nil || resp.RestyResponse.Request == nil {
+		return
+	}
+	duration := resp.RestyResponse.Time()
+	key := apiEndpoint(resp.RestyResponse.Request.Method, resp.RestyResponse.Request.URL)
+	stats, ok := s.endpoints[key]
+	if !ok {
+		stats = &apiEndpointStats{name: key}
+		s.endpoints[key] = stats
+	}
+	stats.count++
+	stats.total += duration
+	if duration > stats.max {
+		stats.max = duration
+	}
+}
+
+// recordRetry records a request sent again because the object was locked
+func (s *apiSummary) recordRetry() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retries++
+}
+
+// apiEndpoint returns the endpoint a request is sent to, with the ids of
+// the objects in its path replaced so the requests to the same endpoint
+// are aggregated
+func apiEndpoint(method string, requestUrl string) string {
+	path := requestUrl
+	if u, err := url.Parse(requestUrl); err == nil {
+		path = u.Path
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if _, err := strconv.ParseInt(segment, 10, 64); err == nil {
+			segments[i] = ":id"
+		}
+	}
+	return fmt.Sprintf("%s %s", method, strings.Join(segments, "/"))
+}
+
+// format returns the summary, the caller must hold the lock
+func (s *apiSummary) format() string {
+	var endpoints []*apiEndpointStats
+	for _, stats := range s.endpoints {
+		endpoints = append(endpoints, stats)
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].total != endpoints[j].total {
+			return endpoints[i].total > endpoints[j].total
+		}
+		return endpoints[i].name < endpoints[j].name
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Morpheus API summary for %s\n", s.url)
+	fmt.Fprintf(&b, "API calls: %d (%d succeeded, %d failed)\n", s.succeeded+s.failed, s.succeeded, s.failed)
+	fmt.Fprintf(&b, "Retries of the requests rejected because the object was locked: %d\n", s.retries)
+	fmt.Fprintf(&b, "Requests rejected because the object was locked: %d\n", s.locked)
+	fmt.Fprintf(&b, "Rate limit hits: %d\n", s.rateLimited)
+	fmt.Fprintf(&b, "Endpoints:\n")
+	for _, stats := range endpoints {
+		fmt.Fprintf(&b, "  %s: %d calls, total %s, max %s, average %s\n", stats.name, stats.count, stats.total.Round(time.Millisecond), stats.max.Round(time.Millisecond), (stats.total / time.Duration(stats.count)).Round(time.Millisecond))
+	}
+	return b.String()
+}
+
+func (s *apiSummary) write() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	summary := s.format()
+	log.Printf("[INFO] %s", summary)
+	return os.WriteFile(s.file, []byte(summary), 0644)
+}
+
+// WriteAPISummary writes the summary of the API usage of a provider to its
+// summary file, if one is configured. Terraform does not notify the
+// provider at the end of a run, it is called once the provider is stopped.
+func WriteAPISummary(provider *schema.Provider) {
+	m, ok := provider.Meta().(*providerMeta)
+	if !ok || m.client.summary == nil {
+		return
+	}
+	if err := m.client.summary.write(); err != nil {
+		log.Printf("unable to write the api summary to %s: %s", m.client.summary.file, err)
+	}
+}
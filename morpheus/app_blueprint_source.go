@@ -0,0 +1,69 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// appBlueprintSourceCustomizeDiff checks at plan time that the attributes
+// required by the source type of a template based app blueprint are set, the
+// content for the inline source types and the git integration and repository
+// for the repository source type, the working path defaulting to the repository root
+func appBlueprintSourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_type") {
+		return nil
+	}
+	sourceType := d.Get("source_type").(string)
+
+	var required []string
+	if sourceType == "repository" {
+		required = []string{"integration_id", "repository_id"}
+	} else {
+		required = []string{"blueprint_content"}
+	}
+
+	var missing []string
+	for _, key := range required {
+		if _, ok := d.GetOk(key); !ok && d.NewValueKnown(key) {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the %s source type requires %s", sourceType, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// appBlueprintGit is the git config of an app blueprint pulled from a
+// repository. Unlike the file of the tasks and spec templates, it references
+// the repository through its git integration, the branch holding the
+// version_ref and the path the working_path.
+type appBlueprintGit struct {
+	Path          string `json:"path"`
+	RepoId        int    `json:"repoId"`
+	IntegrationId int    `json:"integrationId"`
+	Branch        string `json:"branch"`
+}
+
+// appBlueprintGitPayload builds the git config of the payload from the
+// integration_id, repository_id, version_ref and working_path attributes
+func appBlueprintGitPayload(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"integrationId": d.Get("integration_id"),
+		"repoId":        d.Get("repository_id"),
+		"branch":        d.Get("version_ref").(string),
+		"path":          d.Get("working_path").(string),
+	}
+}
+
+// setAppBlueprintGit stores the git config of the response in the
+// attributes the payload is built from
+func setAppBlueprintGit(d *schema.ResourceData, git appBlueprintGit) {
+	d.Set("working_path", git.Path)
+	d.Set("integration_id", git.IntegrationId)
+	d.Set("repository_id", git.RepoId)
+	d.Set("version_ref", git.Branch)
+}
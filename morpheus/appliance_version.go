@@ -0,0 +1,98 @@
+package morpheus
+
+import (
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+)
+
+// applianceVersion is a parsed appliance build version such as 8.0.5
+type applianceVersion []int
+
+func parseApplianceVersion(version string) applianceVersion {
+	var parsed applianceVersion
+	for _, part := range strings.FieldsFunc(version, func(r rune) bool { return r == '.' || r == '-' }) {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		parsed = append(parsed, number)
+	}
+	return parsed
+}
+
+// atLeast returns whether the version is the same as or newer than the given one
+func (v applianceVersion) atLeast(version string) bool {
+	other := parseApplianceVersion(version)
+	for i := 0; i < len(v) || i < len(other); i++ {
+		var a, b int
+		if i < len(v) {
+			a = v[i]
+		}
+		if i < len(other) {
+			b = other[i]
+		}
+		if a != b {
+			return a > b
+		}
+	}
+	return true
+}
+
+func (v applianceVersion) String() string {
+	parts := make([]string, len(v))
+	for i, number := range v {
+		parts[i] = strconv.Itoa(number)
+	}
+	return strings.Join(parts, ".")
+}
+
+// detectApplianceVersion returns the build version of the appliance the
+// client is connected to, nil when unknown. The version is used to check the
+// version constraints of the resources at plan time and to send the cluster
+// worker payload of older appliances, the other payloads are built for the
+// current api whatever the version. The detection is best effort, the
+// constraints are not checked when the version is unknown.
+func detectApplianceVersion(client *apiClient) applianceVersion {
+	resp, err := client.Whoami()
+	if err != nil {
+		log.Printf("unable to detect the appliance version: %s - %s", resp, err)
+		return nil
+	}
+	result, ok := resp.Result.(*morpheus.WhoamiResult)
+	if !ok {
+		return nil
+	}
+	version := parseApplianceVersion(result.Appliance.BuildVersion)
+	if len(version) == 0 {
+		log.Printf("unable to parse the appliance version %q", result.Appliance.BuildVersion)
+		return nil
+	}
+	log.Printf("detected appliance version %s", version)
+	return version
+}
+
+// getApplianceVersion returns the detected version of the appliance
+func getApplianceVersion(meta interface{}) (applianceVersion, bool) {
+	if m, ok := meta.(*providerMeta); ok && m.version != nil {
+		return m.version, true
+	}
+	return nil, false
+}
+
+// clusterWorkerPayload returns the server payload of the worker nodes added
+// to a cluster. The appliances before 8.0.5 require an empty server network,
+// which is also sent when the version is unknown.
+func clusterWorkerPayload(meta interface{}, payload map[string]interface{}) map[string]interface{} {
+	if version, known := getApplianceVersion(meta); known && version.atLeast("8.0.5") {
+		return payload
+	}
+	if _, ok := payload["server"]; !ok {
+		payload["server"] = map[string]interface{}{
+			"network": map[string]interface{}{},
+		}
+	}
+	return payload
+}
@@ -0,0 +1,117 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// applianceTarget is the appliance and tenant a provider instance manages objects in
+type applianceTarget struct {
+	Url    string
+	Tenant string
+
+	// CheckUrl and CheckTenant are whether the expected_appliance_url and
+	// expected_tenant arguments of the provider are set, the target recorded
+	// by the resources only being checked against the expected ones
+	CheckUrl    bool
+	CheckTenant bool
+}
+
+func getApplianceTarget(meta interface{}) (applianceTarget, bool) {
+	if m, ok := meta.(*providerMeta); ok {
+		return m.target, true
+	}
+	return applianceTarget{}, false
+}
+
+// applianceTenant returns the name of the tenant of the authenticated user
+func applianceTenant(client *apiClient) (string, error) {
+	resp, err := client.Whoami()
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return "", err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	tenant := ""
+	if data, ok := resp.JsonData.(map[string]interface{}); ok {
+		if user, ok := data["user"].(map[string]interface{}); ok {
+			if account, ok := user["account"].(map[string]interface{}); ok {
+				tenant, _ = account["name"].(string)
+			}
+		}
+	}
+	return tenant, nil
+}
+
+// appliedTargetSchema returns the attributes recording the appliance and
+// tenant an object was applied to
+func appliedTargetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"applied_appliance_url": {
+			Type:        schema.TypeString,
+			Description: "The URL of the appliance the object was applied to, the provider fails when it targets another appliance and expected_appliance_url is set",
+			Computed:    true,
+		},
+		"applied_tenant": {
+			Type:        schema.TypeString,
+			Description: "The name of the tenant the object was applied to, the provider fails when it targets another tenant and expected_tenant is set",
+			Computed:    true,
+		},
+	}
+}
+
+// withAppliedTarget records the appliance url and tenant a resource is
+// applied to in its state. When the provider expects an appliance or a
+// tenant, it fails the operations of the resource targeting another
+// appliance or tenant than the recorded ones, protecting against applying a
+// state to the wrong appliance. The check is opt-in so the url of an
+// appliance can change without failing every resource.
+func withAppliedTarget(resource *schema.Resource) {
+	for key, value := range appliedTargetSchema() {
+		resource.Schema[key] = value
+	}
+
+	checkTarget := func(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if operation == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			target, ok := getApplianceTarget(meta)
+			if !ok {
+				return operation(ctx, d, meta)
+			}
+			if diags := checkAppliedTarget(d, target); diags.HasError() {
+				return diags
+			}
+			diags := operation(ctx, d, meta)
+			if d.Id() != "" {
+				d.Set("applied_appliance_url", target.Url)
+				d.Set("applied_tenant", target.Tenant)
+			}
+			return diags
+		}
+	}
+	resource.CreateContext = checkTarget(resource.CreateContext)
+	resource.ReadContext = checkTarget(resource.ReadContext)
+	resource.UpdateContext = checkTarget(resource.UpdateContext)
+	resource.DeleteContext = checkTarget(resource.DeleteContext)
+}
+
+// checkAppliedTarget fails when the appliance url or the tenant recorded in
+// the state of a resource differ from the expected ones the provider
+// targets, the objects imported or created before the attributes existed
+// having none
+func checkAppliedTarget(d *schema.ResourceData, target applianceTarget) diag.Diagnostics {
+	if url := d.Get("applied_appliance_url").(string); target.CheckUrl && url != "" && url != target.Url {
+		return diag.Errorf("the object was applied to the appliance %s but the provider targets the appliance %s", url, target.Url)
+	}
+	if tenant := d.Get("applied_tenant").(string); target.CheckTenant && tenant != "" && target.Tenant != "" && !strings.EqualFold(tenant, target.Tenant) {
+		return diag.Errorf("the object was applied to the tenant %q but the provider targets the tenant %q", tenant, target.Tenant)
+	}
+	return nil
+}
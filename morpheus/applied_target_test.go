@@ -0,0 +1,36 @@
+package morpheus
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCheckAppliedTarget(t *testing.T) {
+	target := applianceTarget{Url: "https://morpheus.example.com", Tenant: "Acme", CheckUrl: true, CheckTenant: true}
+	unchecked := applianceTarget{Url: "https://morpheus.example.com", Tenant: "Acme"}
+	cases := map[string]struct {
+		target  applianceTarget
+		url     string
+		tenant  string
+		wantErr bool
+	}{
+		"not recorded":           {target: target},
+		"same target":            {target: target, url: "https://morpheus.example.com", tenant: "acme"},
+		"other appliance":        {target: target, url: "https://morpheus-dev.example.com", tenant: "Acme", wantErr: true},
+		"other tenant":           {target: target, url: "https://morpheus.example.com", tenant: "Globex", wantErr: true},
+		"tenant not known":       {target: target, url: "https://morpheus.example.com"},
+		"appliance not expected": {target: unchecked, url: "https://morpheus-dev.example.com", tenant: "Acme"},
+		"tenant not expected":    {target: unchecked, url: "https://morpheus.example.com", tenant: "Globex"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, appliedTargetSchema(), map[string]interface{}{})
+			d.Set("applied_appliance_url", tc.url)
+			d.Set("applied_tenant", tc.tenant)
+			if diags := checkAppliedTarget(d, tc.target); diags.HasError() != tc.wantErr {
+				t.Errorf("checkAppliedTarget() = %v, want error %t", diags, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// approvalPolicyCustomizeDiff checks at plan time that an approval policy
+// either uses the internal approvals or an approval integration, such as
+// ServiceNow, along with the workflow of the integration raising the approvals
+func approvalPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("use_internal_approvals") || !d.NewValueKnown("integration_id") || !d.NewValueKnown("workflow_id") {
+		return nil
+	}
+	integrationId := d.Get("integration_id").(int)
+	workflowId := d.Get("workflow_id").(int)
+	if d.Get("use_internal_approvals").(bool) {
+		if integrationId != 0 || workflowId != 0 {
+			return fmt.Errorf("integration_id and workflow_id cannot be set when use_internal_approvals is true")
+		}
+		return nil
+	}
+	if integrationId == 0 || workflowId == 0 {
+		return fmt.Errorf("integration_id and workflow_id are required when use_internal_approvals is not true")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package morpheus
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// catalogItemFormFieldOverrideSchema is the schema of the form_field_override block
+// shared by the catalog item resources, letting one form back several catalog
+// items with different presets
+func catalogItemFormFieldOverrideSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Description:  "The overrides of the fields of the form associated with the catalog item",
+		Optional:     true,
+		RequiredWith: []string{"form_id"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field_name": {
+					Type:        schema.TypeString,
+					Description: "The field name of the form input to override",
+					Required:    true,
+				},
+				"default_value": {
+					Type:        schema.TypeString,
+					Description: "The default value of the form input for the catalog item",
+					Optional:    true,
+				},
+				"hidden": {
+					Type:        schema.TypeBool,
+					Description: "Whether the form input is hidden when ordering the catalog item",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// catalogItemFormConfigPayload builds the formConfig of a catalog item from the form_field_override blocks
+func catalogItemFormConfigPayload(d *schema.ResourceData) map[string]interface{} {
+	formConfig := make(map[string]interface{})
+	for _, item := range d.Get("form_field_override").([]interface{}) {
+		override := item.(map[string]interface{})
+		formConfig[override["field_name"].(string)] = map[string]interface{}{
+			"defaultValue": override["default_value"].(string),
+			"hidden":       override["hidden"].(bool),
+		}
+	}
+	return formConfig
+}
+
+// parseCatalogItemFormConfig converts the formConfig of a catalog item to form_field_override blocks
+func parseCatalogItemFormConfig(formConfig interface{}) []map[string]interface{} {
+	config, ok := formConfig.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	fieldNames := make([]string, 0, len(config))
+	for fieldName := range config {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	var overrides []map[string]interface{}
+	for _, fieldName := range fieldNames {
+		field, ok := config[fieldName].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		override := map[string]interface{}{
+			"field_name": fieldName,
+		}
+		if defaultValue, ok := field["defaultValue"]; ok && defaultValue != nil {
+			override["default_value"] = fmt.Sprint(defaultValue)
+		}
+		if hidden, ok := field["hidden"].(bool); ok {
+			override["hidden"] = hidden
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides
+}
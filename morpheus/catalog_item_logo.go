@@ -0,0 +1,45 @@
+package morpheus
+
+import (
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// catalogItemLogoContentSchema is the schema of the inline content of a
+// catalog item logo, an alternative to the file path of the logo
+func catalogItemLogoContentSchema(description string, nameKey string, pathKey string, contentKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   description,
+		Optional:      true,
+		ConflictsWith: []string{pathKey},
+		RequiredWith:  []string{contentKey, nameKey},
+	}
+}
+
+// catalogItemLogoFile returns the logo of a catalog item to upload, read from
+// the inline content when set or from the file path otherwise, nil when the
+// catalog item has no logo. The inline content is either base64 encoded, as
+// returned by filebase64(), or the raw content of the file.
+func catalogItemLogoFile(d *schema.ResourceData, parameterName string, nameKey string, pathKey string, contentKey string) (*morpheus.FilePayload, error) {
+	name := d.Get(nameKey).(string)
+	if name == "" {
+		return nil, nil
+	}
+
+	path := d.Get(pathKey).(string)
+	content := d.Get(contentKey).(string)
+	if path == "" && content == "" {
+		return nil, nil
+	}
+	data, err := logoImageData(path, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &morpheus.FilePayload{
+		ParameterName: parameterName,
+		FileName:      name,
+		FileContent:   data,
+	}, nil
+}
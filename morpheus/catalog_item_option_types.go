@@ -0,0 +1,258 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// catalogItemOptionTypeSchema is the schema of the inputs created and owned
+// by a catalog item, they are created along with the catalog item and deleted
+// with it
+func catalogItemOptionTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Description:   "The inputs created and owned by the catalog item, they are added after the option_type_ids and deleted along with the catalog item",
+		Optional:      true,
+		ConflictsWith: []string{"form_id", "tenant_id"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeInt,
+					Description: "The ID of the option type",
+					Computed:    true,
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Description: "The name of the option type",
+					Required:    true,
+				},
+				"field_name": {
+					Type:        schema.TypeString,
+					Description: "The field name of the option type, the value is available as customOptions.<field_name>",
+					Required:    true,
+				},
+				"field_label": {
+					Type:        schema.TypeString,
+					Description: "The label associated with the field in the UI",
+					Optional:    true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Description:  "The type of the option type (text, textarea, number, checkbox, select, password, hidden)",
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"text", "textarea", "number", "checkbox", "select", "password", "hidden"}, false),
+				},
+				"option_list_id": {
+					Type:        schema.TypeInt,
+					Description: "The ID of the option list providing the values of a select option type",
+					Optional:    true,
+				},
+				"placeholder": {
+					Type:        schema.TypeString,
+					Description: "Text in the field used as a placeholder for example purposes",
+					Optional:    true,
+				},
+				"default_value": {
+					Type:        schema.TypeString,
+					Description: "The default value of the option type",
+					Optional:    true,
+				},
+				"help_block": {
+					Type:        schema.TypeString,
+					Description: "Text that provides additional details about the use of the option type",
+					Optional:    true,
+				},
+				"required": {
+					Type:        schema.TypeBool,
+					Description: "Whether the option type is required",
+					Optional:    true,
+					Default:     false,
+				},
+			},
+		},
+	}
+}
+
+func catalogItemOptionTypePayload(optionType map[string]interface{}) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":         optionType["name"].(string),
+		"fieldName":    optionType["field_name"].(string),
+		"fieldLabel":   optionType["field_label"].(string),
+		"type":         optionType["type"].(string),
+		"placeHolder":  optionType["placeholder"].(string),
+		"defaultValue": optionType["default_value"].(string),
+		"helpBlock":    optionType["help_block"].(string),
+		"required":     optionType["required"].(bool),
+	}
+	if optionListId := optionType["option_list_id"].(int); optionListId != 0 {
+		payload["optionList"] = map[string]interface{}{
+			"id": optionListId,
+		}
+	}
+	return map[string]interface{}{
+		"optionType": payload,
+	}
+}
+
+// syncCatalogItemOptionTypes creates or updates the inputs declared in the
+// option_type blocks of a catalog item and returns their ids along with the
+// ids of the inputs whose block was removed. The removed inputs are still
+// referenced by the catalog item until it is updated, they must be deleted
+// with deleteCatalogItemOptionTypes once it is.
+func syncCatalogItemOptionTypes(ctx context.Context, meta interface{}, d *schema.ResourceData) ([]int, []int, error) {
+	var ids []int
+	kept := make(map[int]bool)
+	optionTypes := d.Get("option_type").([]interface{})
+	for i, item := range optionTypes {
+		optionType := item.(map[string]interface{})
+		req := &morpheus.Request{
+			Body: catalogItemOptionTypePayload(optionType),
+		}
+		id := optionType["id"].(int)
+		if id != 0 {
+			resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+				return optionTypeApi.update(meta, d, int64(id), req)
+			})
+			if err != nil {
+				if resp == nil || resp.StatusCode != 404 {
+					log.Printf("API FAILURE: %s - %s", resp, err)
+					return nil, nil, err
+				}
+				// the option type was deleted outside of terraform, create it again
+				log.Printf("API 404: %s - %s", resp, err)
+				id = 0
+			} else {
+				log.Printf("API RESPONSE: %s", resp)
+			}
+		}
+		if id == 0 {
+			resp, err := optionTypeApi.create(meta, d, req)
+			if err != nil {
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return nil, nil, err
+			}
+			log.Printf("API RESPONSE: %s", resp)
+			id = int(resp.Result.(*morpheus.CreateOptionTypeResult).OptionType.ID)
+		}
+		optionType["id"] = id
+		optionTypes[i] = optionType
+		ids = append(ids, id)
+		kept[id] = true
+	}
+	d.Set("option_type", optionTypes)
+
+	var removed []int
+	old, _ := d.GetChange("option_type")
+	for _, item := range old.([]interface{}) {
+		if id := item.(map[string]interface{})["id"].(int); id != 0 && !kept[id] {
+			removed = append(removed, id)
+		}
+	}
+	return ids, removed, nil
+}
+
+// catalogItemOptionTypeIds returns the ids of the option types of a catalog
+// item, the option_type_ids followed by the inputs owned by the catalog item
+func catalogItemOptionTypeIds(d *schema.ResourceData, ownedIds []int) []interface{} {
+	ids := append([]interface{}{}, d.Get("option_type_ids").([]interface{})...)
+	for _, id := range ownedIds {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// deleteCatalogItemOptionTypes deletes the inputs owned by a catalog item,
+// the inputs already deleted are ignored
+func deleteCatalogItemOptionTypes(ctx context.Context, meta interface{}, d *schema.ResourceData, ids []int) error {
+	for _, id := range ids {
+		resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+			return optionTypeApi.delete(meta, d, int64(id), &morpheus.Request{})
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %s", resp, err)
+				continue
+			}
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+	return nil
+}
+
+// ownedCatalogItemOptionTypeIds returns the ids of the inputs owned by a
+// catalog item
+func ownedCatalogItemOptionTypeIds(d *schema.ResourceData) []int {
+	var ids []int
+	for _, item := range d.Get("option_type").([]interface{}) {
+		if id := item.(map[string]interface{})["id"].(int); id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// catalogItemAttachedOptionTypeIds returns the ids of the option types
+// attached directly to a catalog item. The api also returns the inputs of the
+// form of a catalog item using a form, they are not attached to it.
+func catalogItemAttachedOptionTypeIds(catalogItem *morpheus.CatalogItem) []int64 {
+	if catalogItem.FormType == "form" || catalogItem.Form.ID != 0 {
+		return nil
+	}
+	var ids []int64
+	for _, optionType := range catalogItem.OptionTypes {
+		if option, ok := optionType.(map[string]interface{}); ok {
+			if id, ok := option["id"].(float64); ok {
+				ids = append(ids, int64(id))
+			}
+		}
+	}
+	return ids
+}
+
+// readCatalogItemOptionTypes refreshes the option_type blocks of a catalog
+// item and returns the given option type ids without the inputs it owns. The
+// inputs deleted outside of terraform are dropped to be created again.
+func readCatalogItemOptionTypes(meta interface{}, d *schema.ResourceData, optionTypeIds []int64) ([]int64, error) {
+	owned := make(map[int64]bool)
+	var optionTypes []map[string]interface{}
+	for _, id := range ownedCatalogItemOptionTypeIds(d) {
+		resp, err := optionTypeApi.get(meta, d, int64(id))
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %s", resp, err)
+				continue
+			}
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return nil, err
+		}
+		optionType := resp.Result.(*morpheus.GetOptionTypeResult).OptionType
+		owned[optionType.ID] = true
+		optionTypes = append(optionTypes, map[string]interface{}{
+			"id":             int(optionType.ID),
+			"name":           optionType.Name,
+			"field_name":     optionType.FieldName,
+			"field_label":    optionType.FieldLabel,
+			"type":           optionType.Type,
+			"option_list_id": int(optionType.OptionList.ID),
+			"placeholder":    optionType.PlaceHolder,
+			"default_value":  optionType.DefaultValue,
+			"help_block":     optionType.HelpBlock,
+			"required":       optionType.Required,
+		})
+	}
+	d.Set("option_type", optionTypes)
+
+	var ids []int64
+	for _, id := range optionTypeIds {
+		if !owned[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
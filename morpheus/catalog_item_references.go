@@ -0,0 +1,118 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// catalogItemReferences maps the attributes of the catalog items referencing
+// other objects to the kind of the referenced object and the api used to look
+// it up in the tenant of the catalog item
+var catalogItemReferences = map[string]struct {
+	kind string
+	api  tenantObjectApi
+}{
+	"option_type_ids": {"option type", optionTypeApi},
+	"form_id": {"form", tenantObjectApi{
+		name:      "Forms",
+		path:      morpheus.FormsPath,
+		getResult: func() interface{} { return &morpheus.GetFormResult{} },
+	}},
+	"blueprint_id": {"blueprint", tenantObjectApi{
+		name:      "Blueprints",
+		path:      morpheus.BlueprintsPath,
+		getResult: func() interface{} { return &morpheus.GetBlueprintResult{} },
+	}},
+	"workflow_id": {"workflow", taskSetApi},
+}
+
+// catalogItemReferencesCustomizeDiff checks at plan time that the objects
+// referenced by the given attributes of a catalog item exist on the
+// appliance, reporting every missing reference at once.
+//
+// The plugin SDK only validates one resource at a time, the provider cannot
+// see the other resources of the configuration. The ids of the objects
+// created in the same configuration are not known at plan time and are left
+// to the dependency graph of Terraform, only the known ids are checked.
+func catalogItemReferencesCustomizeDiff(keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if _, ok := metaClient(meta); !ok {
+			return nil
+		}
+		// the references are looked up in the tenant the catalog item is
+		// created in, which is not known yet when computed by another resource
+		if !d.NewValueKnown("tenant_id") {
+			return nil
+		}
+		tenantId := meta.(*providerMeta).defaultTenantId
+		if !d.GetRawConfig().GetAttr("tenant_id").IsNull() {
+			tenantId = d.Get("tenant_id").(int)
+		}
+
+		references := make(map[string][]int)
+		for _, key := range keys {
+			if _, ok := catalogItemReferences[key]; !ok {
+				continue
+			}
+			if _, ok := d.GetOk(key); !ok || !d.HasChange(key) || !d.NewValueKnown(key) {
+				continue
+			}
+			switch value := d.Get(key).(type) {
+			case int:
+				references[key] = append(references[key], value)
+			case []interface{}:
+				for i, id := range value {
+					if d.NewValueKnown(fmt.Sprintf("%s.%d", key, i)) {
+						references[key] = append(references[key], id.(int))
+					}
+				}
+			}
+		}
+
+		missing, err := missingCatalogItemReferences(references, func(key string, id int64) (*morpheus.Response, error) {
+			return catalogItemReferences[key].api.getInTenant(meta, tenantId, id)
+		})
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("the catalog item references objects that do not exist on the appliance: %s", strings.Join(missing, ", "))
+		}
+		return nil
+	}
+}
+
+// missingCatalogItemReferences looks up the ids referenced by each attribute
+// of a catalog item and returns the ones not found on the appliance
+func missingCatalogItemReferences(references map[string][]int, get func(key string, id int64) (*morpheus.Response, error)) ([]string, error) {
+	keys := make([]string, 0, len(references))
+	for key := range references {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var missing []string
+	for _, key := range keys {
+		for _, id := range references[key] {
+			if id == 0 {
+				continue
+			}
+			resp, err := get(key, int64(id))
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					missing = append(missing, fmt.Sprintf("%s %d (%s)", catalogItemReferences[key].kind, id, key))
+					continue
+				}
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return nil, err
+			}
+		}
+	}
+	return missing, nil
+}
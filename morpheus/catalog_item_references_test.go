@@ -0,0 +1,62 @@
+package morpheus
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+)
+
+func TestMissingCatalogItemReferences(t *testing.T) {
+	existing := map[string]map[int64]bool{
+		"option_type_ids": {1: true, 2: true},
+		"form_id":         {10: true},
+		"workflow_id":     {20: true},
+		"blueprint_id":    {30: true},
+	}
+	get := func(key string, id int64) (*morpheus.Response, error) {
+		if id == 500 {
+			return &morpheus.Response{StatusCode: 500}, errors.New("internal server error")
+		}
+		if !existing[key][id] {
+			return &morpheus.Response{StatusCode: 404}, errors.New("not found")
+		}
+		return &morpheus.Response{StatusCode: 200}, nil
+	}
+
+	cases := map[string]struct {
+		references map[string][]int
+		missing    []string
+		wantErr    bool
+	}{
+		"no references": {
+			references: map[string][]int{},
+		},
+		"all found": {
+			references: map[string][]int{"option_type_ids": {1, 2}, "form_id": {10}, "workflow_id": {20}, "blueprint_id": {30}},
+		},
+		"unset ids skipped": {
+			references: map[string][]int{"form_id": {0}},
+		},
+		"every missing reference": {
+			references: map[string][]int{"option_type_ids": {1, 3, 4}, "form_id": {11}, "workflow_id": {21}, "blueprint_id": {31}},
+			missing:    []string{"blueprint 31 (blueprint_id)", "form 11 (form_id)", "option type 3 (option_type_ids)", "option type 4 (option_type_ids)", "workflow 21 (workflow_id)"},
+		},
+		"api failure": {
+			references: map[string][]int{"workflow_id": {500}},
+			wantErr:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			missing, err := missingCatalogItemReferences(tc.references, get)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("missingCatalogItemReferences() error = %v, want error %t", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(missing, tc.missing) {
+				t.Errorf("missingCatalogItemReferences() = %v, want %v", missing, tc.missing)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package morpheus
+
+import (
+	"context"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cloudWaitForSyncSchema is the schema of the wait_for_sync attribute shared by the cloud resources
+func cloudWaitForSyncSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether to wait on create until the initial inventory sync of the cloud completes, so the networks, datastores and resource pools of the cloud can be looked up in the same apply. The wait is bounded by the create timeout",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+// waitForCloud waits for a newly created cloud to be initialized and, when
+// waitForSync is set, for its first inventory sync to complete
+func waitForCloud(ctx context.Context, client *apiClient, id int64, timeout time.Duration, waitForSync bool) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"initializing", "syncing"},
+		Target:  []string{"ok"},
+		Refresh: func() (interface{}, string, error) {
+			cloudDetails, err := client.GetCloud(id, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			result := cloudDetails.Result.(*morpheus.GetCloudResult)
+			cloudStatus := result.Cloud
+			// the cloud reports ok before the first sync has run
+			if waitForSync && cloudStatus.Status == "ok" && cloudStatus.LastSync == "" {
+				return result, "syncing", nil
+			}
+			return result, cloudStatus.Status, nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   1 * time.Minute,
+		PollInterval: 30 * time.Second,
+	}
+
+	// Wait, catching any errors
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// importCloudState sets the defaults of the attributes not returned by the api on import
+func importCloudState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("wait_for_sync", false)
+	return []*schema.ResourceData{d}, nil
+}
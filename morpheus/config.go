@@ -4,7 +4,9 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"log"
 	"os"
+	"strings"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +28,13 @@ type Config struct {
 
 	Insecure bool
 
+	// guard against applying a configuration to the wrong appliance or tenant
+	ExpectedUrl    string
+	ExpectedTenant string
+
+	// the file the summary of the API usage is written to
+	APISummaryFile string
+
 	client *morpheus.Client
 }
 
@@ -87,3 +96,36 @@ func (c *Config) Client() (*morpheus.Client, diag.Diagnostics) {
 
 	return c.client, diags
 }
+
+// CheckExpectedTarget fails when the configured appliance url or the tenant
+// of the authenticated user does not match the expected ones, then returns
+// the target of the provider for the resources to compare with the target
+// they were applied to
+func (c *Config) CheckExpectedTarget(client *apiClient) (applianceTarget, diag.Diagnostics) {
+	if c.ExpectedUrl != "" && normalizeApplianceUrl(c.ExpectedUrl) != normalizeApplianceUrl(c.Url) {
+		return applianceTarget{}, diag.Errorf("the provider is configured for the appliance %s but the expected appliance is %s", c.Url, c.ExpectedUrl)
+	}
+
+	tenant, err := applianceTenant(client)
+	if err != nil {
+		if c.ExpectedTenant != "" {
+			return applianceTarget{}, diag.Errorf("unable to verify the tenant of the authenticated user: %s", err)
+		}
+		// the tenant is not compared with the one the resources were applied to
+		log.Printf("unable to determine the tenant of the authenticated user: %s", err)
+	}
+	if c.ExpectedTenant != "" && !strings.EqualFold(tenant, c.ExpectedTenant) {
+		return applianceTarget{}, diag.Errorf("the provider is authenticated against the tenant %q but the expected tenant is %q", tenant, c.ExpectedTenant)
+	}
+
+	return applianceTarget{
+		Url:         normalizeApplianceUrl(client.Url),
+		Tenant:      tenant,
+		CheckUrl:    c.ExpectedUrl != "",
+		CheckTenant: c.ExpectedTenant != "",
+	}, nil
+}
+
+func normalizeApplianceUrl(url string) string {
+	return strings.ToLower(strings.TrimRight(strings.TrimSpace(url), "/"))
+}
@@ -2,7 +2,6 @@ package morpheus
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
 
@@ -33,13 +32,13 @@ func dataSourceMorpheusAnsibleTowerInventory() *schema.Resource {
 }
 
 func dataSourceMorpheusAnsibleTowerInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
-	value := d.Get("id")
+	value := d.Get("id").(int)
 
 	// lookup by name if we do not have an value yet
 	var resp *morpheus.Response
@@ -58,7 +57,11 @@ func dataSourceMorpheusAnsibleTowerInventoryRead(ctx context.Context, d *schema.
 	log.Printf("API RESPONSE: %s", resp)
 
 	var inventory morpheus.OptionSourceOption
-	allInventories := *resp.Result.(*morpheus.GetOptionSourceResult).Data
+	result := resp.Result.(*morpheus.GetOptionSourceResult)
+	if result.Data == nil {
+		return diag.Errorf("Ansible tower inventory options not found in response data.") // should not happen
+	}
+	allInventories := *result.Data
 	for i := range allInventories {
 		if value == 0 && name != "" {
 			if strings.EqualFold(allInventories[i].Name, name) {
@@ -66,7 +69,7 @@ func dataSourceMorpheusAnsibleTowerInventoryRead(ctx context.Context, d *schema.
 				break
 			}
 		} else if value != 0 {
-			if value == allInventories[i].Value {
+			if int64(value) == jsonInt64Value(allInventories[i].Value) {
 				inventory = allInventories[i]
 				break
 			}
@@ -75,9 +78,13 @@ func dataSourceMorpheusAnsibleTowerInventoryRead(ctx context.Context, d *schema.
 		}
 	}
 
+	if inventory.Value == nil {
+		return diag.Errorf("Ansible tower inventory not found")
+	}
+
 	// store resource data
-	d.SetId(fmt.Sprintf("%g", inventory.Value.(float64)))
-	d.Set("id", inventory.Value)
+	d.SetId(jsonStringValue(inventory.Value))
+	d.Set("id", jsonInt64Value(inventory.Value))
 	d.Set("name", inventory.Name)
 
 	return diags
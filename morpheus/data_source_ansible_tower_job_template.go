@@ -2,7 +2,6 @@ package morpheus
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
 
@@ -33,13 +32,13 @@ func dataSourceMorpheusAnsibleTowerJobTemplate() *schema.Resource {
 }
 
 func dataSourceMorpheusAnsibleTowerJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
-	value := d.Get("id")
+	value := d.Get("id").(int)
 
 	// lookup by name if we do not have an value yet
 	var resp *morpheus.Response
@@ -58,7 +57,11 @@ func dataSourceMorpheusAnsibleTowerJobTemplateRead(ctx context.Context, d *schem
 	log.Printf("API RESPONSE: %s", resp)
 
 	var template morpheus.OptionSourceOption
-	allTemplates := *resp.Result.(*morpheus.GetOptionSourceResult).Data
+	result := resp.Result.(*morpheus.GetOptionSourceResult)
+	if result.Data == nil {
+		return diag.Errorf("Ansible tower job template options not found in response data.") // should not happen
+	}
+	allTemplates := *result.Data
 	for i := range allTemplates {
 		if value == 0 && name != "" {
 			if strings.EqualFold(allTemplates[i].Name, name) {
@@ -66,7 +69,7 @@ func dataSourceMorpheusAnsibleTowerJobTemplateRead(ctx context.Context, d *schem
 				break
 			}
 		} else if value != 0 {
-			if value == allTemplates[i].Value {
+			if int64(value) == jsonInt64Value(allTemplates[i].Value) {
 				template = allTemplates[i]
 				break
 			}
@@ -75,9 +78,13 @@ func dataSourceMorpheusAnsibleTowerJobTemplateRead(ctx context.Context, d *schem
 		}
 	}
 
+	if template.Value == nil {
+		return diag.Errorf("Ansible tower job template not found")
+	}
+
 	// store resource data
-	d.SetId(fmt.Sprintf("%g", template.Value.(float64)))
-	d.Set("id", template.Value)
+	d.SetId(jsonStringValue(template.Value))
+	d.Set("id", jsonInt64Value(template.Value))
 	d.Set("name", template.Name)
 
 	return diags
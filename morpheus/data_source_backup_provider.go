@@ -0,0 +1,143 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusBackupProvider() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus backup provider data source.",
+		ReadContext: dataSourceMorpheusBackupProviderRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the backup provider",
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+				Computed:      true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "The name of the backup provider",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type code of the backup provider",
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the backup provider is enabled",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the backup provider",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusBackupProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	id := d.Get("id").(int)
+
+	// lookup by name if we do not have an id yet
+	var backupProvider *BackupProvider
+	if id == 0 && name != "" {
+		resp, err := client.Execute(&morpheus.Request{
+			Method: "GET",
+			Path:   backupProvidersPath,
+			QueryParams: map[string]string{
+				"name": name,
+			},
+			Result: &ListBackupProvidersResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		result := resp.Result.(*ListBackupProvidersResult)
+		for i := range result.BackupProviders {
+			if result.BackupProviders[i].Name == name {
+				backupProvider = &result.BackupProviders[i]
+				break
+			}
+		}
+		if backupProvider == nil {
+			return diag.Errorf("Backup provider not found by name %s", name)
+		}
+	} else if id != 0 {
+		resp, err := client.Execute(&morpheus.Request{
+			Method: "GET",
+			Path:   fmt.Sprintf("%s/%d", backupProvidersPath, id),
+			Result: &GetBackupProviderResult{},
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return nil
+			} else {
+				log.Printf("API FAILURE: %s - %v", resp, err)
+				return diag.FromErr(err)
+			}
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		backupProvider = resp.Result.(*GetBackupProviderResult).BackupProvider
+	} else {
+		return diag.Errorf("Backup provider cannot be read without name or id")
+	}
+
+	// store resource data
+	if backupProvider != nil {
+		d.SetId(int64ToString(backupProvider.ID))
+		d.Set("name", backupProvider.Name)
+		d.Set("type", backupProvider.Type.Code)
+		d.Set("enabled", backupProvider.Enabled)
+		d.Set("status", backupProvider.Status)
+	} else {
+		return diag.Errorf("Backup provider not found in response data.") // should not happen
+	}
+	return diags
+}
+
+// backupProvidersPath is the api endpoint for the backup integrations
+const backupProvidersPath = "/api/backup-services"
+
+type BackupProvider struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Type struct {
+		ID   int64  `json:"id"`
+		Code string `json:"code"`
+		Name string `json:"name"`
+	} `json:"type"`
+	Enabled         bool                   `json:"enabled"`
+	Status          string                 `json:"status"`
+	ServiceUrl      string                 `json:"serviceUrl"`
+	ServiceUsername string                 `json:"serviceUsername"`
+	Config          map[string]interface{} `json:"config"`
+}
+
+type ListBackupProvidersResult struct {
+	BackupProviders []BackupProvider `json:"backupServices"`
+}
+
+type GetBackupProviderResult struct {
+	BackupProvider *BackupProvider `json:"backupService"`
+}
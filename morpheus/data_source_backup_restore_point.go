@@ -0,0 +1,153 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusBackupRestorePoint() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus backup restore point data source, the most recent result of a backup matching the given status and date that an instance can be restored from.",
+		ReadContext: dataSourceMorpheusBackupRestorePointRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the restore point",
+				Computed:    true,
+			},
+			"backup_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the backup to find the restore point of",
+				Required:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the restore point (SUCCEEDED, FAILED, ...)",
+				Optional:    true,
+				Default:     "SUCCEEDED",
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Description:  "Only consider the restore points created before this date and time, in RFC 3339 format, to pick the recovery point of a given moment",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"start_date": {
+				Type:        schema.TypeString,
+				Description: "The date and time the backup of the restore point started",
+				Computed:    true,
+			},
+			"end_date": {
+				Type:        schema.TypeString,
+				Description: "The date and time the backup of the restore point ended",
+				Computed:    true,
+			},
+			"size_in_mb": {
+				Type:        schema.TypeInt,
+				Description: "The size of the restore point in megabytes",
+				Computed:    true,
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the restore point in the backup provider",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusBackupRestorePointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	backupId := d.Get("backup_id").(int)
+	status := d.Get("status").(string)
+	var createdBefore time.Time
+	if v := d.Get("created_before").(string); v != "" {
+		createdBefore, _ = time.Parse(time.RFC3339, v)
+	}
+
+	queryParams := map[string]string{
+		"backupId":  strconv.Itoa(backupId),
+		"sort":      "dateCreated",
+		"direction": "desc",
+	}
+
+	// page through the results of the backup, the most recent first
+	var restorePoint *BackupResult
+	max := 100
+	for offset := 0; restorePoint == nil; offset += max {
+		queryParams["max"] = strconv.Itoa(max)
+		queryParams["offset"] = strconv.Itoa(offset)
+		resp, err := client.Execute(&morpheus.Request{
+			Method:      "GET",
+			Path:        backupResultsPath,
+			QueryParams: queryParams,
+			Result:      &ListBackupResultsResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+
+		result := resp.Result.(*ListBackupResultsResult)
+		for i, backupResult := range result.Results {
+			if !strings.EqualFold(backupResult.Status, status) {
+				continue
+			}
+			if !createdBefore.IsZero() {
+				dateCreated, err := time.Parse(time.RFC3339, backupResult.DateCreated)
+				if err != nil || !dateCreated.Before(createdBefore) {
+					continue
+				}
+			}
+			restorePoint = &result.Results[i]
+			break
+		}
+		if len(result.Results) < max {
+			break
+		}
+	}
+	if restorePoint == nil {
+		return diag.Errorf("No %s restore point found for the backup %d", status, backupId)
+	}
+
+	d.SetId(int64ToString(restorePoint.ID))
+	d.Set("start_date", restorePoint.StartDate)
+	d.Set("end_date", restorePoint.EndDate)
+	d.Set("size_in_mb", restorePoint.SizeInMb)
+	d.Set("external_id", restorePoint.ExternalId)
+	return diags
+}
+
+// backupResultsPath is the api endpoint for the results of the backups
+const backupResultsPath = "/api/backups/results"
+
+type BackupResult struct {
+	ID     int64 `json:"id"`
+	Backup struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"backup"`
+	Status      string `json:"status"`
+	ExternalId  string `json:"externalId"`
+	SizeInMb    int64  `json:"sizeInMb"`
+	StartDate   string `json:"startDate"`
+	EndDate     string `json:"endDate"`
+	DateCreated string `json:"dateCreated"`
+}
+
+type ListBackupResultsResult struct {
+	Results []BackupResult `json:"results"`
+}
@@ -0,0 +1,151 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusBackupSchedule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus backup schedule data source, the execution schedule that determines when backup jobs run, with the retention of the backups taken on it.",
+		ReadContext: dataSourceMorpheusBackupScheduleRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the backup schedule",
+				Optional:      true,
+				ConflictsWith: []string{"name", "default"},
+				Computed:      true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "The name of the backup schedule",
+				Optional:      true,
+				ConflictsWith: []string{"id", "default"},
+				Computed:      true,
+			},
+			"default": {
+				Type:          schema.TypeBool,
+				Description:   "Whether to look up the default backup schedule of the appliance backup settings, set on lookup when the schedule is the default one",
+				Optional:      true,
+				ConflictsWith: []string{"id", "name"},
+				Computed:      true,
+			},
+			"schedule_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the backup schedule, filtering the schedules looked up by name when set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cron": {
+				Type:        schema.TypeString,
+				Description: "The cron expression of the backup schedule",
+				Computed:    true,
+			},
+			"time_zone": {
+				Type:        schema.TypeString,
+				Description: "The time zone the backup schedule is evaluated in",
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the backup schedule is enabled",
+				Computed:    true,
+			},
+			"retention_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of backups retained by the backup jobs running on the schedule that do not set their own retention count, from the appliance backup settings",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusBackupScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	id := int64(d.Get("id").(int))
+	scheduleType := d.Get("schedule_type").(string)
+
+	// the backup settings hold the default schedule and retention of the backups
+	resp, err := client.GetBackupSettings(&morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %v", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	backupSettings := resp.Result.(*morpheus.GetBackupSettingsResult).BackupSettings
+	if backupSettings == nil {
+		return diag.Errorf("Backup settings not found in response data.") // should not happen
+	}
+
+	// lookup by name if we do not have an id yet
+	if d.Get("default").(bool) {
+		id = backupSettings.DefaultSchedule.ID
+		if id == 0 {
+			return diag.Errorf("No default backup schedule is set in the backup settings")
+		}
+	} else if id == 0 && name != "" {
+		resp, err := client.ListExecuteSchedules(&morpheus.Request{
+			QueryParams: map[string]string{
+				"name": name,
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		var matches []morpheus.ExecuteSchedule
+		if executeSchedules := resp.Result.(*morpheus.ListExecuteSchedulesResult).ExecuteSchedules; executeSchedules != nil {
+			for _, executeSchedule := range *executeSchedules {
+				if executeSchedule.Name == name && (scheduleType == "" || executeSchedule.ScheduleType == scheduleType) {
+					matches = append(matches, executeSchedule)
+				}
+			}
+		}
+		if len(matches) != 1 {
+			return diag.Errorf("found %d backup schedules named %s", len(matches), name)
+		}
+		id = matches[0].ID
+	} else if id == 0 {
+		return diag.Errorf("Backup schedule cannot be read without name, id or default")
+	}
+
+	resp, err = client.GetExecuteSchedule(id, &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return diag.Errorf("Backup schedule %d not found", id)
+		} else {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	executeSchedule := resp.Result.(*morpheus.GetExecuteScheduleResult).ExecuteSchedule
+	if executeSchedule == nil {
+		return diag.Errorf("Backup schedule not found in response data.") // should not happen
+	}
+	if scheduleType != "" && executeSchedule.ScheduleType != scheduleType {
+		return diag.Errorf("Backup schedule %s is of type %s, not %s", executeSchedule.Name, executeSchedule.ScheduleType, scheduleType)
+	}
+	d.SetId(int64ToString(executeSchedule.ID))
+	d.Set("name", executeSchedule.Name)
+	d.Set("default", executeSchedule.ID == backupSettings.DefaultSchedule.ID)
+	d.Set("schedule_type", executeSchedule.ScheduleType)
+	d.Set("cron", executeSchedule.Cron)
+	d.Set("time_zone", executeSchedule.ScheduleTimeZone)
+	d.Set("enabled", executeSchedule.Enabled)
+	d.Set("retention_count", backupSettings.RetentionCount)
+	return diags
+}
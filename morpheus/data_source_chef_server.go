@@ -2,7 +2,6 @@ package morpheus
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
 
@@ -33,13 +32,13 @@ func dataSourceMorpheusChefServer() *schema.Resource {
 }
 
 func dataSourceMorpheusChefServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
-	value := d.Get("id")
+	value := d.Get("id").(int)
 
 	// lookup by name if we do not have an value yet
 	var resp *morpheus.Response
@@ -58,7 +57,11 @@ func dataSourceMorpheusChefServerRead(ctx context.Context, d *schema.ResourceDat
 	log.Printf("API RESPONSE: %s", resp)
 
 	var server morpheus.OptionSourceOption
-	chefServers := *resp.Result.(*morpheus.GetOptionSourceResult).Data
+	result := resp.Result.(*morpheus.GetOptionSourceResult)
+	if result.Data == nil {
+		return diag.Errorf("Chef server options not found in response data.") // should not happen
+	}
+	chefServers := *result.Data
 	for i := range chefServers {
 		if value == 0 && name != "" {
 			if strings.EqualFold(chefServers[i].Name, name) {
@@ -66,7 +69,7 @@ func dataSourceMorpheusChefServerRead(ctx context.Context, d *schema.ResourceDat
 				break
 			}
 		} else if value != 0 {
-			if value == chefServers[i].Value {
+			if int64(value) == jsonInt64Value(chefServers[i].Value) {
 				server = chefServers[i]
 				break
 			}
@@ -75,9 +78,13 @@ func dataSourceMorpheusChefServerRead(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if server.Value == nil {
+		return diag.Errorf("Chef server not found")
+	}
+
 	// store resource data
-	d.SetId(fmt.Sprintf("%g", server.Value.(float64)))
-	d.Set("id", server.Value)
+	d.SetId(jsonStringValue(server.Value))
+	d.Set("id", jsonInt64Value(server.Value))
 	d.Set("name", server.Name)
 
 	return diags
@@ -81,7 +81,7 @@ func dataSourceMorpheusCloudDatastore() *schema.Resource {
 }
 
 func dataSourceMorpheusCloudDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
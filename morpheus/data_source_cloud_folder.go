@@ -48,7 +48,7 @@ func dataSourceMorpheusCloudFolder() *schema.Resource {
 }
 
 func dataSourceMorpheusCloudFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	name := d.Get("name").(string)
 	id := d.Get("id").(int)
@@ -81,7 +81,7 @@ func dataSourceMorpheusCloudFolderRead(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func getCloudFolderFromName(client *morpheus.Client, cloudId int, name string) (*morpheus.Response, error) {
+func getCloudFolderFromName(client *apiClient, cloudId int, name string) (*morpheus.Response, error) {
 	resp, err := client.ListCloudResourceFolders(int64(cloudId), &morpheus.Request{})
 	if err != nil {
 		return nil, err
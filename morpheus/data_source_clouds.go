@@ -54,7 +54,7 @@ func dataSourceMorpheusClouds() *schema.Resource {
 }
 
 func dataSourceMorpheusCloudsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -28,7 +28,7 @@ func dataSourceMorpheusClusterType() *schema.Resource {
 }
 
 func dataSourceMorpheusClusterTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -0,0 +1,217 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusCostAllocation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus cost allocation data source that attributes the cost of the invoices of a period to allocation rules matching the tags of the invoices, so the allocation policy lives with the tagging standards feeding it.",
+		ReadContext: dataSourceMorpheusCostAllocationRead,
+		Schema: map[string]*schema.Schema{
+			"period": {
+				Type:         schema.TypeString,
+				Description:  "The period of the invoices, in YYYYMM format",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]{6}$`), "must be in YYYYMM format"),
+			},
+			"ref_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the objects the invoices are for (Instance, ComputeServer, ComputeZone, ComputeSite)",
+				Optional:     true,
+				Default:      "Instance",
+				ValidateFunc: validation.StringInSlice([]string{"Instance", "ComputeServer", "ComputeZone", "ComputeSite"}, false),
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "The allocation rules, an invoice is allocated to the first rule matching its tags",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the allocation, such as a cost center",
+							Required:    true,
+						},
+						"tag_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the tag of the invoices matched by the rule",
+							Required:    true,
+						},
+						"tag_values": {
+							Type:        schema.TypeList,
+							Description: "The values of the tag matched by the rule, any value of the tag matches when not set",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"allocations": {
+				Type:        schema.TypeList,
+				Description: "The cost allocated to each rule, in the order of the rules",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the allocation",
+							Computed:    true,
+						},
+						"total_cost": {
+							Type:        schema.TypeFloat,
+							Description: "The total cost of the invoices allocated to the rule",
+							Computed:    true,
+						},
+						"invoice_ids": {
+							Type:        schema.TypeList,
+							Description: "The IDs of the invoices allocated to the rule",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"unallocated_cost": {
+				Type:        schema.TypeFloat,
+				Description: "The total cost of the invoices matching none of the rules",
+				Computed:    true,
+			},
+			"unallocated_invoice_ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the invoices matching none of the rules, the objects missing the tags of the allocation policy",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"total_cost": {
+				Type:        schema.TypeFloat,
+				Description: "The total cost of the invoices of the period",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusCostAllocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	period := d.Get("period").(string)
+	refType := d.Get("ref_type").(string)
+
+	type costAllocation struct {
+		name       string
+		tagName    string
+		tagValues  map[string]bool
+		totalCost  float64
+		invoiceIds []int
+	}
+	var allocations []*costAllocation
+	for _, item := range d.Get("rule").([]interface{}) {
+		rule := item.(map[string]interface{})
+		allocation := &costAllocation{
+			name:       rule["name"].(string),
+			tagName:    rule["tag_name"].(string),
+			tagValues:  make(map[string]bool),
+			invoiceIds: []int{},
+		}
+		for _, value := range rule["tag_values"].([]interface{}) {
+			allocation.tagValues[value.(string)] = true
+		}
+		allocations = append(allocations, allocation)
+	}
+
+	var totalCost, unallocatedCost float64
+	unallocatedInvoiceIds := []int{}
+
+	// page through the invoices of the period
+	max := 100
+	for offset := 0; ; offset += max {
+		resp, err := client.Execute(&morpheus.Request{
+			Method: "GET",
+			Path:   "/api/invoices",
+			QueryParams: map[string]string{
+				"period":  period,
+				"refType": refType,
+				"max":     strconv.Itoa(max),
+				"offset":  strconv.Itoa(offset),
+			},
+			Result: &ListInvoicesResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+
+		result := resp.Result.(*ListInvoicesResult)
+		for _, invoice := range result.Invoices {
+			totalCost += invoice.TotalCost
+			tags := make(map[string]string)
+			for _, tag := range invoice.Tags {
+				tags[tag.Name] = tag.Value
+			}
+			allocated := false
+			for _, allocation := range allocations {
+				value, ok := tags[allocation.tagName]
+				if !ok || (len(allocation.tagValues) > 0 && !allocation.tagValues[value]) {
+					continue
+				}
+				allocation.totalCost += invoice.TotalCost
+				allocation.invoiceIds = append(allocation.invoiceIds, int(invoice.ID))
+				allocated = true
+				break
+			}
+			if !allocated {
+				unallocatedCost += invoice.TotalCost
+				unallocatedInvoiceIds = append(unallocatedInvoiceIds, int(invoice.ID))
+			}
+		}
+		if len(result.Invoices) < max {
+			break
+		}
+	}
+
+	var allocationsData []map[string]interface{}
+	for _, allocation := range allocations {
+		allocationsData = append(allocationsData, map[string]interface{}{
+			"name":        allocation.name,
+			"total_cost":  allocation.totalCost,
+			"invoice_ids": allocation.invoiceIds,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", period, refType))
+	d.Set("allocations", allocationsData)
+	d.Set("unallocated_cost", unallocatedCost)
+	d.Set("unallocated_invoice_ids", unallocatedInvoiceIds)
+	d.Set("total_cost", totalCost)
+	return diags
+}
+
+type ListInvoicesResult struct {
+	Invoices []Invoice `json:"invoices"`
+}
+
+type Invoice struct {
+	ID        int64   `json:"id"`
+	RefType   string  `json:"refType"`
+	RefId     int64   `json:"refId"`
+	Period    string  `json:"period"`
+	TotalCost float64 `json:"totalCost"`
+	Tags      []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"tags"`
+}
@@ -0,0 +1,122 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusCredentialStore() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus credential store data source to look up the external credential store integrations credentials can be saved to.",
+		ReadContext: dataSourceMorpheusCredentialStoreRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store integration",
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+				Computed:      true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "The name of the credential store integration",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+				Computed:      true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The code of the integration type of the credential store, used to narrow the lookup by name",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the credential store integration is enabled",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the credential store integration",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusCredentialStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	id := d.Get("id").(int)
+	integrationType := d.Get("type").(string)
+
+	// lookup by name if we do not have an id yet
+	var integration *morpheus.Integration
+	if id == 0 && name != "" {
+		queryParams := map[string]string{
+			"name": name,
+		}
+		if integrationType != "" {
+			queryParams["type"] = integrationType
+		}
+		resp, err := client.ListIntegrations(&morpheus.Request{
+			QueryParams: queryParams,
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		result := resp.Result.(*morpheus.ListIntegrationsResult)
+		if result.Integrations != nil {
+			for i := range *result.Integrations {
+				if (*result.Integrations)[i].Name == name {
+					integration = &(*result.Integrations)[i]
+					break
+				}
+			}
+		}
+		if integration == nil {
+			return diag.Errorf("Credential store not found by name %s", name)
+		}
+	} else if id != 0 {
+		resp, err := client.GetIntegration(int64(id), &morpheus.Request{})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return nil
+			} else {
+				log.Printf("API FAILURE: %s - %v", resp, err)
+				return diag.FromErr(err)
+			}
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		integration = resp.Result.(*morpheus.GetIntegrationResult).Integration
+	} else {
+		return diag.Errorf("Credential store cannot be read without name or id")
+	}
+
+	// store resource data
+	if integration != nil {
+		d.SetId(int64ToString(integration.ID))
+		d.Set("name", integration.Name)
+		if integration.IntegrationType.Code != "" {
+			d.Set("type", integration.IntegrationType.Code)
+		} else {
+			d.Set("type", integration.Type)
+		}
+		d.Set("enabled", integration.Enabled)
+		d.Set("status", integration.Status)
+	} else {
+		return diag.Errorf("Credential store not found in response data.") // should not happen
+	}
+	return diags
+}
@@ -0,0 +1,94 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var cypherKeyRegexp = regexp.MustCompile(`^/?[a-z]+/.+`)
+
+func dataSourceMorpheusCypher() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus cypher data source, reading the value of a cypher key of any mount (secret, password, key, uuid, vault, etc.) as a sensitive value. Reading a missing key of the mounts generating their values, such as the password mount, generates and stores its value.",
+		ReadContext: dataSourceMorpheusCypherRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Description:  "The path of the cypher key, including its mount (secret/demo/test, password/15/dbpassword, etc.)",
+				ValidateFunc: validation.StringMatch(cypherKeyRegexp, "must include the mount of the cypher key"),
+				Required:     true,
+			},
+			"mount": {
+				Type:        schema.TypeString,
+				Description: "The mount of the cypher key",
+				Computed:    true,
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Description: "The value of the cypher key, the objects being encoded in JSON",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of the value of the cypher key (string, object)",
+				Computed:    true,
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "The time to live of the cypher key",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusCypherRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	key := strings.Trim(d.Get("key").(string), "/")
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%s", "/api/cypher", key),
+		Result: &LocalGetCypherResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %v", resp, err)
+			return diag.Errorf("Cypher %s not found", key)
+		} else {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	cypher := resp.Result.(*LocalGetCypherResult)
+	if cypher.Cypher == nil {
+		return diag.Errorf("Cypher not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(cypher.Cypher.ID))
+	d.Set("mount", strings.Split(key, "/")[0])
+	d.Set("value", jsonStringValue(cypher.Data))
+	d.Set("type", cypher.Type)
+	d.Set("ttl", cypher.LeaseDuration)
+	return diags
+}
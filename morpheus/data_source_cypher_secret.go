@@ -29,6 +29,7 @@ func dataSourceMorpheusCypherSecret() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The cypher secret value",
 				Computed:    true,
+				Sensitive:   true,
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -40,7 +41,7 @@ func dataSourceMorpheusCypherSecret() *schema.Resource {
 }
 
 func dataSourceMorpheusCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -64,16 +65,17 @@ func dataSourceMorpheusCypherSecretRead(ctx context.Context, d *schema.ResourceD
 			return diag.FromErr(err)
 		}
 	}
-	log.Printf("API RESPONSE: %s", resp)
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
 	// store resource data
 	cypher := resp.Result.(*LocalGetCypherResult)
-	if cypher != nil {
+	if cypher.Cypher != nil {
 		d.SetId(int64ToString(cypher.Cypher.ID))
 		if cypher.Type == "object" {
 			jsonPayload, _ := json.Marshal(cypher.Data)
 			d.Set("value", string(jsonPayload))
 		} else {
-			d.Set("value", cypher.Data.(string))
+			d.Set("value", jsonStringValue(cypher.Data))
 		}
 		d.Set("ttl", cypher.LeaseDuration)
 	} else {
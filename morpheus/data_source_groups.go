@@ -56,7 +56,7 @@ func dataSourceMorpheusGroups() *schema.Resource {
 }
 
 func dataSourceMorpheusGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
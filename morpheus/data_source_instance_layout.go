@@ -48,7 +48,7 @@ func dataSourceMorpheusInstanceLayout() *schema.Resource {
 }
 
 func dataSourceMorpheusInstanceLayoutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -96,7 +96,7 @@ func dataSourceMorpheusInstanceLayoutRead(ctx context.Context, d *schema.Resourc
 	return diags
 }
 
-func FindInstanceLayoutByNameAndVersion(client *morpheus.Client, name string, version string) (*morpheus.Response, error) {
+func FindInstanceLayoutByNameAndVersion(client *apiClient, name string, version string) (*morpheus.Response, error) {
 	// Find by name, then get by ID
 	resp, err := client.ListInstanceLayouts(&morpheus.Request{
 		QueryParams: map[string]string{
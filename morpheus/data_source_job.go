@@ -32,7 +32,7 @@ func dataSourceMorpheusJob() *schema.Resource {
 }
 
 func dataSourceMorpheusJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -0,0 +1,166 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusNetworkFloatingIP() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus network floating ip data source to look up the floating ips, such as the OpenStack floating ips and the AWS elastic ips, synchronized from the clouds along with the server they are associated with.",
+		ReadContext: dataSourceMorpheusNetworkFloatingIPRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeInt,
+				Description:  "The ID of the floating ip",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "ip_address"},
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "The floating ip address",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "ip_address"},
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the floating ip",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the floating ip (free, assigned, ...)",
+				Computed:    true,
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the floating ip in the cloud",
+				Computed:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud the floating ip belongs to",
+				Computed:    true,
+			},
+			"server_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the server the floating ip is associated with",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusNetworkFloatingIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ipAddress := d.Get("ip_address").(string)
+	id := d.Get("id").(int)
+
+	// lookup by ip address if we do not have an id yet
+	var floatingIP *NetworkFloatingIP
+	if id == 0 && ipAddress != "" {
+		resp, err := client.Execute(&morpheus.Request{
+			Method: "GET",
+			Path:   networkFloatingIPsPath,
+			QueryParams: map[string]string{
+				"phrase": ipAddress,
+			},
+			Result: &ListNetworkFloatingIPsResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		result := resp.Result.(*ListNetworkFloatingIPsResult)
+		for i := range result.NetworkFloatingIPs {
+			if result.NetworkFloatingIPs[i].IpAddress == ipAddress {
+				floatingIP = &result.NetworkFloatingIPs[i]
+				break
+			}
+		}
+		if floatingIP == nil {
+			return diag.Errorf("Floating ip not found by ip address %s", ipAddress)
+		}
+	} else if id != 0 {
+		resp, err := client.Execute(&morpheus.Request{
+			Method: "GET",
+			Path:   fmt.Sprintf("%s/%d", networkFloatingIPsPath, id),
+			Result: &GetNetworkFloatingIPResult{},
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return diag.Errorf("Floating ip %d not found", id)
+			} else {
+				log.Printf("API FAILURE: %s - %v", resp, err)
+				return diag.FromErr(err)
+			}
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		floatingIP = resp.Result.(*GetNetworkFloatingIPResult).NetworkFloatingIP
+	} else {
+		return diag.Errorf("Floating ip cannot be read without ip address or id")
+	}
+
+	// store resource data
+	if floatingIP != nil {
+		d.SetId(int64ToString(floatingIP.ID))
+		d.Set("ip_address", floatingIP.IpAddress)
+		d.Set("name", floatingIP.Name)
+		d.Set("status", floatingIP.IpStatus)
+		d.Set("external_id", floatingIP.ExternalId)
+		d.Set("cloud_id", floatingIP.Cloud.ID)
+		d.Set("server_id", floatingIP.Server.ID)
+	} else {
+		return diag.Errorf("Floating ip not found in response data.") // should not happen
+	}
+	return diags
+}
+
+// networkFloatingIPsPath is the api endpoint for the floating ips of the clouds
+const networkFloatingIPsPath = "/api/network-floating-ips"
+
+type NetworkFloatingIP struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	IpAddress  string `json:"ipAddress"`
+	IpStatus   string `json:"ipStatus"`
+	ExternalId string `json:"externalId"`
+	Cloud      struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"cloud"`
+	Server struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"server"`
+}
+
+type ListNetworkFloatingIPsResult struct {
+	NetworkFloatingIPs []NetworkFloatingIP `json:"networkFloatingIps"`
+}
+
+type GetNetworkFloatingIPResult struct {
+	NetworkFloatingIP *NetworkFloatingIP `json:"networkFloatingIp"`
+}
+
+type UpdateNetworkFloatingIPResult struct {
+	Success           bool               `json:"success"`
+	Message           string             `json:"msg"`
+	Errors            map[string]string  `json:"errors"`
+	NetworkFloatingIP *NetworkFloatingIP `json:"networkFloatingIp"`
+}
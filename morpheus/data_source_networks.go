@@ -60,7 +60,7 @@ func dataSourceMorpheusNetworks() *schema.Resource {
 }
 
 func dataSourceMorpheusNetworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
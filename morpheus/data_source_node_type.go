@@ -38,7 +38,7 @@ func dataSourceMorpheusNodeType() *schema.Resource {
 }
 
 func dataSourceMorpheusNodeTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -3,10 +3,13 @@ package morpheus
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -323,6 +326,12 @@ func dataSourceMorpheusPermissionSet() *schema.Resource {
 					ValidateFunc: validation.StringIsJSON,
 				},
 			},
+			"validate_codes": {
+				Type:        schema.TypeBool,
+				Description: "Whether to check the feature and report type permission codes against the codes known by the Morpheus appliance, the permission set fails to render when a code is unknown",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
@@ -743,6 +752,12 @@ func dataSourceMorpheusPermissionSetRead(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	if d.Get("validate_codes").(bool) {
+		if err := validatePermissionSetCodes(meta.(*providerMeta).client, permissionData); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	jsonDoc, err := json.MarshalIndent(permissionData, "", "  ")
 	log.Printf("API RESPONSE: %s", jsonDoc)
 
@@ -755,6 +770,75 @@ func dataSourceMorpheusPermissionSetRead(ctx context.Context, d *schema.Resource
 	return diags
 }
 
+// validatePermissionSetCodes checks the feature and report type permission
+// codes of a permission set against the codes known by the appliance. The
+// feature permission codes are those listed by any role.
+func validatePermissionSetCodes(client *apiClient, permissionData PermissionSet) error {
+	var unknown []string
+
+	if len(permissionData.FeaturePermissions) > 0 {
+		resp, err := client.ListRoles(&morpheus.Request{
+			QueryParams: map[string]string{
+				"max": "1",
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		roles := resp.Result.(*morpheus.ListRolesResult).Roles
+		if roles == nil || len(*roles) == 0 {
+			return fmt.Errorf("unable to validate the feature permission codes: no role found")
+		}
+
+		resp, err = client.GetRole((*roles)[0].ID, &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		codes := make(map[string]bool)
+		for _, permission := range resp.Result.(*morpheus.GetRoleResult).FeaturePermissions {
+			codes[permission.Code] = true
+		}
+		for _, permission := range permissionData.FeaturePermissions {
+			if !codes[permission.Code] {
+				unknown = append(unknown, fmt.Sprintf("feature permission %q", permission.Code))
+			}
+		}
+	}
+
+	if len(permissionData.ReportTypePermissions) > 0 {
+		resp, err := client.ListReportTypes(&morpheus.Request{
+			QueryParams: map[string]string{
+				"max": "10000",
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		codes := make(map[string]bool)
+		if reportTypes := resp.Result.(*morpheus.ListReportTypesResult).ReportTypes; reportTypes != nil {
+			for _, reportType := range *reportTypes {
+				codes[reportType.Code] = true
+			}
+		}
+		for _, permission := range permissionData.ReportTypePermissions {
+			if !codes[permission.Code] {
+				unknown = append(unknown, fmt.Sprintf("report type permission %q", permission.Code))
+			}
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown permission codes: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
 type PermissionSet struct {
 	DefaultCloudPermission           string                      `json:"default_cloud_permission,omitempty"`
 	DefaultGroupPermission           string                      `json:"default_group_permission,omitempty"`
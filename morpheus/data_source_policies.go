@@ -55,7 +55,7 @@ func dataSourceMorpheusPolicies() *schema.Resource {
 }
 
 func dataSourceMorpheusPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
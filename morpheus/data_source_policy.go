@@ -64,7 +64,7 @@ func dataSourceMorpheusPolicy() *schema.Resource {
 }
 
 func dataSourceMorpheusPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -129,9 +129,9 @@ func dataSourceMorpheusPolicyRead(ctx context.Context, d *schema.ResourceData, m
 		if policyPayload.Policy.Accounts != nil {
 			// iterate over the array of tasks
 			for i := 0; i < len(policyPayload.Policy.Accounts); i++ {
-				tenant := policyPayload.Policy.Accounts[i].(map[string]interface{})
-				tenantID := int64(tenant["id"].(float64))
-				tenants = append(tenants, tenantID)
+				if tenant, ok := policyPayload.Policy.Accounts[i].(map[string]interface{}); ok {
+					tenants = append(tenants, jsonInt64Value(tenant["id"]))
+				}
 			}
 		}
 		d.Set("tenant_ids", tenants)
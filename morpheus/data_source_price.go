@@ -36,7 +36,7 @@ func dataSourceMorpheusPrice() *schema.Resource {
 }
 
 func dataSourceMorpheusPriceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -0,0 +1,229 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusProcess() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus process data source that lists the history of the processes, such as provisioning and workflow executions, run against an instance or server along with the events of each process.",
+		ReadContext: dataSourceMorpheusProcessRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the instance to list the processes of",
+				Optional:    true,
+			},
+			"server_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the server to list the processes of",
+				Optional:    true,
+			},
+			"process_type": {
+				Type:        schema.TypeString,
+				Description: "The code of the type of the processes to list (provision, workflow, ...)",
+				Optional:    true,
+			},
+			"started_after": {
+				Type:         schema.TypeString,
+				Description:  "Only list the processes started after this date and time, in RFC 3339 format",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"started_before": {
+				Type:         schema.TypeString,
+				Description:  "Only list the processes started before this date and time, in RFC 3339 format",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"processes": {
+				Type:        schema.TypeList,
+				Description: "The matching processes, the most recent first",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: processHistorySchema(map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the instance the process ran against",
+							Computed:    true,
+						},
+						"server_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the server the process ran against",
+							Computed:    true,
+						},
+						"events": {
+							Type:        schema.TypeList,
+							Description: "The events of the process, such as the execution of each task of a workflow",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: processHistorySchema(map[string]*schema.Schema{}),
+							},
+						},
+					}),
+				},
+			},
+		},
+	}
+}
+
+// processHistorySchema adds the attributes shared by processes and process
+// events to the given schema
+func processHistorySchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "The ID of the process",
+		Computed:    true,
+	}
+	s["display_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The display name of the process",
+		Computed:    true,
+	}
+	s["process_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The code of the type of the process",
+		Computed:    true,
+	}
+	s["status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The status of the process",
+		Computed:    true,
+	}
+	s["output"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The output of the process",
+		Computed:    true,
+	}
+	s["error"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The error message of the process",
+		Computed:    true,
+	}
+	s["start_date"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The date and time the process started",
+		Computed:    true,
+	}
+	s["end_date"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The date and time the process ended",
+		Computed:    true,
+	}
+	s["duration"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "The duration of the process in milliseconds",
+		Computed:    true,
+	}
+	return s
+}
+
+func dataSourceMorpheusProcessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	queryParams := map[string]string{
+		"sort":      "id",
+		"direction": "desc",
+	}
+	if instanceId := d.Get("instance_id").(int); instanceId != 0 {
+		queryParams["instanceIds"] = strconv.Itoa(instanceId)
+	}
+	if serverId := d.Get("server_id").(int); serverId != 0 {
+		queryParams["serverIds"] = strconv.Itoa(serverId)
+	}
+	if processType := d.Get("process_type").(string); processType != "" {
+		queryParams["processType"] = processType
+	}
+	var startedAfter, startedBefore time.Time
+	if v := d.Get("started_after").(string); v != "" {
+		startedAfter, _ = time.Parse(time.RFC3339, v)
+	}
+	if v := d.Get("started_before").(string); v != "" {
+		startedBefore, _ = time.Parse(time.RFC3339, v)
+	}
+
+	var processes []map[string]interface{}
+
+	// page through the processes, the most recent first
+	max := 100
+	for offset := 0; ; offset += max {
+		queryParams["max"] = strconv.Itoa(max)
+		queryParams["offset"] = strconv.Itoa(offset)
+		resp, err := client.Execute(&morpheus.Request{
+			Method:      "GET",
+			Path:        "/api/processes",
+			QueryParams: queryParams,
+			Result:      &ListProcessesResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+
+		result := resp.Result.(*ListProcessesResult)
+		olderThanRange := false
+		for _, process := range result.Processes {
+			startDate, err := time.Parse(time.RFC3339, process.StartDate)
+			if !startedAfter.IsZero() && (err != nil || startDate.Before(startedAfter)) {
+				olderThanRange = err == nil
+				continue
+			}
+			if !startedBefore.IsZero() && (err != nil || startDate.After(startedBefore)) {
+				continue
+			}
+			row := flattenExecutionProcess(process.ExecutionProcess)
+			row["instance_id"] = process.InstanceId
+			row["server_id"] = process.ServerId
+			var events []map[string]interface{}
+			for _, event := range process.Events {
+				events = append(events, flattenExecutionProcess(event))
+			}
+			row["events"] = events
+			processes = append(processes, row)
+		}
+		// the processes are sorted from the most recent, stop once past the range
+		if olderThanRange || len(result.Processes) < max {
+			break
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d:%s:%s:%s", d.Get("instance_id").(int), d.Get("server_id").(int), d.Get("process_type").(string), d.Get("started_after").(string), d.Get("started_before").(string)))
+	d.Set("processes", processes)
+	return diags
+}
+
+func flattenExecutionProcess(process ExecutionProcess) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           process.ID,
+		"display_name": process.DisplayName,
+		"process_type": process.ProcessType.Code,
+		"status":       process.Status,
+		"output":       process.Output,
+		"error":        process.Error,
+		"start_date":   process.StartDate,
+		"end_date":     process.EndDate,
+		"duration":     process.Duration,
+	}
+}
+
+type ListProcessesResult struct {
+	Processes []Process `json:"processes"`
+}
+
+type Process struct {
+	ExecutionProcess
+	Events []ExecutionProcess `json:"events"`
+}
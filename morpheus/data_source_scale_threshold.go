@@ -0,0 +1,157 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusScaleThreshold() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus scale threshold data source, to attach a shared scale threshold to instances and layouts with the morpheus_instance_scale resource.",
+		ReadContext: dataSourceMorpheusScaleThresholdRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+				Computed:      true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "The name of the Morpheus scale threshold.",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"auto_upscale": {
+				Type:        schema.TypeBool,
+				Description: "Whether to scale up the number of instances",
+				Computed:    true,
+			},
+			"auto_downscale": {
+				Type:        schema.TypeBool,
+				Description: "Whether to scale down the number of instances",
+				Computed:    true,
+			},
+			"min_count": {
+				Type:        schema.TypeInt,
+				Description: "The minimum number of instances to scale down to",
+				Computed:    true,
+			},
+			"max_count": {
+				Type:        schema.TypeInt,
+				Description: "The maximum number of instances to scale up to",
+				Computed:    true,
+			},
+			"scale_increment": {
+				Type:        schema.TypeInt,
+				Description: "The number of instances added or removed by each scaling operation",
+				Computed:    true,
+			},
+			"enable_cpu_threshold": {
+				Type:        schema.TypeBool,
+				Description: "Whether scaling operations based upon cpu usage is enabled or not",
+				Computed:    true,
+			},
+			"min_cpu_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The minimum cpu percentage for scaling",
+				Computed:    true,
+			},
+			"max_cpu_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The maximum cpu percentage for scaling",
+				Computed:    true,
+			},
+			"enable_memory_threshold": {
+				Type:        schema.TypeBool,
+				Description: "Whether scaling operations based upon memory usage is enabled or not",
+				Computed:    true,
+			},
+			"min_memory_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The minimum memory percentage for scaling",
+				Computed:    true,
+			},
+			"max_memory_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The maximum memory percentage for scaling",
+				Computed:    true,
+			},
+			"enable_disk_threshold": {
+				Type:        schema.TypeBool,
+				Description: "Whether scaling operations based upon disk usage is enabled or not",
+				Computed:    true,
+			},
+			"min_disk_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The minimum disk percentage for scaling",
+				Computed:    true,
+			},
+			"max_disk_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The maximum disk percentage for scaling",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusScaleThresholdRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	id := d.Get("id").(int)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == 0 && name != "" {
+		resp, err = client.FindScaleThresholdByName(name)
+	} else if id != 0 {
+		resp, err = client.GetScaleThreshold(int64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Scale threshold cannot be read without name or id")
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %v", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetScaleThresholdResult)
+	scaleThreshold := result.ScaleThreshold
+	if scaleThreshold != nil {
+		d.SetId(int64ToString(scaleThreshold.ID))
+		d.Set("name", scaleThreshold.Name)
+		d.Set("auto_upscale", scaleThreshold.AutoUp)
+		d.Set("auto_downscale", scaleThreshold.AutoDown)
+		d.Set("min_count", scaleThreshold.MinCount)
+		d.Set("max_count", scaleThreshold.MaxCount)
+		d.Set("scale_increment", scaleThreshold.ScaleIncrement)
+		d.Set("enable_cpu_threshold", scaleThreshold.CpuEnabled)
+		d.Set("min_cpu_percentage", scaleThreshold.MinCpu)
+		d.Set("max_cpu_percentage", scaleThreshold.MaxCpu)
+		d.Set("enable_memory_threshold", scaleThreshold.MemoryEnabled)
+		d.Set("min_memory_percentage", scaleThreshold.MinMemory)
+		d.Set("max_memory_percentage", scaleThreshold.MaxMemory)
+		d.Set("enable_disk_threshold", scaleThreshold.DiskEnabled)
+		d.Set("min_disk_percentage", scaleThreshold.MinDisk)
+		d.Set("max_disk_percentage", scaleThreshold.MaxDisk)
+	} else {
+		return diag.Errorf("Scale threshold not found in response data.") // should not happen
+	}
+	return diags
+}
@@ -84,7 +84,7 @@ func dataSourceMorpheusStorageVolume() *schema.Resource {
 }
 
 func dataSourceMorpheusStorageVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -111,7 +111,7 @@ func dataSourceMorpheusStorageVolumeRead(ctx context.Context, d *schema.Resource
 	result := resp.Result.(*morpheus.GetStorageVolumeResult)
 	storageVolume := result.StorageVolume
 	if storageVolume != nil {
-		d.SetId(int64ToString(int64(storageVolume.ID.(float64))))
+		d.SetId(int64ToString(jsonInt64Value(storageVolume.ID)))
 		d.Set("name", storageVolume.Name)
 		d.Set("active", storageVolume.Active)
 		d.Set("category", storageVolume.Category)
@@ -26,12 +26,23 @@ func dataSourceMorpheusTenantRole() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"default_persona": {
+				Type:        schema.TypeString,
+				Description: "The code of the persona the users assigned the tenant role land on after logging in (standard, serviceCatalog, vdi)",
+				Computed:    true,
+			},
+			"allowed_personas": {
+				Type:        schema.TypeList,
+				Description: "The codes of the personas the users assigned the tenant role are allowed to switch to",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
 func dataSourceMorpheusTenantRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -66,5 +77,7 @@ func dataSourceMorpheusTenantRoleRead(ctx context.Context, d *schema.ResourceDat
 	role := result.Role
 	d.SetId(int64ToString(role.ID))
 	d.Set("name", role.Authority)
+	d.Set("default_persona", role.DefaultPersona.Code)
+	d.Set("allowed_personas", allowedPersonas(result))
 	return diags
 }
@@ -0,0 +1,143 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// managedByLabel is the marker label that identifies objects created by terraform
+const managedByLabel = "managed-by:terraform"
+
+// unmanagedObjectTypes maps the supported object types to the api path
+// used to list them and the key holding the objects in the list response.
+// Only the types whose resources send the marker label are supported, the
+// clusters are not listed since the cluster resources have no labels.
+var unmanagedObjectTypes = map[string][2]string{
+	"catalog_item":     {morpheus.CatalogItemsPath, "catalogItemTypes"},
+	"file_template":    {morpheus.FileTemplatesPath, "containerTemplates"},
+	"form":             {morpheus.FormsPath, "optionTypeForms"},
+	"instance":         {morpheus.InstancesPath, "instances"},
+	"instance_layout":  {morpheus.InstanceLayoutsPath, "instanceTypeLayouts"},
+	"instance_type":    {morpheus.InstanceTypesPath, "instanceTypes"},
+	"job":              {morpheus.JobsPath, "jobs"},
+	"node_type":        {morpheus.NodeTypesPath, "containerTypes"},
+	"option_list":      {morpheus.OptionListsPath, "optionTypeLists"},
+	"option_type":      {morpheus.OptionTypesPath, "optionTypes"},
+	"script_template":  {morpheus.ScriptTemplatesPath, "containerScripts"},
+	"security_package": {morpheus.SecurityPackagesPath, "securityPackages"},
+	"task":             {morpheus.TasksPath, "tasks"},
+	"workflow":         {morpheus.TaskSetsPath, "taskSets"},
+}
+
+func dataSourceMorpheusUnmanagedObjects() *schema.Resource {
+	objectTypes := make([]string, 0, len(unmanagedObjectTypes))
+	for objectType := range unmanagedObjectTypes {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+
+	return &schema.Resource{
+		Description: "Provides a Morpheus unmanaged objects data source that lists the objects of a given type lacking the marker label set on objects created by terraform, such as objects created through the UI.",
+		ReadContext: dataSourceMorpheusUnmanagedObjectsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Description:  fmt.Sprintf("The type of object to search for (%s)", strings.Join(objectTypes, ", ")),
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(objectTypes, false),
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Description: "The marker label identifying objects managed by terraform. Defaults to the managed_label of the provider, or " + managedByLabel + " when it is not configured",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The ids of the objects lacking the marker label",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Description: "The names of the objects lacking the marker label",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusUnmanagedObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	objectType := d.Get("type").(string)
+	label := d.Get("label").(string)
+	if label == "" {
+		label = getManagedLabel(meta)
+	}
+	if label == "" {
+		label = managedByLabel
+	}
+	path := unmanagedObjectTypes[objectType][0]
+	key := unmanagedObjectTypes[objectType][1]
+
+	var ids []string
+	var names []string
+
+	// page through every object of the requested type
+	max := 100
+	for offset := 0; ; offset += max {
+		resp, err := client.Execute(&morpheus.Request{
+			Method: "GET",
+			Path:   path,
+			QueryParams: map[string]string{
+				"max":       strconv.Itoa(max),
+				"offset":    strconv.Itoa(offset),
+				"sort":      "id",
+				"direction": "asc",
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+
+		data, ok := resp.JsonData.(map[string]interface{})
+		if !ok {
+			return diag.Errorf("unexpected response listing %s objects", objectType)
+		}
+		objects, _ := data[key].([]interface{})
+		for _, item := range objects {
+			object, ok := item.(map[string]interface{})
+			if !ok || containsLabel(labelsList(object["labels"]), label) {
+				continue
+			}
+			if id, ok := object["id"].(float64); ok {
+				ids = append(ids, strconv.FormatInt(int64(id), 10))
+				name, _ := object["name"].(string)
+				names = append(names, name)
+			}
+		}
+		if len(objects) < max {
+			break
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", objectType, label))
+	d.Set("ids", ids)
+	d.Set("names", names)
+	return diags
+}
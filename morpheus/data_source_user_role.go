@@ -26,12 +26,23 @@ func dataSourceMorpheusUserRole() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"default_persona": {
+				Type:        schema.TypeString,
+				Description: "The code of the persona the users assigned the user role land on after logging in (standard, serviceCatalog, vdi)",
+				Computed:    true,
+			},
+			"allowed_personas": {
+				Type:        schema.TypeList,
+				Description: "The codes of the personas the users assigned the user role are allowed to switch to",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
 func dataSourceMorpheusUserRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -66,5 +77,23 @@ func dataSourceMorpheusUserRoleRead(ctx context.Context, d *schema.ResourceData,
 	role := result.Role
 	d.SetId(int64ToString(role.ID))
 	d.Set("name", role.Authority)
+	d.Set("default_persona", role.DefaultPersona.Code)
+	d.Set("allowed_personas", allowedPersonas(result))
 	return diags
 }
+
+// allowedPersonas returns the codes of the personas granted by the role,
+// the personas left to the default access follow the global persona access
+func allowedPersonas(role *morpheus.GetRoleResult) []string {
+	var personas []string
+	for _, persona := range role.PersonaPermissions {
+		access := persona.Access
+		if access == "default" || access == "" {
+			access = role.GlobalPersonaAccess
+		}
+		if access == "full" {
+			personas = append(personas, persona.Code)
+		}
+	}
+	return personas
+}
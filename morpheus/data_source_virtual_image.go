@@ -37,7 +37,7 @@ func dataSourceMorpheusVirtualImage() *schema.Resource {
 }
 
 func dataSourceMorpheusVirtualImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
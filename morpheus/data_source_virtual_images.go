@@ -62,7 +62,7 @@ func dataSourceMorpheusVirtualImages() *schema.Resource {
 }
 
 func dataSourceMorpheusVirtualImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -120,7 +120,7 @@ func dataSourceMorpheusVirtualImagesRead(ctx context.Context, d *schema.Resource
 	return diags
 }
 
-func ListAllVirtualImages(client *morpheus.Client, max int, sortOrder string, source string) (images []morpheus.VirtualImage) {
+func ListAllVirtualImages(client *apiClient, max int, sortOrder string, source string) (images []morpheus.VirtualImage) {
 	// Fetch initial images
 	params := make(map[string]string)
 	params["max"] = strconv.Itoa(max)
@@ -2,7 +2,6 @@ package morpheus
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
 
@@ -33,13 +32,13 @@ func dataSourceMorpheusVrealizeOrchestratorWorkflow() *schema.Resource {
 }
 
 func dataSourceMorpheusVrealizeOrchestratorWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
-	value := d.Get("value")
+	value := d.Get("value").(int)
 
 	// lookup by name if we do not have an value yet
 	var resp *morpheus.Response
@@ -58,7 +57,11 @@ func dataSourceMorpheusVrealizeOrchestratorWorkflowRead(ctx context.Context, d *
 	log.Printf("API RESPONSE: %s", resp)
 
 	var workflow morpheus.OptionSourceOption
-	allWorkflows := *resp.Result.(*morpheus.GetOptionSourceResult).Data
+	result := resp.Result.(*morpheus.GetOptionSourceResult)
+	if result.Data == nil {
+		return diag.Errorf("vRO workflow options not found in response data.") // should not happen
+	}
+	allWorkflows := *result.Data
 	for i := range allWorkflows {
 		if value == 0 && name != "" {
 			if strings.EqualFold(allWorkflows[i].Name, name) {
@@ -66,7 +69,7 @@ func dataSourceMorpheusVrealizeOrchestratorWorkflowRead(ctx context.Context, d *
 				break
 			}
 		} else if value != 0 {
-			if value == allWorkflows[i].Value {
+			if int64(value) == jsonInt64Value(allWorkflows[i].Value) {
 				workflow = allWorkflows[i]
 				break
 			}
@@ -75,9 +78,13 @@ func dataSourceMorpheusVrealizeOrchestratorWorkflowRead(ctx context.Context, d *
 		}
 	}
 
+	if workflow.Value == nil {
+		return diag.Errorf("vRO workflow not found")
+	}
+
 	// store resource data
-	d.SetId(fmt.Sprintf("%g", workflow.Value.(float64)))
-	d.Set("value", workflow.Value)
+	d.SetId(jsonStringValue(workflow.Value))
+	d.Set("value", jsonInt64Value(workflow.Value))
 	d.Set("name", workflow.Name)
 
 	return diags
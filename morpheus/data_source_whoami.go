@@ -0,0 +1,141 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusWhoami() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus whoami data source returning the user the provider is authenticated as, along with its roles and tenant, so a configuration can check it runs with the intended account before creating anything.",
+		ReadContext: dataSourceMorpheusWhoamiRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the authenticated user",
+				Computed:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the authenticated user",
+				Computed:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address of the authenticated user",
+				Computed:    true,
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Description: "The first name of the authenticated user",
+				Computed:    true,
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Description: "The last name of the authenticated user",
+				Computed:    true,
+			},
+			"role_ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the roles of the authenticated user",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"role_names": {
+				Type:        schema.TypeList,
+				Description: "The names of the roles of the authenticated user",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant of the authenticated user",
+				Computed:    true,
+			},
+			"tenant_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the tenant of the authenticated user",
+				Computed:    true,
+			},
+			"master_tenant": {
+				Type:        schema.TypeBool,
+				Description: "Whether the tenant of the authenticated user is the master tenant",
+				Computed:    true,
+			},
+			"appliance_version": {
+				Type:        schema.TypeString,
+				Description: "The build version of the appliance",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusWhoamiRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   morpheus.WhoamiPath,
+		Result: &Whoami{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	whoami := resp.Result.(*Whoami)
+	if whoami.User.ID == 0 {
+		return diag.Errorf("User not found in response data.") // should not happen
+	}
+
+	roleIds := []int64{}
+	roleNames := []string{}
+	for _, role := range whoami.User.Roles {
+		roleIds = append(roleIds, role.ID)
+		roleNames = append(roleNames, role.Authority)
+	}
+
+	d.SetId(int64ToString(whoami.User.ID))
+	d.Set("username", whoami.User.Username)
+	d.Set("email", whoami.User.Email)
+	d.Set("first_name", whoami.User.FirstName)
+	d.Set("last_name", whoami.User.LastName)
+	d.Set("role_ids", roleIds)
+	d.Set("role_names", roleNames)
+	d.Set("tenant_id", whoami.User.Account.ID)
+	d.Set("tenant_name", whoami.User.Account.Name)
+	d.Set("master_tenant", whoami.IsMasterAccount)
+	d.Set("appliance_version", whoami.Appliance.BuildVersion)
+	return diags
+}
+
+type Whoami struct {
+	User struct {
+		ID        int64  `json:"id"`
+		Username  string `json:"username"`
+		Email     string `json:"email"`
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+		Roles     []struct {
+			ID        int64  `json:"id"`
+			Authority string `json:"authority"`
+		} `json:"roles"`
+		Account struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"account"`
+	} `json:"user"`
+	IsMasterAccount bool `json:"isMasterAccount"`
+	Appliance       struct {
+		BuildVersion string `json:"buildVersion"`
+	} `json:"appliance"`
+}
@@ -0,0 +1,129 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusWikiPages() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus wiki pages data source to list the wiki pages of a category, optionally along with the pages of its subcategories.",
+		ReadContext: dataSourceMorpheusWikiPagesRead,
+		Schema: map[string]*schema.Schema{
+			"category": {
+				Type:        schema.TypeString,
+				Description: "The category of the wiki pages, all the wiki pages are listed when not set",
+				Optional:    true,
+			},
+			"include_subcategories": {
+				Type:        schema.TypeBool,
+				Description: "Whether to list the wiki pages of the subcategories of the category as well, the subcategories being the categories starting with the category followed by /",
+				Optional:    true,
+				Default:     false,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the wiki pages, sorted by category and name",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"pages": {
+				Type:        schema.TypeList,
+				Description: "The wiki pages, sorted by category and name",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the wiki page",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the wiki page",
+							Computed:    true,
+						},
+						"category": {
+							Type:        schema.TypeString,
+							Description: "The category of the wiki page",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusWikiPagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	category := d.Get("category").(string)
+	includeSubcategories := d.Get("include_subcategories").(bool)
+
+	// page through the wiki pages
+	var wikis []morpheus.Wiki
+	max := 100
+	for offset := 0; ; offset += max {
+		resp, err := client.ListWikis(&morpheus.Request{
+			QueryParams: map[string]string{
+				"max":    strconv.Itoa(max),
+				"offset": strconv.Itoa(offset),
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+
+		result := resp.Result.(*morpheus.ListWikisResult)
+		if result.Wikis == nil {
+			break
+		}
+		for _, wiki := range *result.Wikis {
+			if category == "" || wiki.Category == category || (includeSubcategories && strings.HasPrefix(wiki.Category, category+wikiCategorySeparator)) {
+				wikis = append(wikis, wiki)
+			}
+		}
+		if len(*result.Wikis) < max {
+			break
+		}
+	}
+	sort.Slice(wikis, func(i, j int) bool {
+		if wikis[i].Category != wikis[j].Category {
+			return wikis[i].Category < wikis[j].Category
+		}
+		return wikis[i].Name < wikis[j].Name
+	})
+
+	var ids []string
+	var pages []map[string]interface{}
+	for _, wiki := range wikis {
+		ids = append(ids, int64ToString(wiki.ID))
+		pages = append(pages, map[string]interface{}{
+			"id":       int(wiki.ID),
+			"name":     wiki.Name,
+			"category": wiki.Category,
+		})
+	}
+
+	if category == "" {
+		d.SetId("all")
+	} else {
+		d.SetId(category)
+	}
+	d.Set("ids", ids)
+	d.Set("pages", pages)
+	return diags
+}
@@ -0,0 +1,282 @@
+package morpheus
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// forceDeleteSchema is the schema of the force_delete attribute of the
+// resources checking for referencing objects before being deleted
+func forceDeleteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether to skip the check for objects referencing this object on destroy and ask the appliance to force the deletion where supported",
+		Optional:    true,
+	}
+}
+
+// dependencyDeleteRequest checks that no object references the object being
+// deleted, unless force_delete is set, and returns the delete request
+func dependencyDeleteRequest(d *schema.ResourceData, object string, findDependents func() ([]string, error)) (*morpheus.Request, error) {
+	req := &morpheus.Request{}
+	if d.Get("force_delete").(bool) || USE_FORCE {
+		req.QueryParams = map[string]string{
+			"force": "true",
+		}
+		return req, nil
+	}
+	dependents, err := findDependents()
+	if err != nil {
+		return nil, err
+	}
+	if len(dependents) > 0 {
+		return nil, fmt.Errorf("%s %s is still referenced by %s, remove the references or set force_delete to delete it anyway", object, d.Get("name").(string), strings.Join(dependents, ", "))
+	}
+	return req, nil
+}
+
+// optionTypeDependents lists the workflows, catalog items, instance types,
+// layouts, forms and option types referencing an option type, in the tenant
+// the option type is managed in. An option type references another one when
+// it depends on, is visible on or is required on its field name.
+func optionTypeDependents(meta interface{}, d *schema.ResourceData, id int64) ([]string, error) {
+	client := meta.(*providerMeta).client
+	headers := tenantHeaders(resourceTenantId(meta, d))
+	var dependents []string
+	lists := []struct {
+		kind string
+		path string
+		key  string
+	}{
+		{"workflow", morpheus.TaskSetsPath, "taskSets"},
+		{"catalog item", morpheus.CatalogItemsPath, "catalogItemTypes"},
+		{"instance type", morpheus.InstanceTypesPath, "instanceTypes"},
+		{"instance layout", morpheus.InstanceLayoutsPath, "instanceTypeLayouts"},
+	}
+	for _, list := range lists {
+		objects, err := listDependencyCandidates(client, list.path, list.key, nil, headers)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range objects {
+			optionTypes, _ := object["optionTypes"].([]interface{})
+			if referencesObject(optionTypes, id) {
+				dependents = append(dependents, dependencyName(list.kind, object))
+			}
+		}
+	}
+
+	// the forms reference the existing option types among their inputs and
+	// the inputs of their field groups, the other inputs belong to the form
+	forms, err := listDependencyCandidates(client, morpheus.FormsPath, "optionTypeForms", nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	for _, form := range forms {
+		options, _ := form["options"].([]interface{})
+		fieldGroups, _ := form["fieldGroups"].([]interface{})
+		for _, item := range fieldGroups {
+			if fieldGroup, ok := item.(map[string]interface{}); ok {
+				groupOptions, _ := fieldGroup["options"].([]interface{})
+				options = append(options, groupOptions...)
+			}
+		}
+		var existingOptions []interface{}
+		for _, item := range options {
+			if option, ok := item.(map[string]interface{}); ok && option["formField"] != true {
+				existingOptions = append(existingOptions, option)
+			}
+		}
+		if referencesObject(existingOptions, id) {
+			dependents = append(dependents, dependencyName("form", form))
+		}
+	}
+
+	fieldName := d.Get("field_name").(string)
+	if fieldName == "" {
+		return dependents, nil
+	}
+	optionTypes, err := listDependencyCandidates(client, morpheus.OptionTypesPath, "optionTypes", nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	for _, optionType := range optionTypes {
+		if jsonInt64Value(optionType["id"]) == id {
+			continue
+		}
+		for _, key := range []string{"dependsOnCode", "visibleOnCode", "requireOnCode"} {
+			code, _ := optionType[key].(string)
+			if referencesFieldName(code, fieldName) {
+				dependents = append(dependents, dependencyName("option type", optionType))
+				break
+			}
+		}
+	}
+	return dependents, nil
+}
+
+// referencesObject reports whether one of a list of object references has
+// the given id
+func referencesObject(references []interface{}, id int64) bool {
+	for _, reference := range references {
+		if jsonRefIdValue(reference) == id {
+			return true
+		}
+	}
+	return false
+}
+
+// referencesFieldName reports whether the dependsOnCode, visibleOnCode or
+// requireOnCode of an option type refers to a field name. The codes are comma
+// separated field names, optionally prefixed with their context and followed
+// by the value to match after a colon.
+func referencesFieldName(code string, fieldName string) bool {
+	for _, field := range strings.Split(code, ",") {
+		field, _, _ = strings.Cut(strings.TrimSpace(field), ":")
+		if field == fieldName || strings.HasSuffix(field, "."+fieldName) {
+			return true
+		}
+	}
+	return false
+}
+
+// integrationTaskTypeCodes are the types of the tasks that may reference an
+// integration, through their options or the code repository their script
+// is read from
+var integrationTaskTypeCodes = []string{
+	"ansibleTask",
+	"ansibleTowerTask",
+	"chefTask",
+	"puppetTask",
+	"vro",
+	"script",
+	"groovyTask",
+	"jrubyTask",
+	"jythonTask",
+	"winrmTask",
+}
+
+// integrationTaskOptions are the task options holding the id of an
+// integration that are not named after it
+var integrationTaskOptions = map[string]bool{
+	"ansibleGitId":     true,
+	"chefServerId":     true,
+	"localScriptGitId": true,
+}
+
+// integrationDependents lists the tasks and spec templates referencing an
+// integration or one of the code repositories of the integration, the
+// integrations being managed in the tenant of the provider
+func integrationDependents(meta interface{}, id int64) ([]string, error) {
+	client := meta.(*providerMeta).client
+	headers := tenantHeaders(meta.(*providerMeta).defaultTenantId)
+	repositoryIds := make(map[int64]bool)
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   "/api/options/codeRepositories",
+		QueryParams: map[string]string{
+			"integrationId": int64ToString(id),
+		},
+		Headers: headers,
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, err
+	}
+	if data, ok := resp.JsonData.(map[string]interface{}); ok {
+		repositories, _ := data["data"].([]interface{})
+		for _, item := range repositories {
+			if repository, ok := item.(map[string]interface{}); ok {
+				repositoryIds[jsonRefIdValue(repository["value"])] = true
+			}
+		}
+	}
+
+	var dependents []string
+	tasks, err := listDependencyCandidates(client, morpheus.TasksPath, "tasks", map[string]string{
+		"taskTypeCodes": strings.Join(integrationTaskTypeCodes, ","),
+	}, headers)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		referenced := false
+		taskOptions, _ := task["taskOptions"].(map[string]interface{})
+		for key, value := range taskOptions {
+			if (strings.HasSuffix(key, "IntegrationId") || integrationTaskOptions[key]) && jsonRefIdValue(value) == id {
+				referenced = true
+			}
+		}
+		if file, ok := task["file"].(map[string]interface{}); ok && repositoryIds[jsonRefIdValue(file["repository"])] {
+			referenced = true
+		}
+		if referenced {
+			dependents = append(dependents, dependencyName("task", task))
+		}
+	}
+
+	// only the spec templates read from a code repository may reference the
+	// integration
+	if len(repositoryIds) == 0 {
+		return dependents, nil
+	}
+	specTemplates, err := listDependencyCandidates(client, morpheus.SpecTemplatesPath, "specTemplates", nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	for _, specTemplate := range specTemplates {
+		if file, ok := specTemplate["file"].(map[string]interface{}); ok && repositoryIds[jsonRefIdValue(file["repository"])] {
+			dependents = append(dependents, dependencyName("spec template", specTemplate))
+		}
+	}
+	return dependents, nil
+}
+
+// listDependencyCandidates lists the objects of a type matching the filters
+// of the query from the raw response, a page at a time, with the headers of
+// the tenant of the object being deleted as the list calls of the sdk do not
+// forward the request headers
+func listDependencyCandidates(client *apiClient, path string, key string, query map[string]string, headers map[string]string) ([]map[string]interface{}, error) {
+	var objects []map[string]interface{}
+	max := 100
+	for offset := 0; ; offset += max {
+		queryParams := map[string]string{
+			"max":    strconv.Itoa(max),
+			"offset": strconv.Itoa(offset),
+		}
+		for name, value := range query {
+			queryParams[name] = value
+		}
+		resp, err := client.Execute(&morpheus.Request{
+			Method:      "GET",
+			Path:        path,
+			QueryParams: queryParams,
+			Headers:     headers,
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return nil, err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+
+		data, _ := resp.JsonData.(map[string]interface{})
+		items, _ := data[key].([]interface{})
+		for _, item := range items {
+			if object, ok := item.(map[string]interface{}); ok {
+				objects = append(objects, object)
+			}
+		}
+		if len(items) < max {
+			return objects, nil
+		}
+	}
+}
+
+func dependencyName(kind string, object map[string]interface{}) string {
+	return fmt.Sprintf("%s %v (%d)", kind, object["name"], jsonInt64Value(object["id"]))
+}
@@ -0,0 +1,25 @@
+package morpheus
+
+import "testing"
+
+func TestReferencesFieldName(t *testing.T) {
+	cases := map[string]struct {
+		code       string
+		referenced bool
+	}{
+		"empty":         {code: ""},
+		"field name":    {code: "cloud", referenced: true},
+		"with context":  {code: "config.cloud", referenced: true},
+		"with value":    {code: "cloud:aws", referenced: true},
+		"in a list":     {code: "group, cloud", referenced: true},
+		"other field":   {code: "cloudType"},
+		"value matches": {code: "type:cloud"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if referenced := referencesFieldName(tc.code, "cloud"); referenced != tc.referenced {
+				t.Errorf("referencesFieldName(%q) = %t, want %t", tc.code, referenced, tc.referenced)
+			}
+		})
+	}
+}
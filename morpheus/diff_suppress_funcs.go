@@ -3,9 +3,13 @@ package morpheus
 import (
 	"bytes"
 	"encoding/json"
+	"reflect"
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
 )
 
 func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) bool {
@@ -22,6 +26,19 @@ func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) boo
 	return jsonBytesEqual(ob.Bytes(), nb.Bytes())
 }
 
+// suppressEquivalentYamlDiffs ignores the formatting differences between two
+// YAML or JSON documents describing the same data
+func suppressEquivalentYamlDiffs(k, old, new string, d *schema.ResourceData) bool {
+	var o, n interface{}
+	if err := yaml.Unmarshal([]byte(old), &o); err != nil {
+		return false
+	}
+	if err := yaml.Unmarshal([]byte(new), &n); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(o, n)
+}
+
 func supressOptionListScripts(k, old, new string, d *schema.ResourceData) bool {
 	if strings.TrimSpace(old) == strings.TrimSpace(new) {
 		return true
@@ -29,3 +46,49 @@ func supressOptionListScripts(k, old, new string, d *schema.ResourceData) bool {
 		return false
 	}
 }
+
+// suppressImportedLogoPath ignores the local file path of a logo image once the
+// object exists and the image name has not changed. The API only returns the
+// image name so the path is unknown after an import.
+func suppressImportedLogoPath(nameKey string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return d.Id() != "" && old == "" && !d.HasChange(nameKey)
+	}
+}
+
+// suppressEquivalentNumberDiffs ignores the formatting differences between two
+// numbers stored as strings, such as 100 and 100.00
+func suppressEquivalentNumberDiffs(k, old, new string, d *schema.ResourceData) bool {
+	o, err := strconv.ParseFloat(strings.TrimSpace(old), 64)
+	if err != nil {
+		return false
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(new), 64)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
+
+// suppressUnconfiguredMapKeys ignores the keys of a map attribute read from the
+// API but absent from the configuration, such as the defaults the appliance
+// adds to the settings of an object. The count of the map is recomputed from
+// the keys left in the diff.
+func suppressUnconfiguredMapKeys(k, old, new string, d *schema.ResourceData) bool {
+	name, key, _ := strings.Cut(k, ".")
+	if key == "%" {
+		return true
+	}
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.Type().HasAttribute(name) {
+		return false
+	}
+	config := rawConfig.GetAttr(name)
+	if config.IsNull() {
+		return true
+	}
+	if !config.IsKnown() {
+		return false
+	}
+	return !config.HasIndex(cty.StringVal(key)).True()
+}
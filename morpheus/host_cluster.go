@@ -0,0 +1,267 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// hostClusterSchema is the schema shared by the clusters made of a set of
+// identical hosts, such as the docker and kvm clusters
+func hostClusterSchema(noun string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("The ID of the %s", noun),
+			Computed:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("The name of the %s", noun),
+			Required:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("The description of the %s", noun),
+			Optional:    true,
+			Computed:    true,
+		},
+		"group_id": {
+			Type:        schema.TypeInt,
+			Description: fmt.Sprintf("The ID of the group associated with the %s", noun),
+			Required:    true,
+			ForceNew:    true,
+		},
+		"cloud_id": {
+			Type:        schema.TypeInt,
+			Description: fmt.Sprintf("The ID of the cloud associated with the %s", noun),
+			Required:    true,
+			ForceNew:    true,
+		},
+		"cluster_layout_id": {
+			Type:        schema.TypeInt,
+			Description: fmt.Sprintf("The ID of the cluster layout to provision the %s from", noun),
+			Required:    true,
+			ForceNew:    true,
+		},
+		"plan_id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the service plan of the hosts",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"host_count": {
+			Type:         schema.TypeInt,
+			Description:  fmt.Sprintf("The number of hosts of the %s", noun),
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"network_id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the network the hosts are attached to",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"resource_pool_id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the resource pool to provision the hosts to",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"wait_for_ready": {
+			Type:        schema.TypeBool,
+			Description: fmt.Sprintf("Whether to wait on create until the %s is provisioned and ready, the wait is bounded by the create timeout", noun),
+			Optional:    true,
+			Default:     true,
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("The status of the %s", noun),
+			Computed:    true,
+		},
+	}
+}
+
+// hostClusterCreate creates a cluster of the given type and waits for it to be ready
+func hostClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, clusterType string, noun string) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	serverPayload := map[string]interface{}{
+		"config": map[string]interface{}{
+			"resourcePoolId": d.Get("resource_pool_id").(int),
+			"nodeCount":      d.Get("host_count").(int),
+		},
+		"nodeCount": d.Get("host_count").(int),
+		"plan": map[string]interface{}{
+			"id": d.Get("plan_id").(int),
+		},
+	}
+	if networkId := d.Get("network_id").(int); networkId != 0 {
+		serverPayload["networkInterfaces"] = []map[string]interface{}{
+			{"network": map[string]interface{}{"id": fmt.Sprintf("network-%d", networkId)}},
+		}
+	}
+
+	clusterPayload := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"type":        clusterType,
+		"group": map[string]interface{}{
+			"id": d.Get("group_id").(int),
+		},
+		"cloud": map[string]interface{}{
+			"id": d.Get("cloud_id").(int),
+		},
+		"layout": map[string]interface{}{
+			"id": d.Get("cluster_layout_id").(int),
+		},
+		"server": serverPayload,
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"cluster": clusterPayload,
+		},
+	}
+
+	resp, err := client.CreateCluster(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateClusterResult)
+	if !result.Success {
+		return diag.Errorf("error creating %s: %s", noun, result.Message)
+	}
+	cluster := result.Cluster
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(cluster.ID))
+
+	if d.Get("wait_for_ready").(bool) {
+		if err := waitForCluster(ctx, client, cluster.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error creating %s: %s", noun, err)
+		}
+	}
+
+	return hostClusterRead(ctx, d, meta, noun)
+}
+
+func hostClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}, noun string) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetCluster(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetClusterResult)
+	cluster := result.Cluster
+	if cluster == nil {
+		return diag.Errorf("read operation: %s not found in response data", noun) // should not happen
+	}
+
+	d.SetId(int64ToString(cluster.ID))
+	d.Set("name", cluster.Name)
+	d.Set("description", cluster.Description)
+	d.Set("group_id", cluster.Site.Id)
+	d.Set("cloud_id", cluster.Zone.Id)
+	d.Set("cluster_layout_id", cluster.Layout.Id)
+	d.Set("status", cluster.Status)
+
+	hosts, err := getClusterWorkers(client, cluster.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hosts = filterOutClusterWorkersByStatus(hosts, statusDeprovisioning)
+	if len(hosts) > 0 {
+		d.Set("host_count", len(hosts))
+		d.Set("plan_id", hosts[0].Plan.ID)
+	}
+
+	return diags
+}
+
+func hostClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, noun string) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	clusterId := toInt64(d.Id())
+
+	if d.HasChange("host_count") {
+		o, n := d.GetChange("host_count")
+		countDelta := n.(int) - o.(int)
+		if countDelta > 0 {
+			if err := addClusterWorkers(ctx, meta, clusterId, countDelta, d.Get("plan_id").(int), d); err != nil {
+				return diag.Errorf("error adding %s host(s): %s", noun, err)
+			}
+		} else if countDelta < 0 {
+			if err := doClusterWorkerDelete(ctx, client, clusterId, countDelta); err != nil {
+				return diag.Errorf("error deleting %s host(s): %s", noun, err)
+			}
+		}
+	}
+
+	if d.HasChanges("name", "description") {
+		req := &morpheus.Request{
+			Body: map[string]interface{}{
+				"cluster": map[string]interface{}{
+					"name":        d.Get("name").(string),
+					"description": d.Get("description").(string),
+				},
+			},
+		}
+		resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+			return client.UpdateCluster(clusterId, req)
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+
+	return hostClusterRead(ctx, d, meta, noun)
+}
+
+func hostClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, noun string) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	if err := deleteCluster(ctx, client, toInt64(id), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error deleting %s: %s", noun, err)
+	}
+
+	d.SetId("")
+	return diags
+}
+
+// hostClusterImport sets the defaults of the attributes not returned by the api on import
+func hostClusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("wait_for_ready", true)
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,373 @@
+package morpheus
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// importStateVerifyIgnore lists the attributes of each resource that
+// cannot be read back from the appliance after an import, such as the
+// secrets only ever written to it
+var importStateVerifyIgnore = map[string][]string{
+	"morpheus_backup_provider":             {"password"},
+	"morpheus_cypher_secret":               {"value_wo_version"},
+	"morpheus_external_kubernetes_cluster": {"service_token", "kubeconfig"},
+	"morpheus_image_build":                 {"ssh_password"},
+	"morpheus_integration":                 {"credential.0.password"},
+	"morpheus_key_pair":                    {"passphrase"},
+	"morpheus_license":                     {"key"},
+	"morpheus_network_domain":              {"domain_password"},
+	"morpheus_server":                      {"ssh_password"},
+	"morpheus_service_account":             {"password", "access_token", "refresh_token", "access_token_expiration"},
+	"morpheus_user":                        {"password", "windows_password"},
+	"morpheus_virtual_image":               {"ssh_password", "ssh_key"},
+}
+
+// importStateVerifyIgnoreAll lists the attributes only driving the
+// behavior of the provider, never stored in the appliance
+var importStateVerifyIgnoreAll = []string{"force_delete", "keepers"}
+
+// importStateIdFuncs builds the import id of the resources not imported by their id
+var importStateIdFuncs = map[string]func(*terraform.ResourceState) string{
+	"morpheus_cloud_datastore_configuration": func(rs *terraform.ResourceState) string {
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["cloud_id"], rs.Primary.ID)
+	},
+	"morpheus_cloud_resource_pool": func(rs *terraform.ResourceState) string {
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["cloud_id"], rs.Primary.ID)
+	},
+	"morpheus_cypher_secret": func(rs *terraform.ResourceState) string {
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["mount"], rs.Primary.Attributes["key"])
+	},
+	"morpheus_cypher_tfvars": func(rs *terraform.ResourceState) string {
+		return rs.Primary.Attributes["key"]
+	},
+	"morpheus_instance_scale": func(rs *terraform.ResourceState) string {
+		if layoutId := rs.Primary.Attributes["layout_id"]; layoutId != "" && layoutId != "0" {
+			return fmt.Sprintf("layout:%s", rs.Primary.ID)
+		}
+		return rs.Primary.ID
+	},
+	"morpheus_network_pool_ip": func(rs *terraform.ResourceState) string {
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["pool_id"], rs.Primary.ID)
+	},
+	"morpheus_vsphere_cloud_datastore_configuration": func(rs *terraform.ResourceState) string {
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["cloud_id"], rs.Primary.Attributes["name"])
+	},
+}
+
+// importStateVerifyFixtures holds the self-contained configurations of the
+// resources whose examples read objects expected to exist on the appliance
+// through data sources, the fixtures of a resource replacing its examples
+const importStateVerifyFixtures = "testdata/import"
+
+// importStateVerifyDataSourcesEnv runs the examples reading objects of the
+// appliance through data sources when set, the appliance holding the objects
+const importStateVerifyDataSourcesEnv = "MORPHEUS_ACC_EXAMPLE_DATA_SOURCES"
+
+// importStateVerifyConfig is a configuration created then imported by
+// TestAccImportStateVerify, with what it declares and references
+type importStateVerifyConfig struct {
+	file   string
+	config string
+	// the addresses of the resources of the imported type
+	addresses []string
+	// the variables declared without a default, set with TF_VAR_<name>
+	requiredVariables []string
+	// the data sources read from the appliance
+	dataSources []string
+	// the references to variables, data sources and resources not declared
+	undeclared []string
+	// the attributes and blocks of the resources not in their schema
+	unknownAttributes []string
+	fixture           bool
+}
+
+// importStateVerifyConfigs returns the fixtures of a resource, or its
+// example configurations when it has no fixture
+func importStateVerifyConfigs(name string) ([]importStateVerifyConfig, error) {
+	fixture := true
+	files, err := filepath.Glob(filepath.Join(importStateVerifyFixtures, name, "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		fixture = false
+		files, err = filepath.Glob(filepath.Join("..", "examples", "resources", name, "*.tf"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var configs []importStateVerifyConfig
+	for _, file := range files {
+		config, err := parseImportStateVerifyConfig(name, file)
+		if err != nil {
+			return nil, err
+		}
+		config.fixture = fixture
+		if len(config.addresses) > 0 {
+			configs = append(configs, config)
+		}
+	}
+	return configs, nil
+}
+
+// parseImportStateVerifyConfig parses a configuration, each configuration
+// being planned on its own
+func parseImportStateVerifyConfig(name string, file string) (importStateVerifyConfig, error) {
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return importStateVerifyConfig{}, err
+	}
+	config := importStateVerifyConfig{file: file, config: string(src)}
+	parsed, diags := hclsyntax.ParseConfig(src, file, hcl.InitialPos)
+	if diags.HasErrors() {
+		return config, diags
+	}
+	body := parsed.Body.(*hclsyntax.Body)
+
+	declared := make(map[string]bool)
+	for _, block := range body.Blocks {
+		switch {
+		case block.Type == "variable" && len(block.Labels) == 1:
+			declared["var."+block.Labels[0]] = true
+			if _, ok := block.Body.Attributes["default"]; !ok {
+				config.requiredVariables = append(config.requiredVariables, block.Labels[0])
+			}
+		case block.Type == "data" && len(block.Labels) == 2:
+			declared["data."+block.Labels[0]+"."+block.Labels[1]] = true
+			config.dataSources = append(config.dataSources, "data."+block.Labels[0]+"."+block.Labels[1])
+		case block.Type == "resource" && len(block.Labels) == 2:
+			declared[block.Labels[0]+"."+block.Labels[1]] = true
+			if block.Labels[0] == name {
+				config.addresses = append(config.addresses, block.Labels[0]+"."+block.Labels[1])
+			}
+			if resource, ok := Provider().ResourcesMap[block.Labels[0]]; ok {
+				config.unknownAttributes = append(config.unknownAttributes, unknownAttributes(block.Labels[0]+"."+block.Labels[1], block.Body, resource.Schema, true)...)
+			}
+		}
+	}
+
+	undeclared := make(map[string]bool)
+	hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics {
+		expr, ok := node.(hclsyntax.Expression)
+		if !ok {
+			return nil
+		}
+		for _, traversal := range expr.Variables() {
+			reference := traversalReference(traversal)
+			if reference != "" && !declared[reference] {
+				undeclared[reference] = true
+			}
+		}
+		return nil
+	})
+	for reference := range undeclared {
+		config.undeclared = append(config.undeclared, reference)
+	}
+	sort.Strings(config.undeclared)
+	return config, nil
+}
+
+// traversalReference returns the variable, data source or resource of the
+// provider a traversal references, empty for the other references such as
+// each.value or path.module
+func traversalReference(traversal hcl.Traversal) string {
+	var names []string
+	for _, step := range traversal {
+		switch step := step.(type) {
+		case hcl.TraverseRoot:
+			names = append(names, step.Name)
+		case hcl.TraverseAttr:
+			names = append(names, step.Name)
+		}
+	}
+	switch {
+	case len(names) >= 2 && names[0] == "var":
+		return strings.Join(names[:2], ".")
+	case len(names) >= 3 && names[0] == "data":
+		return strings.Join(names[:3], ".")
+	case len(names) >= 2 && strings.HasPrefix(names[0], "morpheus_"):
+		return strings.Join(names[:2], ".")
+	}
+	return ""
+}
+
+// unknownAttributes returns the attributes and blocks of a resource body
+// missing from the schema of the resource
+func unknownAttributes(path string, body *hclsyntax.Body, s map[string]*schema.Schema, root bool) []string {
+	metaArguments := map[string]bool{"count": true, "for_each": true, "depends_on": true, "provider": true, "lifecycle": true, "timeouts": true}
+	var unknown []string
+	for key := range body.Attributes {
+		if _, ok := s[key]; !ok && !(root && metaArguments[key]) {
+			unknown = append(unknown, path+"."+key)
+		}
+	}
+	for _, block := range body.Blocks {
+		if root && metaArguments[block.Type] {
+			continue
+		}
+		attribute, ok := s[block.Type]
+		if !ok {
+			unknown = append(unknown, path+"."+block.Type)
+			continue
+		}
+		if elem, ok := attribute.Elem.(*schema.Resource); ok {
+			unknown = append(unknown, unknownAttributes(path+"."+block.Type, block.Body, elem.Schema, false)...)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// schemaPath returns whether an attribute path, such as credential.0.password,
+// is an attribute of a schema
+func schemaPath(s map[string]*schema.Schema, path string) bool {
+	keys := strings.Split(path, ".")
+	attribute, ok := s[keys[0]]
+	if !ok {
+		return false
+	}
+	if len(keys) == 1 {
+		return true
+	}
+	elem, ok := attribute.Elem.(*schema.Resource)
+	if !ok || len(keys) < 3 {
+		return false
+	}
+	if _, err := strconv.Atoi(keys[1]); err != nil {
+		return false
+	}
+	return schemaPath(elem.Schema, strings.Join(keys[2:], "."))
+}
+
+// importableResources returns the names of the resources supporting import
+func importableResources() []string {
+	var names []string
+	for name, resource := range Provider().ResourcesMap {
+		if resource.Importer != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TestImportStateVerifyExamples ensures every importable resource has a
+// configuration for TestAccImportStateVerify to import, planned on its own:
+// it declares every variable, data source and resource it references and
+// sets only the attributes of the resources, and the ignored attributes
+// are attributes of the resources
+func TestImportStateVerifyExamples(t *testing.T) {
+	for _, name := range importableResources() {
+		configs, err := importStateVerifyConfigs(name)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if len(configs) == 0 {
+			t.Errorf("%s: no configuration declares the resource", name)
+		}
+		for _, config := range configs {
+			for _, reference := range config.undeclared {
+				t.Errorf("%s: %s is not declared", config.file, reference)
+			}
+			for _, attribute := range config.unknownAttributes {
+				t.Errorf("%s: %s is not an attribute of the resource", config.file, attribute)
+			}
+			if config.fixture && len(config.dataSources) > 0 {
+				t.Errorf("%s: the fixture reads %s from the appliance", config.file, strings.Join(config.dataSources, ", "))
+			}
+		}
+	}
+	for name, ignore := range importStateVerifyIgnore {
+		resource, ok := Provider().ResourcesMap[name]
+		if !ok || resource.Importer == nil {
+			t.Errorf("%s: not an importable resource", name)
+			continue
+		}
+		for _, path := range ignore {
+			if !schemaPath(resource.Schema, path) {
+				t.Errorf("%s: %s is not an attribute of the resource", name, path)
+			}
+		}
+	}
+}
+
+// TestAccImportStateVerify creates the configurations of every importable
+// resource, then imports them and checks the imported state is the created
+// one, so an import followed by a plan yields no diff. The variables without
+// a default are set with TF_VAR_<name>, and the examples reading objects of
+// the appliance through data sources only run when
+// MORPHEUS_ACC_EXAMPLE_DATA_SOURCES is set.
+func TestAccImportStateVerify(t *testing.T) {
+	for _, name := range importableResources() {
+		name := name
+		t.Run(strings.TrimPrefix(name, "morpheus_"), func(t *testing.T) {
+			configs, err := importStateVerifyConfigs(name)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			for _, config := range configs {
+				config := config
+				t.Run(filepath.Base(config.file), func(t *testing.T) {
+					testAccImportStateVerifyConfig(t, name, config)
+				})
+			}
+		})
+	}
+}
+
+func testAccImportStateVerifyConfig(t *testing.T, name string, config importStateVerifyConfig) {
+	var unset []string
+	for _, variable := range config.requiredVariables {
+		if _, ok := os.LookupEnv("TF_VAR_" + variable); !ok {
+			unset = append(unset, "TF_VAR_"+variable)
+		}
+	}
+	if len(unset) > 0 {
+		t.Skipf("%s must be set", strings.Join(unset, ", "))
+	}
+	if len(config.dataSources) > 0 && os.Getenv(importStateVerifyDataSourcesEnv) == "" {
+		t.Skipf("reads %s from the appliance, set %s to run it or add a fixture in %s", strings.Join(config.dataSources, ", "), importStateVerifyDataSourcesEnv, filepath.Join(importStateVerifyFixtures, name))
+	}
+
+	steps := []resource.TestStep{
+		{
+			Config: config.config,
+		},
+	}
+	for _, address := range config.addresses {
+		address := address
+		step := resource.TestStep{
+			ResourceName:            address,
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: append(append([]string{}, importStateVerifyIgnore[name]...), importStateVerifyIgnoreAll...),
+		}
+		if idFunc, ok := importStateIdFuncs[name]; ok {
+			step.ImportStateIdFunc = func(s *terraform.State) (string, error) {
+				rs, ok := s.RootModule().Resources[address]
+				if !ok {
+					return "", fmt.Errorf("%s not found in state", address)
+				}
+				return idFunc(rs), nil
+			}
+		}
+		steps = append(steps, step)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps:             steps,
+	})
+}
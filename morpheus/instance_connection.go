@@ -0,0 +1,234 @@
+package morpheus
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// instanceConnectionInfoSchema is the schema of the connection_info attribute of the instance resources
+func instanceConnectionInfoSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Connection information for the instance, a list - this is returned by the API",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip": {
+					Description: "The IP address to connect to",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"port": {
+					Description: "The port to connect to",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"name": {
+					Description: "The name of the connection protocol",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// instanceContainersSchema is the schema of the containers attribute of the
+// instance resources, exposing the addresses of the nodes of the instance for
+// use in connection blocks
+func instanceContainersSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The containers, or nodes, of the instance",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Description: "The ID of the container",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"name": {
+					Description: "The name of the container",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"status": {
+					Description: "The status of the container",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"server_id": {
+					Description: "The ID of the server backing the container",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"ip_address": {
+					Description: "The IP address of the container",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"internal_ip_address": {
+					Description: "The internal IP address of the container",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"external_ip_address": {
+					Description: "The external IP address of the container",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"hostname": {
+					Description: "The hostname of the container",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"external_fqdn": {
+					Description: "The external fully qualified domain name of the container",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"ssh_host": {
+					Description: "The host to connect to over SSH",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"agent_installed": {
+					Description: "Whether the Morpheus agent is installed on the container",
+					Type:        schema.TypeBool,
+					Computed:    true,
+				},
+				"agent_version": {
+					Description: "The version of the Morpheus agent installed on the container",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"ports": {
+					Description: "The ports exposed by the container",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Description: "The name of the port, such as SSH or HTTP",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+							"internal_port": {
+								Description: "The port number inside the container",
+								Type:        schema.TypeInt,
+								Computed:    true,
+							},
+							"external_port": {
+								Description: "The port number to connect to",
+								Type:        schema.TypeInt,
+								Computed:    true,
+							},
+							"primary": {
+								Description: "Whether the port is the primary port of the container",
+								Type:        schema.TypeBool,
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// setInstanceConnectionAttributes stores the connection info and the
+// containers of an instance, the containers being left as is when they
+// cannot be listed so the instance can still be read
+func setInstanceConnectionAttributes(client *apiClient, d *schema.ResourceData, instance *morpheus.Instance) {
+	var connectionInfo []map[string]interface{}
+	// Iterate over the array of connection info
+	for i := 0; i < len(instance.ConnectionInfo); i++ {
+		row := make(map[string]interface{})
+		connection := instance.ConnectionInfo[i]
+		row["ip"] = connection.Ip
+		row["port"] = connection.Port
+		row["name"] = connection.Name
+		connectionInfo = append(connectionInfo, row)
+	}
+	d.Set("connection_info", connectionInfo)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%d/containers", morpheus.InstancesPath, instance.ID),
+		Result: &InstanceContainersResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var containers []map[string]interface{}
+	for _, container := range resp.Result.(*InstanceContainersResult).Containers {
+		var ports []map[string]interface{}
+		for _, port := range container.Ports {
+			ports = append(ports, map[string]interface{}{
+				"name":          port.ExportName,
+				"internal_port": port.Internal,
+				"external_port": port.External,
+				"primary":       port.PrimaryPort,
+			})
+		}
+		hostname := container.Server.Hostname
+		if hostname == "" {
+			hostname = container.InternalHostname
+		}
+		ipAddress := container.IP
+		if ipAddress == "" {
+			ipAddress = container.InternalIp
+		}
+		containers = append(containers, map[string]interface{}{
+			"id":                  container.ID,
+			"name":                container.Name,
+			"status":              container.Status,
+			"server_id":           container.Server.ID,
+			"ip_address":          ipAddress,
+			"internal_ip_address": container.InternalIp,
+			"external_ip_address": container.Server.ExternalIp,
+			"hostname":            hostname,
+			"external_fqdn":       container.ExternalFqdn,
+			"ssh_host":            container.Server.SshHost,
+			"agent_installed":     container.Server.AgentInstalled,
+			"agent_version":       container.Server.AgentVersion,
+			"ports":               ports,
+		})
+	}
+	d.Set("containers", containers)
+}
+
+type InstanceContainersResult struct {
+	Containers []InstanceContainer `json:"containers"`
+}
+
+type InstanceContainer struct {
+	ID               int64  `json:"id"`
+	Name             string `json:"name"`
+	Status           string `json:"status"`
+	IP               string `json:"ip"`
+	InternalIp       string `json:"internalIp"`
+	InternalHostname string `json:"internalHostname"`
+	ExternalFqdn     string `json:"externalFqdn"`
+	Ports            []struct {
+		ExportName  string `json:"exportName"`
+		Internal    int64  `json:"internal"`
+		External    int64  `json:"external"`
+		PrimaryPort bool   `json:"primaryPort"`
+	} `json:"ports"`
+	Server struct {
+		ID             int64  `json:"id"`
+		Hostname       string `json:"hostname"`
+		SshHost        string `json:"sshHost"`
+		ExternalIp     string `json:"externalIp"`
+		AgentInstalled bool   `json:"agentInstalled"`
+		AgentVersion   string `json:"agentVersion"`
+	} `json:"server"`
+}
@@ -0,0 +1,64 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// interfacesCustomizeDiff checks at plan time that the network interfaces of
+// the given block using the static ip mode set an ip address and that the
+// address belongs to the cidr of the selected network. The network groups and
+// the networks without a cidr are not checked.
+func interfacesCustomizeDiff(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.HasChange(key) || !d.NewValueKnown(key) {
+			return nil
+		}
+		client, ok := metaClient(meta)
+		if !ok {
+			return nil
+		}
+
+		for i, item := range d.Get(key).([]interface{}) {
+			networkInterface, ok := item.(map[string]interface{})
+			if !ok || networkInterface["ip_mode"] != "static" {
+				continue
+			}
+			ipAddress := networkInterface["ip_address"].(string)
+			if ipAddress == "" {
+				if d.NewValueKnown(fmt.Sprintf("%s.%d.ip_address", key, i)) {
+					return fmt.Errorf("interface %d uses the static ip mode but does not set an ip_address", i)
+				}
+				continue
+			}
+			networkId, _ := networkInterface["network_id"].(int)
+			networkGroup, _ := networkInterface["network_group"].(bool)
+			if networkId == 0 || networkGroup || !d.NewValueKnown(fmt.Sprintf("%s.%d.network_id", key, i)) {
+				continue
+			}
+
+			resp, err := client.GetNetwork(int64(networkId), &morpheus.Request{})
+			if err != nil {
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return err
+			}
+			network := resp.Result.(*morpheus.GetNetworkResult).Network
+			if network == nil || network.Cidr == "" {
+				continue
+			}
+			_, cidr, err := net.ParseCIDR(network.Cidr)
+			if err != nil {
+				continue
+			}
+			if !cidr.Contains(net.ParseIP(ipAddress)) {
+				return fmt.Errorf("the ip_address %s of interface %d is not in the %s cidr of the network %s", ipAddress, i, network.Cidr, network.Name)
+			}
+		}
+		return nil
+	}
+}
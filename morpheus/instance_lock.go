@@ -0,0 +1,59 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// instanceLockedSchema is the schema of the lock of an instance, Morpheus
+// refuses to delete a locked instance and terraform does the same
+func instanceLockedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether the instance is locked, a locked instance cannot be deleted until it is unlocked, neither from Morpheus nor by terraform",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+// setInstanceLock locks or unlocks an instance when the locked attribute was
+// changed to the given value. Update calls it to unlock an instance being
+// unlocked before its other changes and to lock an instance being locked
+// after them, an instance whose lock does not change is left as is, the
+// lock only preventing its deletion.
+func setInstanceLock(ctx context.Context, client *apiClient, d *schema.ResourceData, locked bool) error {
+	if d.Get("locked").(bool) != locked || (!d.IsNewResource() && !d.HasChange("locked")) {
+		return nil
+	}
+	if d.IsNewResource() && !locked {
+		return nil
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		if locked {
+			return client.LockInstance(toInt64(d.Id()), &morpheus.Request{})
+		}
+		return client.UnlockInstance(toInt64(d.Id()), &morpheus.Request{})
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return nil
+}
+
+// checkInstanceUnlocked fails the deletion of a locked instance
+func checkInstanceUnlocked(d *schema.ResourceData) diag.Diagnostics {
+	if !d.Get("locked").(bool) {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "The instance is locked",
+		Detail:   "The instance " + d.Get("name").(string) + " is locked in Morpheus and cannot be deleted. Set locked to false and apply the change before destroying it.",
+	}}
+}
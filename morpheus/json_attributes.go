@@ -0,0 +1,17 @@
+package morpheus
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// jsonStringSchema completes the schema of a string attribute holding a raw
+// JSON document. The document is validated at plan time and compared
+// semantically, the key order and the whitespace of the document returned by
+// the API do not cause a diff and the configured document is kept in state.
+func jsonStringSchema(s *schema.Schema) *schema.Schema {
+	s.ValidateFunc = validation.StringIsJSON
+	s.DiffSuppressFunc = suppressEquivalentJsonDiffs
+	s.DiffSuppressOnRefresh = true
+	return s
+}
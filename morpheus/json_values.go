@@ -0,0 +1,160 @@
+package morpheus
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// The API returns some values with a type depending on the version of the
+// appliance and on the object: ids as numbers or strings, booleans as "on"
+// or as strings, null for the unset values. The helpers below decode such
+// values from the untyped fields of the responses without asserting their
+// type, a value of an unexpected type decodes as the zero value instead of
+// crashing the provider.
+
+// jsonInt64Value returns the integer held by a decoded JSON value
+func jsonInt64Value(value interface{}) int64 {
+	switch v := value.(type) {
+	case float64:
+		return int64(v)
+	case int:
+		return int64(v)
+	case int64:
+		return v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return int64(f)
+	case string:
+		if i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+			return i
+		}
+		f, _ := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return int64(f)
+	case bool:
+		if v {
+			return 1
+		}
+	}
+	return 0
+}
+
+// jsonFloat64Value returns the number held by a decoded JSON value
+func jsonFloat64Value(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case json.Number:
+		f, _ := v.Float64()
+		return f
+	case string:
+		f, _ := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f
+	}
+	return 0
+}
+
+// jsonBoolValue returns the boolean held by a decoded JSON value, the
+// checkboxes of the forms of the appliance being stored as "on"
+func jsonBoolValue(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "on", "true", "yes", "1":
+			return true
+		}
+	case float64:
+		return v != 0
+	case int:
+		return v != 0
+	case int64:
+		return v != 0
+	}
+	return false
+}
+
+// jsonStringValue returns the string held by a decoded JSON value, the
+// numbers being formatted without exponent and the objects and arrays
+// encoded in JSON
+func jsonStringValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	}
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(payload)
+}
+
+// jsonStringListValue returns the strings held by a decoded JSON array, or
+// by a comma separated string
+func jsonStringListValue(value interface{}) []string {
+	var values []string
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if item := jsonStringValue(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	case string:
+		for _, item := range strings.Split(v, ",") {
+			if item := strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	return values
+}
+
+// JSONString is a string of a response decoded with jsonStringValue
+type JSONString string
+
+func (s *JSONString) UnmarshalJSON(data []byte) error {
+	*s = JSONString(jsonStringValue(decodeJSONValue(data)))
+	return nil
+}
+
+// decodeJSONValue decodes a JSON value keeping the numbers as json.Number,
+// nil for an invalid value
+func decodeJSONValue(data []byte) interface{} {
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+	return value
+}
+
+// jsonRefIdValue returns the id of a decoded JSON reference to another
+// object, either the object itself or only its id
+func jsonRefIdValue(value interface{}) int64 {
+	if ref, ok := value.(map[string]interface{}); ok {
+		return jsonInt64Value(ref["id"])
+	}
+	return jsonInt64Value(value)
+}
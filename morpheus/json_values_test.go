@@ -0,0 +1,192 @@
+package morpheus
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The fuzz tests below decode arbitrary JSON values with the helpers, which
+// must never panic whatever the appliance returns, and check the values of
+// the expected types round trip.
+
+func FuzzJsonInt64Value(f *testing.F) {
+	f.Add(int64(42), []byte(`42`))
+	f.Add(int64(-1), []byte(`"12"`))
+	f.Add(int64(math.MaxInt64), []byte(`1.5e300`))
+	f.Add(int64(0), []byte(`null`))
+	f.Fuzz(func(t *testing.T, n int64, data []byte) {
+		if got := jsonInt64Value(strconv.FormatInt(n, 10)); got != n {
+			t.Errorf("jsonInt64Value(%q) = %d, want %d", strconv.FormatInt(n, 10), got, n)
+		}
+		if got := jsonInt64Value(json.Number(strconv.FormatInt(n, 10))); got != n {
+			t.Errorf("jsonInt64Value(json.Number(%d)) = %d, want %d", n, got, n)
+		}
+		jsonInt64Value(decodeJSONValue(data))
+		jsonInt64Value(string(data))
+	})
+}
+
+func FuzzJsonFloat64Value(f *testing.F) {
+	f.Add(1.5, []byte(`1.5`))
+	f.Add(-0.1, []byte(`"2.5"`))
+	f.Add(1e300, []byte(`{"value":1}`))
+	f.Fuzz(func(t *testing.T, x float64, data []byte) {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return
+		}
+		if got := jsonFloat64Value(jsonStringValue(x)); got != x {
+			t.Errorf("jsonFloat64Value(%q) = %v, want %v", jsonStringValue(x), got, x)
+		}
+		jsonFloat64Value(decodeJSONValue(data))
+		jsonFloat64Value(string(data))
+	})
+}
+
+func FuzzJsonBoolValue(f *testing.F) {
+	f.Add(true, []byte(`"on"`))
+	f.Add(false, []byte(`0`))
+	f.Add(true, []byte(`[true]`))
+	f.Fuzz(func(t *testing.T, b bool, data []byte) {
+		if got := jsonBoolValue(b); got != b {
+			t.Errorf("jsonBoolValue(%t) = %t", b, got)
+		}
+		if got := jsonBoolValue(strconv.FormatBool(b)); got != b {
+			t.Errorf("jsonBoolValue(%q) = %t, want %t", strconv.FormatBool(b), got, b)
+		}
+		jsonBoolValue(decodeJSONValue(data))
+		jsonBoolValue(string(data))
+	})
+}
+
+func FuzzJsonStringValue(f *testing.F) {
+	f.Add("admin", []byte(`"admin"`))
+	f.Add("", []byte(`{"id":1,"username":"admin"}`))
+	f.Add("1e3", []byte(`1e3`))
+	f.Add("null", []byte(`null`))
+	f.Fuzz(func(t *testing.T, s string, data []byte) {
+		if got := jsonStringValue(s); got != s {
+			t.Errorf("jsonStringValue(%q) = %q", s, got)
+		}
+		value := jsonStringValue(decodeJSONValue(data))
+
+		var js JSONString
+		if err := js.UnmarshalJSON(data); err != nil {
+			t.Fatalf("JSONString.UnmarshalJSON(%q) = %s", data, err)
+		}
+		if string(js) != value {
+			t.Errorf("JSONString.UnmarshalJSON(%q) = %q, want %q", data, js, value)
+		}
+		var str string
+		if err := json.Unmarshal(data, &str); err == nil && string(js) != str {
+			t.Errorf("JSONString.UnmarshalJSON(%q) = %q, want %q", data, js, str)
+		}
+	})
+}
+
+func FuzzJsonStringListValue(f *testing.F) {
+	f.Add("a,b , c", []byte(`["a",1,null,{"b":2}]`))
+	f.Add(",,", []byte(`"x, y"`))
+	f.Add("", []byte(`[]`))
+	f.Fuzz(func(t *testing.T, s string, data []byte) {
+		values := jsonStringListValue(s)
+		for _, value := range values {
+			if value == "" || value != strings.TrimSpace(value) || strings.Contains(value, ",") {
+				t.Errorf("jsonStringListValue(%q) returned the item %q", s, value)
+			}
+		}
+
+		items := make([]interface{}, len(values))
+		for i, value := range values {
+			items[i] = value
+		}
+		if got := jsonStringListValue(items); strings.Join(got, ",") != strings.Join(values, ",") {
+			t.Errorf("jsonStringListValue(%q) = %q, want %q", items, got, values)
+		}
+
+		for _, value := range jsonStringListValue(decodeJSONValue(data)) {
+			if value == "" {
+				t.Errorf("jsonStringListValue(%q) returned an empty item", data)
+			}
+		}
+	})
+}
+
+func FuzzJsonRefIdValue(f *testing.F) {
+	f.Add(int64(7), []byte(`{"id":"7","name":"admin"}`))
+	f.Add(int64(0), []byte(`[7]`))
+	f.Fuzz(func(t *testing.T, n int64, data []byte) {
+		ref := map[string]interface{}{"id": json.Number(strconv.FormatInt(n, 10))}
+		if got := jsonRefIdValue(ref); got != n {
+			t.Errorf("jsonRefIdValue(%v) = %d, want %d", ref, got, n)
+		}
+		if got := jsonRefIdValue(n); got != n {
+			t.Errorf("jsonRefIdValue(%d) = %d", n, got)
+		}
+		jsonRefIdValue(decodeJSONValue(data))
+	})
+}
+
+// FuzzSpecTemplateResponses decodes arbitrary responses in the hand-rolled
+// response structs of the spec templates and app blueprints and stores them
+// in the state, as the reads do
+func FuzzSpecTemplateResponses(f *testing.F) {
+	f.Add([]byte(`{"specTemplate":{"id":1,"name":"helm","file":{"sourceType":"git","contentPath":"charts","repository":{"id":2}},"createdBy":{"username":"admin"}}}`))
+	f.Add([]byte(`{"specTemplate":{"createdBy":"admin","file":{"sourceType":"local","content":"{}"}}}`))
+	f.Add([]byte(`{"blueprint":{"id":1,"config":{"helm":{"configType":"git","git":{"path":"/","repoId":2,"integrationId":3,"branch":"main"}}}}}`))
+	f.Add([]byte(`{"specTemplate":null}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var helmSpecTemplate HelmSpecTemplate
+		if err := json.Unmarshal(data, &helmSpecTemplate); err == nil {
+			d := schema.TestResourceDataRaw(t, resourceHelmSpecTemplate().Schema, map[string]interface{}{})
+			setSourceFile(d, helmSpecTemplate.Spectemplate.File, "spec_content", "spec_path")
+			switch sourceType := d.Get("source_type").(string); helmSpecTemplate.Spectemplate.File.SourceType {
+			case "git", "repository":
+				if sourceType != "repository" {
+					t.Errorf("source_type = %q, want repository", sourceType)
+				}
+			default:
+				if sourceType != helmSpecTemplate.Spectemplate.File.SourceType {
+					t.Errorf("source_type = %q, want %q", sourceType, helmSpecTemplate.Spectemplate.File.SourceType)
+				}
+			}
+		}
+		for _, response := range []interface{}{
+			&ArmSpecTemplate{},
+			&CloudFormationSpecTemplate{},
+			&KubernetesSpecTemplate{},
+			&TerraformSpecTemplate{},
+		} {
+			json.Unmarshal(data, response)
+		}
+
+		// the creator of a spec template is decoded whatever its type
+		if json.Valid(data) {
+			response := []byte(`{"specTemplate":{"createdBy":` + string(data) + `}}`)
+			if err := json.Unmarshal(response, &HelmSpecTemplate{}); err != nil {
+				t.Errorf("json.Unmarshal(%q) = %s", response, err)
+			}
+		}
+
+		var helmAppBlueprint HelmAppBlueprint
+		if err := json.Unmarshal(data, &helmAppBlueprint); err == nil {
+			d := schema.TestResourceDataRaw(t, resourceHelmAppBlueprint().Schema, map[string]interface{}{})
+			setAppBlueprintGit(d, helmAppBlueprint.Blueprint.Config.Helm.Git)
+			if path := d.Get("working_path").(string); path != helmAppBlueprint.Blueprint.Config.Helm.Git.Path {
+				t.Errorf("working_path = %q, want %q", path, helmAppBlueprint.Blueprint.Config.Helm.Git.Path)
+			}
+		}
+		for _, response := range []interface{}{
+			&ArmAppBlueprint{},
+			&CloudFormationAppBlueprint{},
+			&KubernetesAppBlueprint{},
+			&TerraformAppBlueprint{},
+		} {
+			json.Unmarshal(data, response)
+		}
+	})
+}
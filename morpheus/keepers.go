@@ -0,0 +1,19 @@
+package morpheus
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// keepersSchema is the schema of the arbitrary values that force the secrets
+// of a resource to be sent to the appliance again when they change, a change
+// of a secret made outside of terraform cannot be detected otherwise. The
+// resources whose secrets cannot be updated in place are replaced instead.
+func keepersSchema(description string, forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: description,
+		Optional:    true,
+		ForceNew:    forceNew,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
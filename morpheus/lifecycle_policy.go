@@ -0,0 +1,429 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// lifecyclePolicy is a policy ending the life of the provisioned instances
+// a number of days after their provisioning, the expiration and shutdown
+// policies only differing by their policy type, the prefix of the keys of
+// their config and the names of the days and hide attributes
+type lifecyclePolicy struct {
+	// the code and name of the policy type
+	code string
+	name string
+	// the prefix of the keys of the config, lifecycle or shutdown
+	configPrefix string
+	// the noun and the outcome of the end of the life of the instances
+	// used in the descriptions of the attributes
+	noun    string
+	outcome string
+	// the attributes of the number of days and of the hiding of the option
+	daysKey string
+	hideKey string
+}
+
+// resource returns the resource managing the policies of the type
+func (p lifecyclePolicy) resource(description string) *schema.Resource {
+	return &schema.Resource{
+		Description:   description,
+		CreateContext: p.create,
+		ReadContext:   p.read,
+		UpdateContext: p.update,
+		DeleteContext: p.delete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("The ID of the %s policy", p.noun),
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("The name of the %s policy", p.noun),
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("The description of the %s policy", p.noun),
+				Optional:    true,
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the policy is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"enforcement_type": {
+				Type:         schema.TypeString,
+				Description:  "The enforcement type of the policy, fixed or user configurable (fixed, user)",
+				ValidateFunc: validation.StringInSlice([]string{"fixed", "user"}, false),
+				Required:     true,
+			},
+			p.daysKey: {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("The number of days after the provisioning of the instance after which it %s", p.outcome),
+				ValidateFunc: validation.IntAtLeast(1),
+				Required:     true,
+			},
+			"extension_days": {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("The number of days the %s is postponed by each extension, the appliance default when not set", p.noun),
+				ValidateFunc: validation.IntAtLeast(0),
+				Optional:     true,
+				Computed:     true,
+			},
+			"notification_days": {
+				Type:         schema.TypeInt,
+				Description:  fmt.Sprintf("The number of days before the %s the owner of the instance is notified, the appliance default when not set", p.noun),
+				ValidateFunc: validation.IntAtLeast(0),
+				Optional:     true,
+				Computed:     true,
+			},
+			"notification_message": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("The message of the notification sent before the %s", p.noun),
+				Optional:    true,
+				Computed:    true,
+			},
+			"auto_approve_extensions": {
+				Type:        schema.TypeBool,
+				Description: "Whether the extensions are approved without an approval request",
+				Optional:    true,
+				Default:     false,
+			},
+			"extensions_before_approval": {
+				Type:         schema.TypeInt,
+				Description:  "The number of extensions approved without an approval request before the extensions require an approval, when the extensions are not auto approved, the appliance default when not set",
+				ValidateFunc: validation.IntAtLeast(0),
+				Optional:     true,
+				Computed:     true,
+			},
+			p.hideKey: {
+				Type:        schema.TypeBool,
+				Description: fmt.Sprintf("Whether to hide the %s option on the instance provisioning wizard if the enforcement type is fixed", p.noun),
+				Optional:    true,
+				Default:     false,
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Description:  "The filter or scope that the policy is applied to (global, group, cloud, user, role, tenant)",
+				ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role", "tenant"}, false),
+				Required:     true,
+				ForceNew:     true,
+			},
+			"group_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the group associated with the group scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "role_id", "tenant_id"},
+			},
+			"cloud_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the cloud associated with the cloud scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"group_id", "user_id", "role_id", "tenant_id"},
+			},
+			"user_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the user associated with the user scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "group_id", "role_id", "tenant_id"},
+			},
+			"role_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the role associated with the role scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id", "tenant_id"},
+			},
+			"apply_to_each_user": {
+				Type:          schema.TypeBool,
+				Description:   "Whether to assign the policy at the individual user level to all users assigned the associated role or belonging to the associated tenant",
+				Optional:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
+			},
+			"tenant_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the tenant associated with the tenant scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id", "role_id"},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeList,
+				Description: "A list of tenant IDs to assign the policy to",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func (p lifecyclePolicy) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"policy": p.payload(d),
+		},
+	}
+	resp, err := client.CreatePolicy(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreatePolicyResult)
+	policyResult := result.Policy
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(policyResult.ID))
+
+	p.read(ctx, d, meta)
+	return diags
+}
+
+func (p lifecyclePolicy) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindPolicyByName(name)
+	} else if id != "" {
+		resp, err = client.GetPolicy(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Policy cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetPolicyResult)
+	policy := result.Policy
+
+	d.SetId(int64ToString(policy.ID))
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("enabled", policy.Enabled)
+
+	// the keys of the config depend on the policy type, the config is read
+	// from the response data rather than from the fields of the sdk
+	var config map[string]interface{}
+	if data, ok := resp.JsonData.(map[string]interface{}); ok {
+		if policyData, ok := data["policy"].(map[string]interface{}); ok {
+			config, _ = policyData["config"].(map[string]interface{})
+		}
+	}
+	d.Set("enforcement_type", jsonStringValue(config[p.configPrefix+"Type"]))
+	d.Set(p.daysKey, jsonInt64Value(config[p.configPrefix+"Age"]))
+	d.Set("extension_days", jsonInt64Value(config[p.configPrefix+"Renewal"]))
+	d.Set("notification_days", jsonInt64Value(config[p.configPrefix+"Notify"]))
+	d.Set("notification_message", jsonStringValue(config[p.configPrefix+"Message"]))
+	d.Set("auto_approve_extensions", jsonBoolValue(config[p.configPrefix+"AutoRenew"]))
+	d.Set("extensions_before_approval", jsonInt64Value(config[p.configPrefix+"ExtensionsBeforeApproval"]))
+	d.Set(p.hideKey, jsonBoolValue(config[p.configPrefix+"HideFixed"]))
+
+	switch policy.RefType {
+	case "ComputeSite":
+		d.Set("scope", "group")
+		d.Set("group_id", policy.Site.ID)
+	case "ComputeZone":
+		d.Set("scope", "cloud")
+		d.Set("cloud_id", policy.Zone.ID)
+	case "User":
+		d.Set("scope", "user")
+		d.Set("user_id", policy.User.ID)
+	case "Role":
+		d.Set("scope", "role")
+		d.Set("role_id", policy.Role.ID)
+		d.Set("apply_to_each_user", policy.EachUser)
+	case "Account":
+		d.Set("scope", "tenant")
+		d.Set("tenant_id", policy.RefID)
+		d.Set("apply_to_each_user", policy.EachUser)
+	default:
+		d.Set("scope", "global")
+	}
+
+	var tenantIds []int64
+	if policy.Accounts != nil {
+		// iterate over the array of accounts
+		for _, account := range policy.Accounts {
+			tenantIds = append(tenantIds, account.ID)
+		}
+	}
+	d.Set("tenant_ids", tenantIds)
+
+	return diags
+}
+
+func (p lifecyclePolicy) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"policy": p.payload(d),
+		},
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdatePolicyResult)
+	policyResult := result.Policy
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(policyResult.ID))
+	return p.read(ctx, d, meta)
+}
+
+func (p lifecyclePolicy) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// payload builds the policy of the create and update requests
+func (p lifecyclePolicy) payload(d *schema.ResourceData) map[string]interface{} {
+	policy := make(map[string]interface{})
+
+	policy["name"] = d.Get("name").(string)
+	policy["description"] = d.Get("description").(string)
+	policy["enabled"] = d.Get("enabled").(bool)
+	policy["config"] = p.config(d)
+	policy["policyType"] = map[string]interface{}{
+		"code": p.code,
+		"name": p.name,
+	}
+
+	policy["accounts"] = d.Get("tenant_ids")
+
+	switch d.Get("scope") {
+	case "group":
+		policy["refId"] = d.Get("group_id").(int)
+		policy["refType"] = "ComputeSite"
+		policy["site"] = map[string]interface{}{
+			"id": d.Get("group_id").(int),
+		}
+	case "cloud":
+		policy["refId"] = d.Get("cloud_id").(int)
+		policy["refType"] = "ComputeZone"
+		policy["zone"] = map[string]interface{}{
+			"id": d.Get("cloud_id").(int),
+		}
+	case "user":
+		policy["refId"] = d.Get("user_id").(int)
+		policy["refType"] = "User"
+		policy["user"] = map[string]interface{}{
+			"id": d.Get("user_id").(int),
+		}
+	case "role":
+		policy["refId"] = d.Get("role_id").(int)
+		policy["refType"] = "Role"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["role"] = map[string]interface{}{
+			"id": d.Get("role_id").(int),
+		}
+	case "tenant":
+		policy["refId"] = d.Get("tenant_id").(int)
+		policy["refType"] = "Account"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["account"] = map[string]interface{}{
+			"id": d.Get("tenant_id").(int),
+		}
+	}
+	return policy
+}
+
+// config builds the config of the policy, the optional settings not
+// configured being sent empty for the appliance defaults. The raw config
+// tells the settings set to 0 from the unset ones.
+func (p lifecyclePolicy) config(d *schema.ResourceData) map[string]interface{} {
+	config := map[string]interface{}{
+		p.configPrefix + "Type":      d.Get("enforcement_type").(string),
+		p.configPrefix + "Age":       intToString(d.Get(p.daysKey).(int)),
+		p.configPrefix + "Message":   d.Get("notification_message").(string),
+		p.configPrefix + "HideFixed": d.Get(p.hideKey).(bool),
+	}
+	if d.Get("auto_approve_extensions").(bool) {
+		config[p.configPrefix+"AutoRenew"] = "on"
+	} else {
+		config[p.configPrefix+"AutoRenew"] = ""
+	}
+	rawConfig := d.GetRawConfig()
+	for key, attribute := range map[string]string{
+		"Renewal":                  "extension_days",
+		"Notify":                   "notification_days",
+		"ExtensionsBeforeApproval": "extensions_before_approval",
+	} {
+		if rawConfig.IsNull() || rawConfig.GetAttr(attribute).IsNull() {
+			config[p.configPrefix+key] = ""
+		} else {
+			config[p.configPrefix+key] = intToString(d.Get(attribute).(int))
+		}
+	}
+	return config
+}
@@ -0,0 +1,63 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+)
+
+// lockedRetryInterval is the delay between two attempts of a request
+// rejected because the object is locked
+const lockedRetryInterval = 10 * time.Second
+
+// lockedRetryMax is the maximum number of times a request rejected because
+// the object is locked is sent again, a lock held for longer than that is not
+// waited for even when the timeout of the operation is longer
+const lockedRetryMax = 60
+
+// retryWhileLocked retries a request as long as the appliance rejects it
+// because the object is locked, as it does while a cloud is syncing. The
+// retries stop once the request succeeds, fails for another reason, the
+// maximum number of retries is reached or the context, bounded by the timeout
+// of the operation, expires. The retries are recorded in the api summary of
+// the client.
+func retryWhileLocked(ctx context.Context, client *apiClient, request func() (*morpheus.Response, error)) (*morpheus.Response, error) {
+	for retries := 0; ; retries++ {
+		resp, err := request()
+		if err == nil || !lockedResponse(resp) {
+			return resp, err
+		}
+		if retries >= lockedRetryMax {
+			return resp, fmt.Errorf("%s, the object was still locked after %d retries", err, retries)
+		}
+		log.Printf("API LOCKED: %s - %s, retrying in %s", resp, err, lockedRetryInterval)
+		select {
+		case <-ctx.Done():
+			return resp, fmt.Errorf("%s, the object was still locked when the timeout was reached", err)
+		case <-time.After(lockedRetryInterval):
+		}
+		client.recordRetry()
+	}
+}
+
+// lockedResponse reports whether the appliance rejected a request because the
+// object is locked. The appliance answers 423 for a locked object, and 409
+// with a message about the lock for some objects, a 409 for another conflict,
+// such as a duplicate name, is not retried.
+func lockedResponse(resp *morpheus.Response) bool {
+	if resp == nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusLocked:
+		return true
+	case http.StatusConflict:
+		return strings.Contains(strings.ToLower(string(resp.Body)), "lock")
+	}
+	return false
+}
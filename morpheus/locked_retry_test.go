@@ -0,0 +1,27 @@
+package morpheus
+
+import (
+	"testing"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+)
+
+func TestLockedResponse(t *testing.T) {
+	cases := map[string]struct {
+		resp   *morpheus.Response
+		locked bool
+	}{
+		"no response":     {resp: nil},
+		"locked":          {resp: &morpheus.Response{StatusCode: 423}, locked: true},
+		"conflict locked": {resp: &morpheus.Response{StatusCode: 409, Body: []byte(`{"success":false,"msg":"Cloud is Locked"}`)}, locked: true},
+		"duplicate name":  {resp: &morpheus.Response{StatusCode: 409, Body: []byte(`{"success":false,"msg":"name must be unique"}`)}},
+		"server error":    {resp: &morpheus.Response{StatusCode: 500, Body: []byte(`{"msg":"lock"}`)}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if locked := lockedResponse(tc.resp); locked != tc.locked {
+				t.Errorf("lockedResponse() = %t, want %t", locked, tc.locked)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"log"
+	"os"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// logoChecksumSchema is the schema of the checksum of an uploaded logo image,
+// a change of the content of the image file changes the checksum and uploads
+// the image again even though its name and path are the same
+func logoChecksumSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: description,
+		Computed:    true,
+	}
+}
+
+// logoImageData returns the content of a logo image, the inline content when
+// set, either base64 encoded or raw, or the content of the file path otherwise
+func logoImageData(path string, content string) ([]byte, error) {
+	if content != "" {
+		if decoded, err := base64.StdEncoding.DecodeString(content); err == nil {
+			return decoded, nil
+		}
+		return []byte(content), nil
+	}
+	return os.ReadFile(path)
+}
+
+func logoChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// logoChecksumCustomizeDiff plans the checksum of the configured logo image so
+// the image is uploaded again when its content changes. The contentKey is
+// empty for the resources only supporting a file path.
+func logoChecksumCustomizeDiff(checksumKey string, nameKey string, pathKey string, contentKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(nameKey) || !d.NewValueKnown(pathKey) || (contentKey != "" && !d.NewValueKnown(contentKey)) {
+			return nil
+		}
+		path := d.Get(pathKey).(string)
+		content := ""
+		if contentKey != "" {
+			content = d.Get(contentKey).(string)
+		}
+
+		checksum := ""
+		if d.Get(nameKey).(string) != "" && (path != "" || content != "") {
+			data, err := logoImageData(path, content)
+			if err != nil {
+				// the file is reported missing when the image is uploaded
+				return nil
+			}
+			checksum = logoChecksum(data)
+		}
+		if old, _ := d.GetChange(checksumKey); old.(string) != checksum {
+			return d.SetNew(checksumKey, checksum)
+		}
+		return nil
+	}
+}
+
+// readLogoChecksum sets the checksum of the logo image the appliance holds,
+// downloaded from the image path it returns, so the image is uploaded again
+// when it was removed or replaced outside of Terraform
+func readLogoChecksum(client *apiClient, d *schema.ResourceData, checksumKey string, imagePath string) {
+	if imagePath == "" {
+		d.Set(checksumKey, "")
+		return
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method:  "GET",
+		Path:    imagePath,
+		Headers: map[string]string{"Accept": "*/*"},
+	})
+	if err != nil {
+		// the checksum of the state is kept when the image cannot be downloaded
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return
+	}
+	d.Set(checksumKey, logoChecksum(resp.Body))
+}
@@ -0,0 +1,103 @@
+package morpheus
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func getManagedLabel(meta interface{}) string {
+	if m, ok := meta.(*providerMeta); ok {
+		return m.managedLabel
+	}
+	return ""
+}
+
+// withManagedLabel wraps the operations of a resource supporting labels so the
+// marker label, added to the request payloads by appendManagedLabel, is kept
+// out of the labels stored in state unless the configuration lists it. The
+// labels before an operation are the planned ones on create and update and
+// the ones of the prior state on read, which hold the marker label only when
+// it is configured.
+func withManagedLabel(resource *schema.Resource) {
+	if _, ok := resource.Schema["labels"]; !ok {
+		return
+	}
+
+	removeLabel := func(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if operation == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured := containsLabel(labelsList(d.Get("labels")), getManagedLabel(meta))
+			diags := operation(ctx, d, meta)
+			if !configured {
+				removeManagedLabel(d, meta)
+			}
+			return diags
+		}
+	}
+	resource.CreateContext = removeLabel(resource.CreateContext)
+	resource.ReadContext = removeLabel(resource.ReadContext)
+	resource.UpdateContext = removeLabel(resource.UpdateContext)
+}
+
+// appendManagedLabel adds the marker label configured for the provider to the
+// labels of a request payload, leaving the labels attribute as planned
+func appendManagedLabel(labels []string, meta interface{}) []string {
+	label := getManagedLabel(meta)
+	if label == "" || slices.Contains(labels, label) {
+		return labels
+	}
+	return append(labels, label)
+}
+
+// managedLabelsPayload returns the labels of a resource to send to the API,
+// including the marker label configured for the provider
+func managedLabelsPayload(d *schema.ResourceData, meta interface{}) []string {
+	labels := make([]string, 0)
+	for _, label := range labelsList(d.Get("labels")) {
+		labels = append(labels, label.(string))
+	}
+	return appendManagedLabel(labels, meta)
+}
+
+func removeManagedLabel(d *schema.ResourceData, meta interface{}) {
+	label := getManagedLabel(meta)
+	if label == "" || d.Id() == "" {
+		return
+	}
+	labels := labelsList(d.Get("labels"))
+	if !containsLabel(labels, label) {
+		return
+	}
+	var filtered []interface{}
+	for _, value := range labels {
+		if value != label {
+			filtered = append(filtered, value)
+		}
+	}
+	d.Set("labels", filtered)
+}
+
+// labelsList returns the labels of a resource whether stored as a list or a set
+func labelsList(labels interface{}) []interface{} {
+	switch v := labels.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+func containsLabel(labels []interface{}, label string) bool {
+	for _, value := range labels {
+		if value == label {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package morpheus
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWithManagedLabel(t *testing.T) {
+	meta := &providerMeta{managedLabel: "managed-by:terraform"}
+	cases := map[string]struct {
+		labels []interface{}
+		want   []string
+	}{
+		"marker label not configured": {
+			labels: []interface{}{"prod"},
+			want:   []string{"prod"},
+		},
+		"marker label configured": {
+			labels: []interface{}{"prod", "managed-by:terraform"},
+			want:   []string{"managed-by:terraform", "prod"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resource := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"labels": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+				// the appliance returns the marker label added to the payloads
+				ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					d.Set("labels", []interface{}{"prod", "managed-by:terraform"})
+					return nil
+				},
+			}
+			withManagedLabel(resource)
+
+			d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"labels": tc.labels})
+			d.SetId("1")
+			if diags := resource.ReadContext(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("ReadContext() = %v", diags)
+			}
+			var labels []string
+			for _, label := range labelsList(d.Get("labels")) {
+				labels = append(labels, label.(string))
+			}
+			sort.Strings(labels)
+			if !reflect.DeepEqual(labels, tc.want) {
+				t.Errorf("labels = %v, want %v", labels, tc.want)
+			}
+		})
+	}
+}
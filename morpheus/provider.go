@@ -8,7 +8,7 @@ import (
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"url": {
 				Type:        schema.TypeString,
@@ -29,11 +29,18 @@ func Provider() *schema.Provider {
 			"tenant_subdomain": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				Description:   "The tenant subdomain used for authentication",
+				Description:   "The tenant subdomain used for authentication, the user authenticates into the subtenant with this subdomain",
 				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_TENANT", nil),
 				ConflictsWith: []string{"access_token"},
 			},
 
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The id of the subtenant the resources supporting tenant impersonation, the groups, catalog items, tasks, workflows, option types and templates, are managed in when their tenant_id attribute is not set. The requests are sent on behalf of the subtenant with the impersonation header, the user authenticates into its own tenant. Combined with provider aliases, a single configuration manages the catalogs and libraries of several tenants. If omitted, the resources are managed in the tenant of the user.",
+				DefaultFunc: schema.EnvDefaultFunc("MORPHEUS_API_TENANT_ID", 0),
+			},
+
 			"username": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -58,6 +65,34 @@ func Provider() *schema.Provider {
 				Description: `Allow the provider to enable certificate verification. If omitted, default value is "false".`,
 				DefaultFunc: schema.EnvDefaultFunc("MORPHEUS_API_SECURE", false),
 			},
+
+			"managed_label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `A label added to every object supporting labels that the provider creates or updates, such as "managed-by:terraform". The label is not stored in the labels attribute of the resources, unless it is listed in it. If omitted, no label is added.`,
+				DefaultFunc: schema.EnvDefaultFunc("MORPHEUS_MANAGED_LABEL", ""),
+			},
+
+			"expected_appliance_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL of the Morpheus Data Appliance the configuration is meant for. The provider fails when the url argument does not match it, protecting against applying a configuration to the wrong appliance. Every resource records the appliance and tenant it is applied to, when this argument is set it fails when the provider targets another appliance.",
+				DefaultFunc: schema.EnvDefaultFunc("MORPHEUS_EXPECTED_API_URL", ""),
+			},
+
+			"api_summary_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of a file the provider writes a summary of its API usage to: the number of API calls, the requests rejected because the object was locked and their retries, the rate limit hits and the number and duration of the calls to each endpoint. The file is written once Terraform stops the provider at the end of the run. If omitted, no summary is collected.",
+				DefaultFunc: schema.EnvDefaultFunc("MORPHEUS_API_SUMMARY_FILE", ""),
+			},
+
+			"expected_tenant": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the tenant the configuration is meant for. The provider fails when the authenticated user does not belong to it, protecting against applying a configuration to the wrong tenant. When set, every resource also fails when the provider targets another tenant than the one it was applied to.",
+				DefaultFunc: schema.EnvDefaultFunc("MORPHEUS_EXPECTED_TENANT", ""),
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -68,21 +103,30 @@ func Provider() *schema.Provider {
 			"morpheus_ansible_tower_task":                    resourceAnsibleTowerTask(),
 			"morpheus_api_option_list":                       resourceApiOptionList(),
 			"morpheus_app_blueprint_catalog_item":            resourceAppBlueprintCatalogItem(),
+			"morpheus_appliance_maintenance_mode":            resourceApplianceMaintenanceMode(),
 			"morpheus_appliance_setting":                     resourceApplianceSetting(),
 			"morpheus_arm_app_blueprint":                     resourceArmAppBlueprint(),
 			"morpheus_arm_spec_template":                     resourceArmSpecTemplate(),
 			"morpheus_aws_cloud":                             resourceAWSCloud(),
 			"morpheus_aws_instance":                          resourceAwsInstance(),
 			"morpheus_azure_cloud":                           resourceAzureCloud(),
+			"morpheus_backup":                                resourceBackup(),
 			"morpheus_backup_creation_policy":                resourceBackupCreationPolicy(),
+			"morpheus_backup_job":                            resourceBackupJob(),
+			"morpheus_backup_provider":                       resourceBackupProvider(),
 			"morpheus_backup_setting":                        resourceBackupSetting(),
 			"morpheus_boot_script":                           resourceBootScript(),
+			"morpheus_budget":                                resourceBudget(),
 			"morpheus_budget_policy":                         resourceBudgetPolicy(),
+			"morpheus_catalog_order":                         resourceCatalogOrder(),
 			"morpheus_checkbox_option_type":                  resourceCheckboxOptionType(),
 			"morpheus_chef_bootstrap_task":                   resourceChefBootstrapTask(),
 			"morpheus_chef_integration":                      resourceChefIntegration(),
+			"morpheus_cloud_datastore_configuration":         resourceCloudDatastoreConfiguration(),
 			"morpheus_cloud_formation_app_blueprint":         resourceCloudFormationAppBlueprint(),
 			"morpheus_cloud_formation_spec_template":         resourceCloudFormationSpecTemplate(),
+			"morpheus_cloud_network":                         resourceCloudNetwork(),
+			"morpheus_cloud_resource_pool":                   resourceCloudResourcePool(),
 			"morpheus_cluster_layout":                        resourceClusterLayout(),
 			"morpheus_cluster_package":                       resourceClusterPackage(),
 			"morpheus_cluster_resource_name_policy":          resourceClusterResourceNamePolicy(),
@@ -93,10 +137,14 @@ func Provider() *schema.Provider {
 			"morpheus_cypher_tfvars":                         resourceCypherTFVars(),
 			"morpheus_delayed_delete_policy":                 resourceDelayedDeletePolicy(),
 			"morpheus_delete_approval_policy":                resourceDeleteApprovalPolicy(),
+			"morpheus_docker_cluster":                        resourceDockerCluster(),
 			"morpheus_docker_registry_integration":           resourceDockerRegistryIntegration(),
 			"morpheus_email_task":                            resourceEmailTask(),
 			"morpheus_environment":                           resourceEnvironment(),
 			"morpheus_execute_schedule":                      resourceExecuteSchedule(),
+			"morpheus_execution":                             resourceExecution(),
+			"morpheus_expiration_policy":                     resourceExpirationPolicy(),
+			"morpheus_external_kubernetes_cluster":           resourceExternalKubernetesCluster(),
 			"morpheus_file_template":                         resourceFileTemplate(),
 			"morpheus_form":                                  resourceForm(),
 			"morpheus_git_integration":                       resourceGitIntegration(),
@@ -107,10 +155,14 @@ func Provider() *schema.Provider {
 			"morpheus_helm_spec_template":                    resourceHelmSpecTemplate(),
 			"morpheus_hidden_option_type":                    resourceHiddenOptionType(),
 			"morpheus_hostname_policy":                       resourceHostNamePolicy(),
+			"morpheus_image_build":                           resourceImageBuild(),
+			"morpheus_instance_action":                       resourceInstanceAction(),
 			"morpheus_instance_catalog_item":                 resourceInstanceCatalogItem(),
 			"morpheus_instance_layout":                       resourceInstanceLayout(),
 			"morpheus_instance_name_policy":                  resourceInstanceNamePolicy(),
+			"morpheus_instance_scale":                        resourceInstanceScale(),
 			"morpheus_instance_type":                         resourceInstanceType(),
+			"morpheus_integration":                           resourceIntegration(),
 			"morpheus_ipv4_ip_pool":                          resourceIPv4IPPool(),
 			"morpheus_javascript_task":                       resourceJavaScriptTask(),
 			"morpheus_library_script_task":                   resourceLibraryScriptTask(),
@@ -118,7 +170,9 @@ func Provider() *schema.Provider {
 			"morpheus_license":                               resourceLicense(),
 			"morpheus_key_pair":                              resourceKeyPair(),
 			"morpheus_kubernetes_app_blueprint":              resourceKubernetesAppBlueprint(),
+			"morpheus_kubernetes_cluster":                    resourceKubernetesCluster(),
 			"morpheus_kubernetes_spec_template":              resourceKubernetesSpecTemplate(),
+			"morpheus_kvm_cluster":                           resourceKvmCluster(),
 			"morpheus_manual_option_list":                    resourceManualOptionList(),
 			"morpheus_max_containers_policy":                 resourceMaxContainersPolicy(),
 			"morpheus_max_cores_policy":                      resourceMaxCoresPolicy(),
@@ -127,21 +181,31 @@ func Provider() *schema.Provider {
 			"morpheus_max_storage_policy":                    resourceMaxStoragePolicy(),
 			"morpheus_max_vms_policy":                        resourceMaxVmsPolicy(),
 			"morpheus_monitoring_setting":                    resourceMonitoringSetting(),
+			"morpheus_morpheus_app_blueprint":                resourceMorpheusAppBlueprint(),
 			"morpheus_motd_policy":                           resourceMotdPolicy(),
 			"morpheus_mvm_instance":                          resourceMVMInstance(),
 			"morpheus_nested_workflow_task":                  resourceNestedWorkflowTask(),
+			"morpheus_network":                               resourceNetwork(),
 			"morpheus_network_domain":                        resourceNetworkDomain(),
+			"morpheus_network_floating_ip":                   resourceNetworkFloatingIP(),
+			"morpheus_network_floating_ip_association":       resourceNetworkFloatingIPAssociation(),
+			"morpheus_network_pool_ip":                       resourceNetworkPoolIP(),
 			"morpheus_network_quota_policy":                  resourceNetworkQuotaPolicy(),
 			"morpheus_node_type":                             resourceNodeType(),
 			"morpheus_number_option_type":                    resourceNumberOptionType(),
+			"morpheus_nutanix_cloud":                         resourceNutanixCloud(),
 			"morpheus_operational_workflow":                  resourceOperationalWorkflow(),
 			"morpheus_password_option_type":                  resourcePasswordOptionType(),
+			"morpheus_personal_access_token":                 resourcePersonalAccessToken(),
+			"morpheus_policy":                                resourcePolicy(),
+			"morpheus_power_schedule":                        resourcePowerSchedule(),
 			"morpheus_power_schedule_policy":                 resourcePowerSchedulePolicy(),
 			"morpheus_powershell_script_task":                resourcePowerShellScriptTask(),
 			"morpheus_preseed_script":                        resourcePreseedScript(),
 			"morpheus_price_set":                             resourcePriceSet(),
 			"morpheus_price":                                 resourcePrice(),
 			"morpheus_provision_approval_policy":             resourceProvisionApprovalPolicy(),
+			"morpheus_provisioning_license":                  resourceProvisioningLicense(),
 			"morpheus_provisioning_setting":                  resourceProvisioningSetting(),
 			"morpheus_provisioning_workflow":                 resourceProvisioningWorkflow(),
 			"morpheus_puppet_integration":                    resourcePuppetIntegration(),
@@ -157,9 +221,12 @@ func Provider() *schema.Provider {
 			"morpheus_script_template":                       resourceScriptTemplate(),
 			"morpheus_security_package":                      resourceSecurityPackage(),
 			"morpheus_select_list_option_type":               resourceSelectListOptionType(),
+			"morpheus_server":                                resourceServer(),
+			"morpheus_service_account":                       resourceMorpheusServiceAccount(),
 			"morpheus_service_plan":                          resourceServicePlan(),
 			"morpheus_servicenow_integration":                resourceServiceNowIntegration(),
 			"morpheus_shell_script_task":                     resourceShellScriptTask(),
+			"morpheus_shutdown_policy":                       resourceShutdownPolicy(),
 			"morpheus_standard_cloud":                        resourceStandardCloud(),
 			"morpheus_tag_policy":                            resourceTagPolicy(),
 			"morpheus_task_job":                              resourceTaskJob(),
@@ -175,6 +242,8 @@ func Provider() *schema.Provider {
 			"morpheus_user":                                  resourceMorpheusUser(),
 			"morpheus_user_group":                            resourceUserGroup(),
 			"morpheus_user_role":                             resourceUserRole(),
+			"morpheus_vcd_cloud":                             resourceVcdCloud(),
+			"morpheus_virtual_image":                         resourceVirtualImage(),
 			"morpheus_vro_integration":                       resourceVrealizeOrchestratorIntegration(),
 			"morpheus_vro_task":                              resourceVrealizeOrchestratorTask(),
 			"morpheus_vsphere_cloud_datastore_configuration": resourceVSphereCloudDatastoreConfiguration(),
@@ -190,6 +259,9 @@ func Provider() *schema.Provider {
 		DataSourcesMap: map[string]*schema.Resource{
 			"morpheus_ansible_tower_job_template": dataSourceMorpheusAnsibleTowerJobTemplate(),
 			"morpheus_ansible_tower_inventory":    dataSourceMorpheusAnsibleTowerInventory(),
+			"morpheus_backup_provider":            dataSourceMorpheusBackupProvider(),
+			"morpheus_backup_restore_point":       dataSourceMorpheusBackupRestorePoint(),
+			"morpheus_backup_schedule":            dataSourceMorpheusBackupSchedule(),
 			"morpheus_blueprint":                  dataSourceMorpheusBlueprint(),
 			"morpheus_budget":                     dataSourceMorpheusBudget(),
 			"morpheus_catalog_item_type":          dataSourceMorpheusCatalogItemType(),
@@ -201,7 +273,10 @@ func Provider() *schema.Provider {
 			"morpheus_cloud_type":                 dataSourceMorpheusCloudType(),
 			"morpheus_cluster_type":               dataSourceMorpheusClusterType(),
 			"morpheus_contact":                    dataSourceMorpheusContact(),
+			"morpheus_cost_allocation":            dataSourceMorpheusCostAllocation(),
 			"morpheus_credential":                 dataSourceMorpheusCredential(),
+			"morpheus_credential_store":           dataSourceMorpheusCredentialStore(),
+			"morpheus_cypher":                     dataSourceMorpheusCypher(),
 			"morpheus_cypher_secret":              dataSourceMorpheusCypherSecret(),
 			"morpheus_domain":                     dataSourceMorpheusDomain(),
 			"morpheus_environment":                dataSourceMorpheusEnvironment(),
@@ -218,6 +293,7 @@ func Provider() *schema.Provider {
 			"morpheus_key_pair":                   dataSourceMorpheusKeyPair(),
 			"morpheus_network":                    dataSourceMorpheusNetwork(),
 			"morpheus_networks":                   dataSourceMorpheusNetworks(),
+			"morpheus_network_floating_ip":        dataSourceMorpheusNetworkFloatingIP(),
 			"morpheus_network_group":              dataSourceMorpheusNetworkGroup(),
 			"morpheus_network_subnet":             dataSourceMorpheusNetworkSubnet(),
 			"morpheus_node_type":                  dataSourceMorpheusNodeType(),
@@ -230,8 +306,10 @@ func Provider() *schema.Provider {
 			"morpheus_power_schedule":             dataSourceMorpheusPowerSchedule(),
 			"morpheus_price_set":                  dataSourceMorpheusPriceSet(),
 			"morpheus_price":                      dataSourceMorpheusPrice(),
+			"morpheus_process":                    dataSourceMorpheusProcess(),
 			"morpheus_provision_type":             dataSourceMorpheusProvisionType(),
 			"morpheus_resource_pool":              dataSourceMorpheusResourcePool(),
+			"morpheus_scale_threshold":            dataSourceMorpheusScaleThreshold(),
 			"morpheus_script_template":            dataSourceMorpheusScriptTemplate(),
 			"morpheus_security_package":           dataSourceMorpheusSecurityPackage(),
 			"morpheus_servicenow_workflow":        dataSourceMorpheusServiceNowWorkflow(),
@@ -244,6 +322,7 @@ func Provider() *schema.Provider {
 			"morpheus_tenant_role":                dataSourceMorpheusTenantRole(),
 			"morpheus_tenant":                     dataSourceMorpheusTenant(),
 			"morpheus_tenants":                    dataSourceMorpheusTenants(),
+			"morpheus_unmanaged_objects":          dataSourceMorpheusUnmanagedObjects(),
 			"morpheus_user_group":                 dataSourceMorpheusUserGroup(),
 			"morpheus_user_groups":                dataSourceMorpheusUserGroups(),
 			"morpheus_user_role":                  dataSourceMorpheusUserRole(),
@@ -251,10 +330,49 @@ func Provider() *schema.Provider {
 			"morpheus_virtual_image":              dataSourceMorpheusVirtualImage(),
 			"morpheus_virtual_images":             dataSourceMorpheusVirtualImages(),
 			"morpheus_vro_workflow":               dataSourceMorpheusVrealizeOrchestratorWorkflow(),
+			"morpheus_whoami":                     dataSourceMorpheusWhoami(),
+			"morpheus_wiki_pages":                 dataSourceMorpheusWikiPages(),
 			"morpheus_workflow":                   dataSourceMorpheusWorkflow(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	// every resource exposes when and by whom its object was created, except
+	// the resources not backed by an object of their own
+	for name, resource := range provider.ResourcesMap {
+		if resourcesWithoutAudit[name] {
+			continue
+		}
+		for key, value := range resourceAuditSchema() {
+			resource.Schema[key] = value
+		}
+	}
+
+	// the appliance and tenant the objects are applied to are recorded in state
+	for _, resource := range provider.ResourcesMap {
+		withAppliedTarget(resource)
+	}
+
+	// objects supporting labels are stamped with the configured managed label
+	for _, resource := range provider.ResourcesMap {
+		withManagedLabel(resource)
+	}
+
+	// resources and attributes missing from older appliances fail at plan time
+	for name, resource := range provider.ResourcesMap {
+		withVersionConstraints(name, resource)
+	}
+
+	return provider
+}
+
+// resourcesWithoutAudit lists the resources managing a setting or an
+// action rather than an object, the API returning no audit details for them
+var resourcesWithoutAudit = map[string]bool{
+	"morpheus_appliance_maintenance_mode":      true,
+	"morpheus_instance_action":                 true,
+	"morpheus_network_floating_ip_association": true,
+	"morpheus_personal_access_token":           true,
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -265,7 +383,29 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		Username:        d.Get("username").(string),
 		Password:        d.Get("password").(string),
 		Insecure:        !d.Get("secure").(bool), //.(bool),
+		ExpectedUrl:     d.Get("expected_appliance_url").(string),
+		ExpectedTenant:  d.Get("expected_tenant").(string),
+		APISummaryFile:  d.Get("api_summary_file").(string),
 	}
 
-	return config.Client()
+	sdkClient, diags := config.Client()
+	if diags.HasError() {
+		return nil, diags
+	}
+	client := &apiClient{Client: sdkClient}
+	if config.APISummaryFile != "" {
+		client.summary = newAPISummary(config.APISummaryFile, config.Url)
+	}
+	target, targetDiags := config.CheckExpectedTarget(client)
+	if targetDiags.HasError() {
+		return nil, append(diags, targetDiags...)
+	}
+	return &providerMeta{
+		client:          client,
+		insecure:        config.Insecure,
+		target:          target,
+		version:         detectApplianceVersion(client),
+		managedLabel:    d.Get("managed_label").(string),
+		defaultTenantId: d.Get("tenant_id").(int),
+	}, diags
 }
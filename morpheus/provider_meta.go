@@ -0,0 +1,42 @@
+package morpheus
+
+import (
+	"sync"
+)
+
+// providerMeta is the meta of a configured provider instance, the client of
+// the appliance along with the settings and the state of the provider the
+// resources and data sources depend on
+type providerMeta struct {
+	client *apiClient
+
+	// insecure is whether the certificate of the appliance is not verified,
+	// for the requests not sent through the client
+	insecure bool
+
+	// target is the appliance and tenant the provider manages objects in
+	target applianceTarget
+
+	// version is the detected version of the appliance, nil when unknown
+	version applianceVersion
+
+	// managedLabel is the marker label of the objects created by the provider
+	managedLabel string
+
+	// defaultTenantId is the tenant the objects supporting impersonation are
+	// managed in when they have no tenant_id, 0 for the tenant of the user
+	defaultTenantId int
+
+	// networkPoolLocks holds a mutex per network pool, serializing the
+	// reservations of the next free ip address of a pool
+	networkPoolLocks sync.Map
+}
+
+// metaClient returns the client of a configured provider, the CustomizeDiff
+// functions being called without meta before the provider is configured
+func metaClient(meta interface{}) (*apiClient, bool) {
+	if m, ok := meta.(*providerMeta); ok {
+		return m.client, true
+	}
+	return nil, false
+}
@@ -0,0 +1,32 @@
+package morpheus
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testAccProviderFactories are the providers the acceptance tests run
+// against, configured with the MORPHEUS_API_* environment variables
+var testAccProviderFactories = map[string]func() (*schema.Provider, error){
+	"morpheus": func() (*schema.Provider, error) {
+		return Provider(), nil
+	},
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+// testAccPreCheck ensures the appliance the acceptance tests run against is configured
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("MORPHEUS_API_URL") == "" {
+		t.Fatal("MORPHEUS_API_URL must be set for acceptance tests")
+	}
+	if os.Getenv("MORPHEUS_API_TOKEN") == "" && (os.Getenv("MORPHEUS_API_USERNAME") == "" || os.Getenv("MORPHEUS_API_PASSWORD") == "") {
+		t.Fatal("MORPHEUS_API_TOKEN or MORPHEUS_API_USERNAME and MORPHEUS_API_PASSWORD must be set for acceptance tests")
+	}
+}
@@ -0,0 +1,83 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// provisioningPhasesBeforeInstance are the phases of a provisioning workflow
+// running before the instance exists, the tasks executed on the instance
+// cannot run in them
+var provisioningPhasesBeforeInstance = map[string]bool{
+	"configure":    true,
+	"price":        true,
+	"preProvision": true,
+}
+
+// taskTypePlatforms maps the task types only running on some platforms when
+// executed on the instance to these platforms
+var taskTypePlatforms = map[string][]string{
+	"script":    {"linux", "macos"},
+	"winrmTask": {"windows"},
+}
+
+// provisioningWorkflowTasksCustomizeDiff checks at plan time that the tasks of
+// a provisioning workflow can run in their phase and on the platform of the
+// workflow. The ids of tasks created in the same configuration are not known
+// yet and are skipped. Every invalid task is reported at once.
+func provisioningWorkflowTasksCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := metaClient(meta)
+	if !ok {
+		return nil
+	}
+	if !d.HasChange("task") && !d.HasChange("platform") {
+		return nil
+	}
+	platform := ""
+	if d.NewValueKnown("platform") {
+		platform = d.Get("platform").(string)
+	}
+
+	var invalid []string
+	for i, item := range d.Get("task").([]interface{}) {
+		if !d.NewValueKnown(fmt.Sprintf("task.%d.task_id", i)) || !d.NewValueKnown(fmt.Sprintf("task.%d.task_phase", i)) {
+			continue
+		}
+		taskConfig := item.(map[string]interface{})
+		taskId := taskConfig["task_id"].(int)
+		phase := taskConfig["task_phase"].(string)
+		if taskId == 0 {
+			continue
+		}
+
+		resp, err := client.GetTask(int64(taskId), &morpheus.Request{})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				invalid = append(invalid, fmt.Sprintf("task %d does not exist", taskId))
+				continue
+			}
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		task := resp.Result.(*morpheus.GetTaskResult).Task
+		if task == nil {
+			continue
+		}
+
+		if provisioningPhasesBeforeInstance[phase] && (task.TaskType.Code == "restartTask" || task.ExecuteTarget == "resource") {
+			invalid = append(invalid, fmt.Sprintf("task %d (%s) is executed on the instance, which does not exist yet in the %s phase", taskId, task.Name, phase))
+		}
+		if platforms, ok := taskTypePlatforms[task.TaskType.Code]; ok && task.ExecuteTarget == "resource" && platform != "" && platform != "all" && !containsString(platforms, platform) {
+			invalid = append(invalid, fmt.Sprintf("task %d (%s) of type %s does not run on the %s platform", taskId, task.Name, task.TaskType.Code, platform))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("the provisioning workflow has invalid tasks: %s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
@@ -138,7 +138,7 @@ func resourceActiveDirectoryIdentitySource() *schema.Resource {
 }
 
 func resourceActiveDirectoryIdentitySourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -197,7 +197,7 @@ func resourceActiveDirectoryIdentitySourceCreate(ctx context.Context, d *schema.
 }
 
 func resourceActiveDirectoryIdentitySourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -227,6 +227,7 @@ func resourceActiveDirectoryIdentitySourceRead(ctx context.Context, d *schema.Re
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetIdentitySourceResult)
@@ -263,7 +264,7 @@ func resourceActiveDirectoryIdentitySourceRead(ctx context.Context, d *schema.Re
 }
 
 func resourceActiveDirectoryIdentitySourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	identitySource := make(map[string]interface{})
@@ -305,7 +306,9 @@ func resourceActiveDirectoryIdentitySourceUpdate(ctx context.Context, d *schema.
 		},
 	}
 
-	resp, err := client.UpdateIdentitySource(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateIdentitySource(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -321,14 +324,16 @@ func resourceActiveDirectoryIdentitySourceUpdate(ctx context.Context, d *schema.
 }
 
 func resourceActiveDirectoryIdentitySourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteIdentitySource(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteIdentitySource(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -136,6 +136,7 @@ func resourceAnsibleIntegration() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"force_delete": forceDeleteSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -144,7 +145,7 @@ func resourceAnsibleIntegration() *schema.Resource {
 }
 
 func resourceAnsibleIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -197,7 +198,7 @@ func resourceAnsibleIntegrationCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAnsibleIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -227,6 +228,7 @@ func resourceAnsibleIntegrationRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetIntegrationResult)
@@ -252,7 +254,7 @@ func resourceAnsibleIntegrationRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAnsibleIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	integration := make(map[string]interface{})
@@ -286,7 +288,9 @@ func resourceAnsibleIntegrationUpdate(ctx context.Context, d *schema.ResourceDat
 		},
 	}
 
-	resp, err := client.UpdateIntegration(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -302,14 +306,21 @@ func resourceAnsibleIntegrationUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAnsibleIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteIntegration(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "integration", func() ([]string, error) {
+		return integrationDependents(meta, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -2,12 +2,16 @@ package morpheus
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"log"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAnsiblePlaybookTask() *schema.Resource {
@@ -80,6 +84,23 @@ func resourceAnsiblePlaybookTask() *schema.Resource {
 				Description: "The target that the ansible playbook will be executed on",
 				Optional:    true,
 			},
+			"become": {
+				Type:        schema.TypeBool,
+				Description: "Whether to run the ansible playbook with privilege escalation on the target, passed as the --become command option",
+				Optional:    true,
+				Default:     false,
+			},
+			"become_method": {
+				Type:         schema.TypeString,
+				Description:  "The privilege escalation method used when become is enabled (sudo, su, pbrun, pfexec, doas, dzdo, ksu, runas, machinectl)",
+				ValidateFunc: validation.StringInSlice([]string{"sudo", "su", "pbrun", "pfexec", "doas", "dzdo", "ksu", "runas", "machinectl"}, false),
+				Optional:     true,
+			},
+			"become_user": {
+				Type:        schema.TypeString,
+				Description: "The user to become when become is enabled, root when not set",
+				Optional:    true,
+			},
 			"retryable": {
 				Type:        schema.TypeBool,
 				Description: "Whether to retry the task if there is a failure",
@@ -98,22 +119,32 @@ func resourceAnsiblePlaybookTask() *schema.Resource {
 				Optional:    true,
 				Default:     10,
 			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
 			"allow_custom_config": {
 				Type:        schema.TypeBool,
 				Description: "Custom configuration data to pass during the execution of the ansible playbook",
 				Optional:    true,
 				Default:     false,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the ansible playbook task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: customdiff.Sequence(
+			ansibleBecomeCustomizeDiff,
+			tenantIdCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceAnsiblePlaybookTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -125,7 +156,7 @@ func resourceAnsiblePlaybookTaskCreate(ctx context.Context, d *schema.ResourceDa
 	taskOptions["ansiblePlaybook"] = d.Get("playbook")
 	taskOptions["ansibleTags"] = d.Get("tags")
 	taskOptions["ansibleSkipTags"] = d.Get("skip_tags")
-	taskOptions["ansibleOptions"] = d.Get("command_options")
+	taskOptions["ansibleOptions"] = ansibleBecomeOptions(d.Get("command_options").(string), d.Get("become").(bool), d.Get("become_method").(string), d.Get("become_user").(string))
 
 	taskType := make(map[string]interface{})
 	taskType["code"] = "ansibleTask"
@@ -136,6 +167,7 @@ func resourceAnsiblePlaybookTaskCreate(ctx context.Context, d *schema.ResourceDa
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -150,10 +182,11 @@ func resourceAnsiblePlaybookTaskCreate(ctx context.Context, d *schema.ResourceDa
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -170,8 +203,6 @@ func resourceAnsiblePlaybookTaskCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAnsiblePlaybookTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -182,9 +213,9 @@ func resourceAnsiblePlaybookTaskRead(ctx context.Context, d *schema.ResourceData
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -202,6 +233,8 @@ func resourceAnsiblePlaybookTaskRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -215,17 +248,25 @@ func resourceAnsiblePlaybookTaskRead(ctx context.Context, d *schema.ResourceData
 	d.Set("playbook", ansiblePlaybookTask.TaskOptions.AnsiblePlaybook)
 	d.Set("tags", ansiblePlaybookTask.TaskOptions.AnsibleTags)
 	d.Set("skip_tags", ansiblePlaybookTask.TaskOptions.AnsibleSkipTags)
-	d.Set("command_options", ansiblePlaybookTask.TaskOptions.AnsibleOptions)
+	commandOptions, become, becomeMethod, becomeUser := parseAnsibleBecomeOptions(ansiblePlaybookTask.TaskOptions.AnsibleOptions)
+	if _, ok, _, _ := parseAnsibleBecomeOptions(d.Get("command_options").(string)); ok {
+		// the privilege escalation is configured in the command options
+		commandOptions, become, becomeMethod, becomeUser = ansiblePlaybookTask.TaskOptions.AnsibleOptions, false, "", ""
+	}
+	d.Set("command_options", commandOptions)
+	d.Set("become", become)
+	d.Set("become_method", becomeMethod)
+	d.Set("become_user", becomeUser)
 	d.Set("execute_target", ansiblePlaybookTask.ExecuteTarget)
 	d.Set("retryable", ansiblePlaybookTask.Retryable)
 	d.Set("retry_count", ansiblePlaybookTask.RetryCount)
 	d.Set("retry_delay_seconds", ansiblePlaybookTask.RetryDelaySeconds)
 	d.Set("allow_custom_config", ansiblePlaybookTask.AllowCustomConfig)
+	d.Set("visibility", ansiblePlaybookTask.Visibility)
 	return diags
 }
 
 func resourceAnsiblePlaybookTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -235,7 +276,7 @@ func resourceAnsiblePlaybookTaskUpdate(ctx context.Context, d *schema.ResourceDa
 	taskOptions["ansiblePlaybook"] = d.Get("playbook")
 	taskOptions["ansibleTags"] = d.Get("tags")
 	taskOptions["ansibleSkipTags"] = d.Get("skip_tags")
-	taskOptions["ansibleOptions"] = d.Get("command_options")
+	taskOptions["ansibleOptions"] = ansibleBecomeOptions(d.Get("command_options").(string), d.Get("become").(bool), d.Get("become_method").(string), d.Get("become_user").(string))
 
 	taskType := make(map[string]interface{})
 	taskType["code"] = "ansibleTask"
@@ -246,6 +287,7 @@ func resourceAnsiblePlaybookTaskUpdate(ctx context.Context, d *schema.ResourceDa
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -260,10 +302,13 @@ func resourceAnsiblePlaybookTaskUpdate(ctx context.Context, d *schema.ResourceDa
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config").(bool),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -278,14 +323,14 @@ func resourceAnsiblePlaybookTaskUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAnsiblePlaybookTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -299,3 +344,135 @@ func resourceAnsiblePlaybookTaskDelete(ctx context.Context, d *schema.ResourceDa
 	d.SetId("")
 	return diags
 }
+
+// ansibleBecomeCustomizeDiff rejects the privilege escalation method and
+// user when become is not enabled, they would not be passed to ansible
+func ansibleBecomeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("become").(bool) {
+		return nil
+	}
+	for _, key := range []string{"become_method", "become_user"} {
+		if value, ok := d.GetOk(key); ok && value.(string) != "" {
+			return fmt.Errorf("%s requires become to be true", key)
+		}
+	}
+	return nil
+}
+
+// ansibleBecomeOptions appends the privilege escalation settings to the
+// command options of an ansible playbook task, the api has no dedicated
+// fields for them. The command options are left as they are written.
+func ansibleBecomeOptions(commandOptions string, become bool, becomeMethod string, becomeUser string) string {
+	if !become {
+		return commandOptions
+	}
+	options := []string{"--become"}
+	if becomeMethod != "" {
+		options = append(options, "--become-method="+becomeMethod)
+	}
+	if becomeUser != "" {
+		options = append(options, "--become-user="+becomeUser)
+	}
+	if commandOptions == "" {
+		return strings.Join(options, " ")
+	}
+	return commandOptions + " " + strings.Join(options, " ")
+}
+
+// parseAnsibleBecomeOptions splits the privilege escalation settings out of
+// the command options of an ansible playbook task. Only the become options
+// and the whitespace separating them are removed, the other options keep
+// their quoting and spacing.
+func parseAnsibleBecomeOptions(ansibleOptions string) (commandOptions string, become bool, becomeMethod string, becomeUser string) {
+	tokens := ansibleOptionTokens(ansibleOptions)
+	removedTokens := make([]bool, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		switch field := ansibleOptions[tokens[i][0]:tokens[i][1]]; {
+		case field == "--become" || field == "-b":
+			become = true
+			removedTokens[i] = true
+		case strings.HasPrefix(field, "--become-method="):
+			becomeMethod = strings.TrimPrefix(field, "--become-method=")
+			removedTokens[i] = true
+		case strings.HasPrefix(field, "--become-user="):
+			becomeUser = strings.TrimPrefix(field, "--become-user=")
+			removedTokens[i] = true
+		case (field == "--become-method" || field == "--become-user") && i+1 < len(tokens):
+			value := ansibleOptions[tokens[i+1][0]:tokens[i+1][1]]
+			if field == "--become-method" {
+				becomeMethod = value
+			} else {
+				becomeUser = value
+			}
+			removedTokens[i], removedTokens[i+1] = true, true
+			i++
+		}
+	}
+
+	// a removed option takes the whitespace before it along, or the one
+	// after it when no option before it is kept
+	removed := make([]bool, len(ansibleOptions))
+	kept := false
+	for i, token := range tokens {
+		if !removedTokens[i] {
+			kept = true
+			continue
+		}
+		start, end := token[0], token[1]
+		if kept {
+			start = tokens[i-1][1]
+		} else if i+1 < len(tokens) {
+			end = tokens[i+1][0]
+		}
+		for j := start; j < end; j++ {
+			removed[j] = true
+		}
+	}
+	var b strings.Builder
+	for i := 0; i < len(ansibleOptions); i++ {
+		if !removed[i] {
+			b.WriteByte(ansibleOptions[i])
+		}
+	}
+	return b.String(), become, becomeMethod, becomeUser
+}
+
+// ansibleOptionTokens returns the start and end offsets of the options of a
+// command line, the whitespace within quotes not separating options
+func ansibleOptionTokens(options string) [][2]int {
+	var tokens [][2]int
+	start := -1
+	var quote byte
+	for i := 0; i < len(options); i++ {
+		c := options[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' && i+1 < len(options) {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if start >= 0 {
+				tokens = append(tokens, [2]int{start, i})
+				start = -1
+			}
+			continue
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '\\' && i+1 < len(options):
+			if start < 0 {
+				start = i
+			}
+			i++
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, [2]int{start, len(options)})
+	}
+	return tokens
+}
@@ -0,0 +1,58 @@
+package morpheus
+
+import "testing"
+
+func TestAnsibleBecomeOptions(t *testing.T) {
+	cases := map[string]struct {
+		commandOptions string
+		become         bool
+		becomeMethod   string
+		becomeUser     string
+		ansibleOptions string
+	}{
+		"no become":      {commandOptions: "-vv", ansibleOptions: "-vv"},
+		"quoted options": {commandOptions: `-e "a  b"`, become: true, ansibleOptions: `-e "a  b" --become`},
+		"become":         {commandOptions: "-vv", become: true, ansibleOptions: "-vv --become"},
+		"become as user": {become: true, becomeMethod: "su", becomeUser: "app", ansibleOptions: "--become --become-method=su --become-user=app"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ansibleOptions := ansibleBecomeOptions(tc.commandOptions, tc.become, tc.becomeMethod, tc.becomeUser)
+			if ansibleOptions != tc.ansibleOptions {
+				t.Errorf("ansibleBecomeOptions() = %q, want %q", ansibleOptions, tc.ansibleOptions)
+			}
+			commandOptions, become, becomeMethod, becomeUser := parseAnsibleBecomeOptions(ansibleOptions)
+			if commandOptions != tc.commandOptions || become != tc.become || becomeMethod != tc.becomeMethod || becomeUser != tc.becomeUser {
+				t.Errorf("parseAnsibleBecomeOptions(%q) = %q, %t, %q, %q", ansibleOptions, commandOptions, become, becomeMethod, becomeUser)
+			}
+		})
+	}
+}
+
+func TestParseAnsibleBecomeOptionsSeparateValues(t *testing.T) {
+	commandOptions, become, becomeMethod, becomeUser := parseAnsibleBecomeOptions("-b --become-method sudo --become-user app --diff")
+	if commandOptions != "--diff" || !become || becomeMethod != "sudo" || becomeUser != "app" {
+		t.Errorf("parseAnsibleBecomeOptions() = %q, %t, %q, %q", commandOptions, become, becomeMethod, becomeUser)
+	}
+}
+
+func TestParseAnsibleBecomeOptionsKeepsOtherOptions(t *testing.T) {
+	cases := map[string]struct {
+		ansibleOptions string
+		commandOptions string
+	}{
+		"quoted value":      {ansibleOptions: `-e "greeting=hello  world" --become`, commandOptions: `-e "greeting=hello  world"`},
+		"single quotes":     {ansibleOptions: `--become -e 'a b'`, commandOptions: `-e 'a b'`},
+		"spacing kept":      {ansibleOptions: "-vv  --diff\t--become-user app", commandOptions: "-vv  --diff"},
+		"quoted become":     {ansibleOptions: `-e "--become"`, commandOptions: `-e "--become"`},
+		"become in between": {ansibleOptions: "-vv -b --diff", commandOptions: "-vv --diff"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			commandOptions, _, _, _ := parseAnsibleBecomeOptions(tc.ansibleOptions)
+			if commandOptions != tc.commandOptions {
+				t.Errorf("parseAnsibleBecomeOptions(%q) = %q, want %q", tc.ansibleOptions, commandOptions, tc.commandOptions)
+			}
+		})
+	}
+}
@@ -69,6 +69,7 @@ func resourceAnsibleTowerIntegration() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"username", "password"},
 			},
+			"force_delete": forceDeleteSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -77,7 +78,7 @@ func resourceAnsibleTowerIntegration() *schema.Resource {
 }
 
 func resourceAnsibleTowerIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -127,7 +128,7 @@ func resourceAnsibleTowerIntegrationCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceAnsibleTowerIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -157,6 +158,7 @@ func resourceAnsibleTowerIntegrationRead(ctx context.Context, d *schema.Resource
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetIntegrationResult)
@@ -176,7 +178,7 @@ func resourceAnsibleTowerIntegrationRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceAnsibleTowerIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	integration := make(map[string]interface{})
@@ -210,7 +212,9 @@ func resourceAnsibleTowerIntegrationUpdate(ctx context.Context, d *schema.Resour
 		},
 	}
 
-	resp, err := client.UpdateIntegration(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -226,14 +230,21 @@ func resourceAnsibleTowerIntegrationUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceAnsibleTowerIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteIntegration(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "integration", func() ([]string, error) {
+		return integrationDependents(meta, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -113,16 +113,16 @@ func resourceAnsibleTowerTask() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the ansible tower task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceAnsibleTowerTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -145,6 +145,7 @@ func resourceAnsibleTowerTaskCreate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -164,7 +165,7 @@ func resourceAnsibleTowerTaskCreate(ctx context.Context, d *schema.ResourceData,
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -181,8 +182,6 @@ func resourceAnsibleTowerTaskCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAnsibleTowerTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -193,9 +192,9 @@ func resourceAnsibleTowerTaskRead(ctx context.Context, d *schema.ResourceData, m
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -213,6 +212,8 @@ func resourceAnsibleTowerTaskRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	result := resp.Result.(*morpheus.GetTaskResult)
 	ansibleTowerTask := result.Task
@@ -249,7 +250,6 @@ func resourceAnsibleTowerTaskRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAnsibleTowerTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -281,6 +281,7 @@ func resourceAnsibleTowerTaskUpdate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -299,7 +300,9 @@ func resourceAnsibleTowerTaskUpdate(ctx context.Context, d *schema.ResourceData,
 			},
 		},
 	}
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -314,14 +317,14 @@ func resourceAnsibleTowerTaskUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAnsibleTowerTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
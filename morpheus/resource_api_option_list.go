@@ -78,7 +78,7 @@ func resourceApiOptionList() *schema.Resource {
 }
 
 func resourceApiOptionListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -91,6 +91,7 @@ func resourceApiOptionListCreate(ctx context.Context, d *schema.ResourceData, me
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"optionTypeList": map[string]interface{}{
@@ -122,7 +123,7 @@ func resourceApiOptionListCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceApiOptionListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -152,6 +153,7 @@ func resourceApiOptionListRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetOptionListResult)
@@ -174,7 +176,7 @@ func resourceApiOptionListRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceApiOptionListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -184,6 +186,7 @@ func resourceApiOptionListUpdate(ctx context.Context, d *schema.ResourceData, me
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"optionTypeList": map[string]interface{}{
@@ -198,7 +201,9 @@ func resourceApiOptionListUpdate(ctx context.Context, d *schema.ResourceData, me
 			},
 		},
 	}
-	resp, err := client.UpdateOptionList(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateOptionList(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -213,14 +218,16 @@ func resourceApiOptionListUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceApiOptionListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteOptionList(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteOptionList(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -2,13 +2,13 @@ package morpheus
 
 import (
 	"context"
-	"os"
 	"strings"
 
 	"log"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -78,9 +78,10 @@ func resourceAppBlueprintCatalogItem() *schema.Resource {
 				Required:    true,
 			},
 			"app_spec": {
-				Type:        schema.TypeString,
-				Description: "The app spec associated with the app blueprint catalog item",
-				Required:    true,
+				Type:             schema.TypeString,
+				Description:      "The app spec associated with the app blueprint catalog item, as a YAML or JSON document",
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentYamlDiffs,
 			},
 			"option_type_ids": {
 				Type:        schema.TypeList,
@@ -99,6 +100,8 @@ func resourceAppBlueprintCatalogItem() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"option_type_ids"},
 			},
+			"option_type":         catalogItemOptionTypeSchema(),
+			"form_field_override": catalogItemFormFieldOverrideSchema(),
 			"logo_image_name": {
 				Type:        schema.TypeString,
 				Description: "The file name of the app blueprint catalog item logo image",
@@ -106,11 +109,13 @@ func resourceAppBlueprintCatalogItem() *schema.Resource {
 				Computed:    true,
 			},
 			"logo_image_path": {
-				Type:        schema.TypeString,
-				Description: "The file path of the app blueprint catalog item logo image including the file name",
-				Optional:    true,
-				Computed:    true,
+				Type:             schema.TypeString,
+				Description:      "The file path of the app blueprint catalog item logo image including the file name",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressImportedLogoPath("logo_image_name"),
 			},
+			"logo_image_content": catalogItemLogoContentSchema("The content of the app blueprint catalog item logo image, base64 encoded as returned by filebase64() or raw, instead of the file path", "logo_image_name", "logo_image_path", "logo_image_content"),
 			"dark_logo_image_name": {
 				Type:        schema.TypeString,
 				Description: "The file name of the app blueprint catalog item dark mode logo image",
@@ -118,30 +123,44 @@ func resourceAppBlueprintCatalogItem() *schema.Resource {
 				Computed:    true,
 			},
 			"dark_logo_image_path": {
-				Type:        schema.TypeString,
-				Description: "The file path of the app blueprint catalog item dark mode logo image including the file name",
-				Optional:    true,
-				Computed:    true,
+				Type:             schema.TypeString,
+				Description:      "The file path of the app blueprint catalog item dark mode logo image including the file name",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressImportedLogoPath("dark_logo_image_name"),
 			},
+			"dark_logo_image_content":  catalogItemLogoContentSchema("The content of the app blueprint catalog item dark mode logo image, base64 encoded as returned by filebase64() or raw, instead of the file path", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content"),
+			"logo_image_checksum":      logoChecksumSchema("The checksum of the uploaded app blueprint catalog item logo image, a change of the content of the image uploads it again"),
+			"dark_logo_image_checksum": logoChecksumSchema("The checksum of the uploaded app blueprint catalog item dark mode logo image, a change of the content of the image uploads it again"),
 			"visibility": {
-				Type:         schema.TypeString,
-				Description:  "The visibility of the app blueprint catalog item (public or private)",
-				Required:     true,
+				Type:             schema.TypeString,
+				Description:      "The visibility of the app blueprint catalog item (public or private)",
+				Required:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"public", "private"}, false)),
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the app blueprint catalog item is created in, the tenant_id argument of the provider or the tenant of the user when not set. The option types and forms referenced by the catalog item must be visible to the tenant"),
 		},
+		CustomizeDiff: customdiff.All(
+			catalogItemReferencesCustomizeDiff("blueprint_id", "option_type_ids", "form_id"),
+			logoChecksumCustomizeDiff("logo_image_checksum", "logo_image_name", "logo_image_path", "logo_image_content"),
+			logoChecksumCustomizeDiff("dark_logo_image_checksum", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content"),
+			tenantIdCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	ownedOptionTypeIds, _, err := syncCatalogItemOptionTypes(ctx, meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	catalogItem := make(map[string]interface{})
 
 	catalogItem["name"] = d.Get("name").(string)
@@ -151,7 +170,7 @@ func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.Resour
 	catalogItem["featured"] = d.Get("featured").(bool)
 	catalogItem["type"] = "blueprint"
 	catalogItem["iconPath"] = "custom"
-	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	catalogItem["optionTypes"] = catalogItemOptionTypeIds(d, ownedOptionTypeIds)
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -167,6 +186,7 @@ func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.Resour
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	catalogItem["labels"] = labelsPayload
 
 	if d.Get("form_id").(int) > 0 {
@@ -174,6 +194,7 @@ func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.Resour
 		catalogItem["form"] = map[string]interface{}{
 			"id": d.Get("form_id").(int),
 		}
+		catalogItem["formConfig"] = catalogItemFormConfigPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -182,9 +203,12 @@ func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.Resour
 		},
 	}
 
-	resp, err := client.CreateCatalogItem(req)
+	resp, err := catalogItemApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
+		if err := deleteCatalogItemOptionTypes(ctx, meta, d, ownedOptionTypeIds); err != nil {
+			log.Printf("unable to delete the option types of the catalog item: %s", err)
+		}
 		return diag.FromErr(err)
 	}
 	log.Printf("API RESPONSE: %s", resp)
@@ -193,36 +217,26 @@ func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.Resour
 	catalogItemResult := result.CatalogItem
 	var filePayloads []*morpheus.FilePayload
 
-	if d.Get("logo_image_path") != "" && d.Get("logo_image_name") != "" {
-		data, err := os.ReadFile(d.Get("logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("logo_image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
+	logo, err := catalogItemLogoFile(d, "logo", "logo_image_name", "logo_image_path", "logo_image_content")
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if d.Get("dark_logo_image_path") != "" && d.Get("dark_logo_image_name") != "" {
-		darkLogoData, err := os.ReadFile(d.Get("dark_logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		darkLogoPayload := &morpheus.FilePayload{
-			ParameterName: "darkLogo",
-			FileName:      d.Get("dark_logo_image_name").(string),
-			FileContent:   darkLogoData,
-		}
-		filePayloads = append(filePayloads, darkLogoPayload)
+	if logo != nil {
+		filePayloads = append(filePayloads, logo)
+	}
+	darkLogo, err := catalogItemLogoFile(d, "darkLogo", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if darkLogo != nil {
+		filePayloads = append(filePayloads, darkLogo)
 	}
 
 	// any payloads
 	if len(filePayloads) > 0 {
-		response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
+		response, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+			return catalogItemApi.updateLogo(meta, d, catalogItemResult.ID, filePayloads)
+		})
 		if err != nil {
 			log.Printf("API FAILURE: %s - %s", response, err)
 		}
@@ -237,7 +251,7 @@ func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceAppBlueprintCatalogItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -248,9 +262,9 @@ func resourceAppBlueprintCatalogItemRead(ctx context.Context, d *schema.Resource
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindCatalogItemByName(name)
+		resp, err = catalogItemApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetCatalogItem(toInt64(id), &morpheus.Request{})
+		resp, err = catalogItemApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Catalog Item cannot be read without name or id")
 	}
@@ -267,6 +281,8 @@ func resourceAppBlueprintCatalogItemRead(ctx context.Context, d *schema.Resource
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 	// store resource data
 	result := resp.Result.(*morpheus.GetCatalogItemResult)
 	catalogItem := result.CatalogItem
@@ -278,17 +294,13 @@ func resourceAppBlueprintCatalogItemRead(ctx context.Context, d *schema.Resource
 	d.Set("enabled", catalogItem.Enabled)
 	d.Set("featured", catalogItem.Featured)
 	// option types
-	var optionTypes []int64
-	if catalogItem.OptionTypes != nil {
-		// iterate over the array of tasks
-		for i := 0; i < len(catalogItem.OptionTypes); i++ {
-			option := catalogItem.OptionTypes[i].(map[string]interface{})
-			optionID := int64(option["id"].(float64))
-			optionTypes = append(optionTypes, optionID)
-		}
+	optionTypes, err := readCatalogItemOptionTypes(meta, d, catalogItemAttachedOptionTypeIds(catalogItem))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	d.Set("option_type_ids", optionTypes)
 	d.Set("form_id", catalogItem.Form.ID)
+	d.Set("form_field_override", parseCatalogItemFormConfig(catalogItem.FormConfig))
 	d.Set("app_spec", catalogItem.AppSpec)
 	d.Set("content", catalogItem.Content)
 	d.Set("blueprint_id", catalogItem.Blueprint.ID)
@@ -296,16 +308,22 @@ func resourceAppBlueprintCatalogItemRead(ctx context.Context, d *schema.Resource
 	imagePath := strings.Split(catalogItem.ImagePath, "/")
 	opt := strings.Replace(imagePath[len(imagePath)-1], "_original", "", 1)
 	d.Set("logo_image_name", opt)
+	readLogoChecksum(client, d, "logo_image_checksum", catalogItem.ImagePath)
 	darkImagePath := strings.Split(catalogItem.DarkImagePath, "/")
 	darkOpt := strings.Replace(darkImagePath[len(darkImagePath)-1], "_original", "", 1)
 	d.Set("dark_logo_image_name", darkOpt)
+	readLogoChecksum(client, d, "dark_logo_image_checksum", catalogItem.DarkImagePath)
 	return diags
 }
 
 func resourceAppBlueprintCatalogItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 
+	ownedOptionTypeIds, removedOptionTypeIds, err := syncCatalogItemOptionTypes(ctx, meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	catalogItem := make(map[string]interface{})
 
 	catalogItem["name"] = d.Get("name").(string)
@@ -315,7 +333,7 @@ func resourceAppBlueprintCatalogItemUpdate(ctx context.Context, d *schema.Resour
 	catalogItem["featured"] = d.Get("featured").(bool)
 	catalogItem["type"] = "blueprint"
 	catalogItem["iconPath"] = "custom"
-	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	catalogItem["optionTypes"] = catalogItemOptionTypeIds(d, ownedOptionTypeIds)
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -332,6 +350,7 @@ func resourceAppBlueprintCatalogItemUpdate(ctx context.Context, d *schema.Resour
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	catalogItem["labels"] = labelsPayload
 
 	if d.Get("form_id").(int) > 0 {
@@ -339,6 +358,7 @@ func resourceAppBlueprintCatalogItemUpdate(ctx context.Context, d *schema.Resour
 		catalogItem["form"] = map[string]interface{}{
 			"id": d.Get("form_id").(int),
 		}
+		catalogItem["formConfig"] = catalogItemFormConfigPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -346,47 +366,44 @@ func resourceAppBlueprintCatalogItemUpdate(ctx context.Context, d *schema.Resour
 			"catalogItemType": catalogItem,
 		},
 	}
-	resp, err := client.UpdateCatalogItem(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return catalogItemApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
 	}
 	log.Printf("API RESPONSE: %s", resp)
+
+	// the option types of the removed blocks are no longer used by the catalog item
+	if err := deleteCatalogItemOptionTypes(ctx, meta, d, removedOptionTypeIds); err != nil {
+		return diag.FromErr(err)
+	}
 	result := resp.Result.(*morpheus.UpdateCatalogItemResult)
 	catalogItemResult := result.CatalogItem
 
 	var filePayloads []*morpheus.FilePayload
 
-	if d.Get("logo_image_path") != "" && d.Get("logo_image_name") != "" {
-		data, err := os.ReadFile(d.Get("logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("logo_image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
+	logo, err := catalogItemLogoFile(d, "logo", "logo_image_name", "logo_image_path", "logo_image_content")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if logo != nil {
+		filePayloads = append(filePayloads, logo)
 	}
 
-	if d.Get("dark_logo_image_path") != "" && d.Get("dark_logo_image_name") != "" {
-		darkLogoData, err := os.ReadFile(d.Get("dark_logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		darkLogoPayload := &morpheus.FilePayload{
-			ParameterName: "darkLogo",
-			FileName:      d.Get("dark_logo_image_name").(string),
-			FileContent:   darkLogoData,
-		}
-		filePayloads = append(filePayloads, darkLogoPayload)
+	darkLogo, err := catalogItemLogoFile(d, "darkLogo", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if darkLogo != nil {
+		filePayloads = append(filePayloads, darkLogo)
 	}
 
 	if len(filePayloads) > 0 {
-		response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
+		response, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+			return catalogItemApi.updateLogo(meta, d, catalogItemResult.ID, filePayloads)
+		})
 		if err != nil {
 			log.Printf("API FAILURE: %s - %s", response, err)
 		}
@@ -400,14 +417,14 @@ func resourceAppBlueprintCatalogItemUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceAppBlueprintCatalogItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteCatalogItem(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return catalogItemApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -418,6 +435,9 @@ func resourceAppBlueprintCatalogItemDelete(ctx context.Context, d *schema.Resour
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	if err := deleteCatalogItemOptionTypes(ctx, meta, d, ownedCatalogItemOptionTypeIds(d)); err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId("")
 	return diags
 }
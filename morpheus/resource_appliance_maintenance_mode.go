@@ -0,0 +1,130 @@
+package morpheus
+
+import (
+	"context"
+	"strconv"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceApplianceMaintenanceMode() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus appliance maintenance mode resource, putting the appliance in maintenance mode for the lifetime of the resource so a maintenance window can be opened and closed by the pipeline performing the maintenance.",
+		CreateContext: resourceApplianceMaintenanceModeCreate,
+		ReadContext:   resourceApplianceMaintenanceModeRead,
+		UpdateContext: resourceApplianceMaintenanceModeUpdate,
+		DeleteContext: resourceApplianceMaintenanceModeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the appliance maintenance mode",
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the appliance is in maintenance mode",
+				Optional:    true,
+				Default:     true,
+			},
+			"disable_on_destroy": {
+				Type:        schema.TypeBool,
+				Description: "Whether the maintenance mode is disabled when the resource is destroyed",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceApplianceMaintenanceModeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if err := setMaintenanceMode(ctx, meta.(*providerMeta).client, d.Get("enabled").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(1))
+
+	resourceApplianceMaintenanceModeRead(ctx, d, meta)
+	return diags
+}
+
+func resourceApplianceMaintenanceModeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.GetApplianceSettings(&morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetApplianceSettingsResult)
+	if result.ApplianceSettings == nil {
+		return diag.Errorf("Appliance settings not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(1))
+	d.Set("enabled", result.ApplianceSettings.MaintenanceMode)
+
+	return diags
+}
+
+func resourceApplianceMaintenanceModeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("enabled") {
+		if err := setMaintenanceMode(ctx, meta.(*providerMeta).client, d.Get("enabled").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceApplianceMaintenanceModeRead(ctx, d, meta)
+}
+
+func resourceApplianceMaintenanceModeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if d.Get("disable_on_destroy").(bool) {
+		if err := setMaintenanceMode(ctx, meta.(*providerMeta).client, false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("")
+	return diags
+}
+
+func setMaintenanceMode(ctx context.Context, client *apiClient, enabled bool) error {
+	req := &morpheus.Request{
+		QueryParams: map[string]string{
+			"enabled": strconv.FormatBool(enabled),
+		},
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.ToggleMaintenanceMode(req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return nil
+}
@@ -266,7 +266,7 @@ func resourceApplianceSetting() *schema.Resource {
 }
 
 func resourceApplianceSettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -388,7 +388,9 @@ func resourceApplianceSettingCreate(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	resp, err := client.UpdateApplianceSettings(req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateApplianceSettings(req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -405,7 +407,7 @@ func resourceApplianceSettingCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceApplianceSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -427,6 +429,7 @@ func resourceApplianceSettingRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetApplianceSettingsResult)
@@ -465,7 +468,7 @@ func resourceApplianceSettingRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceApplianceSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	applianceSettings := make(map[string]interface{})
 
@@ -551,7 +554,9 @@ func resourceApplianceSettingUpdate(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	resp, err := client.UpdateApplianceSettings(req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateApplianceSettings(req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -20,6 +20,7 @@ func resourceArmAppBlueprint() *schema.Resource {
 		ReadContext:   resourceArmAppBlueprintRead,
 		UpdateContext: resourceArmAppBlueprintUpdate,
 		DeleteContext: resourceArmAppBlueprintDelete,
+		CustomizeDiff: appBlueprintSourceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -102,7 +103,7 @@ func resourceArmAppBlueprint() *schema.Resource {
 }
 
 func resourceArmAppBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -131,12 +132,7 @@ func resourceArmAppBlueprintCreate(ctx context.Context, d *schema.ResourceData,
 
 	case "repository":
 		armConfig["configType"] = "git"
-		armGitConfig := make(map[string]interface{})
-		armGitConfig["integrationId"] = d.Get("integration_id")
-		armGitConfig["repoId"] = d.Get("repository_id")
-		armGitConfig["branch"] = d.Get("version_ref").(string)
-		armGitConfig["path"] = d.Get("working_path").(string)
-		armConfig["git"] = armGitConfig
+		armConfig["git"] = appBlueprintGitPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -168,7 +164,7 @@ func resourceArmAppBlueprintCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceArmAppBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -200,6 +196,7 @@ func resourceArmAppBlueprintRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var armBlueprint ArmAppBlueprint
@@ -219,16 +216,13 @@ func resourceArmAppBlueprintRead(ctx context.Context, d *schema.ResourceData, me
 		d.Set("blueprint_content", armBlueprint.Blueprint.Config.Arm.JSON)
 	case "git":
 		d.Set("source_type", "repository")
-		d.Set("working_path", armBlueprint.Blueprint.Config.Arm.Git.Path)
-		d.Set("integration_id", armBlueprint.Blueprint.Config.Arm.Git.IntegrationId)
-		d.Set("repository_id", armBlueprint.Blueprint.Config.Arm.Git.RepoId)
-		d.Set("version_ref", armBlueprint.Blueprint.Config.Arm.Git.Branch)
+		setAppBlueprintGit(d, armBlueprint.Blueprint.Config.Arm.Git)
 	}
 	return diags
 }
 
 func resourceArmAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	name := d.Get("name").(string)
@@ -255,12 +249,7 @@ func resourceArmAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData,
 
 	case "repository":
 		armConfig["configType"] = "git"
-		armGitConfig := make(map[string]interface{})
-		armGitConfig["integrationId"] = d.Get("integration_id")
-		armGitConfig["repoId"] = d.Get("repository_id")
-		armGitConfig["branch"] = d.Get("version_ref").(string)
-		armGitConfig["path"] = d.Get("working_path").(string)
-		armConfig["git"] = armGitConfig
+		armConfig["git"] = appBlueprintGitPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -275,7 +264,9 @@ func resourceArmAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	resp, err := client.UpdateBlueprint(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBlueprint(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -290,14 +281,16 @@ func resourceArmAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceArmAppBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteBlueprint(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteBlueprint(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -323,17 +316,12 @@ type ArmAppBlueprint struct {
 			Name        string `json:"name"`
 			Description string `json:"description"`
 			Arm         struct {
-				Configtype       string `json:"configType"`
-				OsType           string `json:"osType"`
-				CloudInitEnabled bool   `json:"cloudInitEnabled"`
-				InstallAgent     bool   `json:"installAgent"`
-				JSON             string `json:"json"`
-				Git              struct {
-					Path          string `json:"path"`
-					RepoId        int    `json:"repoId"`
-					IntegrationId int    `json:"integrationId"`
-					Branch        string `json:"branch"`
-				} `json:"git"`
+				Configtype       string          `json:"configType"`
+				OsType           string          `json:"osType"`
+				CloudInitEnabled bool            `json:"cloudInitEnabled"`
+				InstallAgent     bool            `json:"installAgent"`
+				JSON             string          `json:"json"`
+				Git              appBlueprintGit `json:"git"`
 			} `json:"arm"`
 			Type     string `json:"type"`
 			Category string `json:"category"`
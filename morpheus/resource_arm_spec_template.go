@@ -62,50 +62,43 @@ func resourceArmSpecTemplate() *schema.Resource {
 				Description: "The git reference of the repository to pull (main, master, etc.)",
 				Optional:    true,
 			},
+			"config":    specTemplateConfigSchema(),
+			"tenant_id": tenantIdSchema("The id of the tenant the arm spec template is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceArmSpecTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "spec_content", "spec_path")
 
 	specTemplateType := make(map[string]interface{})
 	specTemplateType["code"] = "arm"
 
-	switch d.Get("source_type") {
-	case "local":
-		sourceOptions["content"] = d.Get("spec_content")
-	case "url":
-		sourceOptions["contentPath"] = d.Get("spec_path")
-	case "repository":
-		sourceOptions["contentPath"] = d.Get("spec_path")
-		sourceOptions["contentRef"] = d.Get("version_ref")
-		sourceOptions["repository"] = map[string]interface{}{
-			"id": d.Get("repository_id"),
-		}
+	config, err := specTemplateConfigPayload(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"specTemplate": map[string]interface{}{
-				"name": name,
-				"file": sourceOptions,
-				"type": specTemplateType,
+				"name":   name,
+				"file":   sourceOptions,
+				"type":   specTemplateType,
+				"config": config,
 			},
 		},
 	}
-	resp, err := client.CreateSpecTemplate(req)
+	resp, err := specTemplateApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -122,8 +115,6 @@ func resourceArmSpecTemplateCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceArmSpecTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -134,9 +125,9 @@ func resourceArmSpecTemplateRead(ctx context.Context, d *schema.ResourceData, me
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindSpecTemplateByName(name)
+		resp, err = specTemplateApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetSpecTemplate(toInt64(id), &morpheus.Request{})
+		resp, err = specTemplateApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Spec template cannot be read without name or id")
 	}
@@ -154,6 +145,8 @@ func resourceArmSpecTemplateRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	var armSpecTemplate ArmSpecTemplate
@@ -162,59 +155,42 @@ func resourceArmSpecTemplateRead(ctx context.Context, d *schema.ResourceData, me
 	}
 	d.SetId(intToString(armSpecTemplate.Spectemplate.ID))
 	d.Set("name", armSpecTemplate.Spectemplate.Name)
-	d.Set("source_type", armSpecTemplate.Spectemplate.File.Sourcetype)
+	setSourceFile(d, armSpecTemplate.Spectemplate.File, "spec_content", "spec_path")
 
-	switch armSpecTemplate.Spectemplate.File.Sourcetype {
-	case "local":
-		d.Set("source_type", "local")
-		d.Set("spec_content", armSpecTemplate.Spectemplate.File.Content)
-	case "url":
-		d.Set("source_type", "url")
-		d.Set("spec_path", armSpecTemplate.Spectemplate.File.Contentpath)
-	case "git":
-		d.Set("source_type", "repository")
-		d.Set("spec_path", armSpecTemplate.Spectemplate.File.Contentpath)
-		d.Set("repository_id", armSpecTemplate.Spectemplate.File.Repository.ID)
-		d.Set("version_ref", armSpecTemplate.Spectemplate.File.Contentref)
+	if err := setSpecTemplateConfig(d, resp); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
 }
 
 func resourceArmSpecTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "spec_content", "spec_path")
 
 	specTemplateType := make(map[string]interface{})
 	specTemplateType["code"] = "arm"
 
-	switch d.Get("source_type") {
-	case "local":
-		sourceOptions["content"] = d.Get("spec_content")
-	case "url":
-		sourceOptions["contentPath"] = d.Get("spec_path")
-	case "repository":
-		sourceOptions["contentPath"] = d.Get("spec_path")
-		sourceOptions["contentRef"] = d.Get("version_ref")
-		sourceOptions["repository"] = map[string]interface{}{
-			"id": d.Get("repository_id"),
-		}
+	config, err := specTemplateConfigPayload(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"specTemplate": map[string]interface{}{
-				"name": name,
-				"file": sourceOptions,
-				"type": specTemplateType,
+				"name":   name,
+				"file":   sourceOptions,
+				"type":   specTemplateType,
+				"config": config,
 			},
 		},
 	}
-	resp, err := client.UpdateSpecTemplate(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return specTemplateApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -229,14 +205,14 @@ func resourceArmSpecTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceArmSpecTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteSpecTemplate(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return specTemplateApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -269,20 +245,10 @@ type ArmSpecTemplate struct {
 		Externaltype interface{} `json:"externalType"`
 		Deploymentid interface{} `json:"deploymentId"`
 		Status       interface{} `json:"status"`
-		File         struct {
-			ID          int         `json:"id"`
-			Sourcetype  string      `json:"sourceType"`
-			Contentref  interface{} `json:"contentRef"`
-			Contentpath interface{} `json:"contentPath"`
-			Repository  struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-			} `json:"repository"`
-			Content string `json:"content"`
-		} `json:"file"`
-		Config struct {
+		File         SourceFile  `json:"file"`
+		Config       struct {
 		} `json:"config"`
-		Createdby   string      `json:"createdBy"`
+		Createdby   JSONString  `json:"createdBy"`
 		Updatedby   interface{} `json:"updatedBy"`
 		Datecreated time.Time   `json:"dateCreated"`
 		Lastupdated time.Time   `json:"lastUpdated"`
@@ -12,7 +12,6 @@ import (
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -25,7 +24,7 @@ func resourceAWSCloud() *schema.Resource {
 		UpdateContext: resourceAWSCloudUpdate,
 		DeleteContext: resourceAWSCloudDelete,
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(45 * time.Minute),
+			Create: schema.DefaultTimeout(1 * time.Hour),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(45 * time.Minute),
 			Delete: schema.DefaultTimeout(45 * time.Minute),
@@ -182,6 +181,43 @@ func resourceAWSCloud() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 			},
+			"costing_report_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the AWS cost and usage report used for costing",
+				Optional:    true,
+				Computed:    true,
+			},
+			"costing_bucket": {
+				Type:        schema.TypeString,
+				Description: "The name of the S3 bucket the AWS cost and usage report is delivered to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"costing_bucket_region": {
+				Type:        schema.TypeString,
+				Description: "The AWS region of the S3 bucket the AWS cost and usage report is delivered to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"costing_access_key": {
+				Type:        schema.TypeString,
+				Description: "The AWS access key used to read the AWS cost and usage report, defaults to the cloud credentials",
+				Optional:    true,
+				Computed:    true,
+			},
+			"costing_secret_key": {
+				Type:        schema.TypeString,
+				Description: "The AWS secret key used to read the AWS cost and usage report, defaults to the cloud credentials",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				RequiredWith: []string{"costing_access_key"},
+			},
 			"agent_install_mode": {
 				Type:         schema.TypeString,
 				Description:  "The method used to install the Morpheus agent on virtual machines provisioned in the cloud (ssh, cloudInit)",
@@ -189,15 +225,16 @@ func resourceAWSCloud() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 			},
+			"wait_for_sync": cloudWaitForSyncSchema(),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importCloudState,
 		},
 	}
 }
 
 func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -262,6 +299,11 @@ func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta in
 	config["configManagementId"] = d.Get("config_management_integration_id").(string)
 	cloud["guidanceMode"] = d.Get("guidance").(string)
 	cloud["costingMode"] = d.Get("costing").(string)
+	config["costingReport"] = d.Get("costing_report_name").(string)
+	config["costingBucket"] = d.Get("costing_bucket").(string)
+	config["costingRegion"] = d.Get("costing_bucket_region").(string)
+	config["costingAccessKey"] = d.Get("costing_access_key").(string)
+	config["costingSecretKey"] = d.Get("costing_secret_key").(string)
 	cloud["agentMode"] = d.Get("agent_install_mode").(string)
 
 	cloud["config"] = config
@@ -285,26 +327,7 @@ func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta in
 	result := resp.Result.(*morpheus.CreateCloudResult)
 	cloudOutput := result.Cloud
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"initializing", "syncing"},
-		Target:  []string{"ok"},
-		Refresh: func() (interface{}, string, error) {
-			cloudDetails, err := client.GetCloud(cloudOutput.ID, &morpheus.Request{})
-			if err != nil {
-				return "", "", err
-			}
-			result := cloudDetails.Result.(*morpheus.GetCloudResult)
-			cloudStatus := result.Cloud
-			return result, cloudStatus.Status, nil
-		},
-		Timeout:      1 * time.Hour,
-		MinTimeout:   1 * time.Minute,
-		Delay:        1 * time.Minute,
-		PollInterval: 1 * time.Minute,
-	}
-
-	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	err = waitForCloud(ctx, client, cloudOutput.ID, d.Timeout(schema.TimeoutCreate), d.Get("wait_for_sync").(bool))
 	if err != nil {
 		return diag.Errorf("error creating cloud: %s", err)
 	}
@@ -316,7 +339,7 @@ func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceAWSCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -343,6 +366,7 @@ func resourceAWSCloudRead(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetCloudResult)
@@ -387,6 +411,18 @@ func resourceAWSCloudRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("config_management_integration_id", cloud.Config.ConfigManagementID)
 		d.Set("guidance", cloud.GuidanceMode)
 		d.Set("costing", cloud.CostingMode)
+		d.Set("costing_report_name", cloud.Config.CostingReport)
+		d.Set("costing_bucket_region", cloud.Config.CostingRegion)
+		d.Set("costing_secret_key", cloud.Config.CostingSecretKeyHash)
+		// the costing bucket and access key are not part of the sdk cloud config
+		if data, ok := resp.JsonData.(map[string]interface{}); ok {
+			if zone, ok := data["zone"].(map[string]interface{}); ok {
+				if config, ok := zone["config"].(map[string]interface{}); ok {
+					d.Set("costing_bucket", config["costingBucket"])
+					d.Set("costing_access_key", config["costingAccessKey"])
+				}
+			}
+		}
 		d.Set("agent_install_mode", cloud.AgentMode)
 		d.Set("account_number", cloud.ExternalID)
 		return diags
@@ -394,7 +430,7 @@ func resourceAWSCloudRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceAWSCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	cloud := make(map[string]interface{})
 	if d.HasChange("name") {
@@ -488,6 +524,21 @@ func resourceAWSCloudUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	if d.HasChange("costing") {
 		cloud["costingMode"] = d.Get("costing").(string)
 	}
+	if d.HasChange("costing_report_name") {
+		config["costingReport"] = d.Get("costing_report_name").(string)
+	}
+	if d.HasChange("costing_bucket") {
+		config["costingBucket"] = d.Get("costing_bucket").(string)
+	}
+	if d.HasChange("costing_bucket_region") {
+		config["costingRegion"] = d.Get("costing_bucket_region").(string)
+	}
+	if d.HasChange("costing_access_key") {
+		config["costingAccessKey"] = d.Get("costing_access_key").(string)
+	}
+	if d.HasChange("costing_secret_key") {
+		config["costingSecretKey"] = d.Get("costing_secret_key").(string)
+	}
 	if d.HasChange("agent_install_mode") {
 		cloud["agentMode"] = d.Get("agent_install_mode").(string)
 	}
@@ -502,7 +553,9 @@ func resourceAWSCloudUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	req := &morpheus.Request{Body: payload}
-	resp, err := client.UpdateCloud(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateCloud(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -516,14 +569,16 @@ func resourceAWSCloudUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceAWSCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteCloud(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCloud(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
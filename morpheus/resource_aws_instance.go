@@ -92,6 +92,7 @@ func resourceAwsInstance() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"locked": instanceLockedSchema(),
 			"labels": {
 				Type:        schema.TypeList,
 				Description: "The list of labels to add to the instance",
@@ -128,6 +129,23 @@ func resourceAwsInstance() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"workflow_id"},
 			},
+			"provision_workflow_id": {
+				Description: "The ID of an operational workflow to execute against the instance once it has been provisioned, the apply waits for the workflow to succeed",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"teardown_workflow_id": {
+				Description:   "The ID of an operational workflow to execute against the instance before it is deleted, the destroy waits for the workflow to succeed (`teardown_workflow_name` can be used alternatively, only one is needed)",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"teardown_workflow_name"},
+			},
+			"teardown_workflow_name": {
+				Description:   "The name of an operational workflow to execute against the instance before it is deleted, the destroy waits for the workflow to succeed (`teardown_workflow_id` can be used alternatively, only one is needed)",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"teardown_workflow_id"},
+			},
 			"create_user": {
 				Description: "Whether to create a user account on the instance that is associated with the provisioning user account",
 				Type:        schema.TypeBool,
@@ -248,16 +266,18 @@ func resourceAwsInstance() *schema.Resource {
 							Computed:    true,
 						},
 						"ip_address": {
-							Description: "",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
+							Description:  "The static ip address of the network interface, required by the static ip mode and checked against the cidr of the network",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.Any(validation.IsIPAddress, validation.StringIsEmpty),
 						},
 						"ip_mode": {
-							Description: "",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
+							Description:  "How the ip address of the network interface is assigned (dhcp, pool for the next address of the ip pool of the network, static for the ip_address)",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"dhcp", "pool", "static"}, false),
 						},
 					},
 				},
@@ -294,7 +314,10 @@ func resourceAwsInstance() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"connection_info": instanceConnectionInfoSchema(),
+			"containers":      instanceContainersSchema(),
 		},
+		CustomizeDiff: interfacesCustomizeDiff("interfaces"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -302,7 +325,7 @@ func resourceAwsInstance() *schema.Resource {
 }
 
 func resourceAwsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -452,9 +475,7 @@ func resourceAwsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	// Labels
-	if d.Get("labels") != nil {
-		payload["labels"] = d.Get("labels")
-	}
+	payload["labels"] = managedLabelsPayload(d, meta)
 
 	// Provisioning Workflow ID
 	if d.Get("workflow_id") != nil {
@@ -490,6 +511,7 @@ func resourceAwsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("API RESPONSE: %s", resp)
 	result := resp.Result.(*morpheus.CreateInstanceResult)
 	instance := result.Instance
+	instanceStatus := "provisioning"
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"provisioning", "starting", "stopping", "pending"},
@@ -501,6 +523,7 @@ func resourceAwsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 			}
 			result := instanceDetails.Result.(*morpheus.GetInstanceResult)
 			instance := result.Instance
+			instanceStatus = instance.Status
 			return result, instance.Status, nil
 		},
 		Timeout:      3 * time.Hour,
@@ -517,12 +540,30 @@ func resourceAwsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	// Successfully created resource, now set id
 	d.SetId(int64ToString(instance.ID))
+
+	// Run the provision workflow and wait for it to succeed,
+	// unless the instance did not provision
+	if workflowId, ok := d.GetOk("provision_workflow_id"); ok {
+		if instanceStatus == "failed" || instanceStatus == "denied" || instanceStatus == "cancelled" {
+			resourceAwsInstanceRead(ctx, d, meta)
+			return diag.Errorf("error executing provision workflow: instance is %s", instanceStatus)
+		}
+		path := fmt.Sprintf("%s/%d/workflow", morpheus.InstancesPath, instance.ID)
+		if _, err := executeWorkflow(ctx, client, path, workflowId.(int), nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error executing provision workflow: %s", err)
+		}
+	}
+
+	if err := setInstanceLock(ctx, client, d, true); err != nil {
+		return diag.FromErr(err)
+	}
+
 	resourceAwsInstanceRead(ctx, d, meta)
 	return diags
 }
 
 func resourceAwsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -552,6 +593,7 @@ func resourceAwsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetInstanceResult)
@@ -571,6 +613,7 @@ func resourceAwsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("resource_pool_id", instance.Config["resourcePoolId"])
 	d.Set("environment", instance.Environment)
 	d.Set("labels", instance.Labels)
+	d.Set("locked", instance.Locked)
 	d.Set("evar", instance.EnvironmentVariables)
 	// Tags
 	tags := make(map[string]interface{})
@@ -603,12 +646,17 @@ func resourceAwsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("public_ip_type", instance.Config["publicIpType"])
 	d.Set("instance_profile_id", instance.Config["instanceProfile"])
 	d.Set("kms_key_id", instance.Config["kmsKeyId"])
+	setInstanceConnectionAttributes(client, d, instance)
 	return diags
 }
 
 func resourceAwsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
+
+	if err := setInstanceLock(ctx, client, d, false); err != nil {
+		return diag.FromErr(err)
+	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 
@@ -638,7 +686,7 @@ func resourceAwsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 	instancePayload := map[string]interface{}{
 		"name":            name,
 		"description":     description,
-		"labels":          d.Get("labels"),
+		"labels":          managedLabelsPayload(d, meta),
 		"tags":            tags,
 		"instanceContext": d.Get("environment"),
 		"config":          config,
@@ -647,7 +695,9 @@ func resourceAwsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		"instance": instancePayload,
 	}
 	req := &morpheus.Request{Body: payload}
-	resp, err := client.UpdateInstance(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateInstance(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -657,23 +707,37 @@ func resourceAwsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 	instance := result.Instance
 	// Successfully updated resource, now set id
 	d.SetId(int64ToString(instance.ID))
+	if err := setInstanceLock(ctx, client, d, true); err != nil {
+		return diag.FromErr(err)
+	}
 	return resourceAwsInstanceRead(ctx, d, meta)
 }
 
 func resourceAwsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	if diags := checkInstanceUnlocked(d); diags.HasError() {
+		return diags
+	}
+
 	id := d.Id()
+
+	// Run the teardown workflow and wait for it to succeed before deleting the instance
+	if err := executeTeardownWorkflow(ctx, client, d, toInt64(id)); err != nil {
+		return diag.Errorf("error executing teardown workflow: %s", err)
+	}
 	req := &morpheus.Request{
 		QueryParams: map[string]string{},
 	}
 	if USE_FORCE {
 		req.QueryParams["force"] = "true"
 	}
-	resp, err := client.DeleteInstance(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteInstance(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -11,7 +11,6 @@ import (
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -24,7 +23,7 @@ func resourceAzureCloud() *schema.Resource {
 		UpdateContext: resourceAzureCloudUpdate,
 		DeleteContext: resourceAzureCloudDelete,
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(45 * time.Minute),
+			Create: schema.DefaultTimeout(1 * time.Hour),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(45 * time.Minute),
 			Delete: schema.DefaultTimeout(45 * time.Minute),
@@ -191,15 +190,16 @@ func resourceAzureCloud() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 			},
+			"wait_for_sync": cloudWaitForSyncSchema(),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importCloudState,
 		},
 	}
 }
 
 func resourceAzureCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -289,26 +289,7 @@ func resourceAzureCloudCreate(ctx context.Context, d *schema.ResourceData, meta
 	result := resp.Result.(*morpheus.CreateCloudResult)
 	cloudOutput := result.Cloud
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"initializing", "syncing"},
-		Target:  []string{"ok"},
-		Refresh: func() (interface{}, string, error) {
-			cloudDetails, err := client.GetCloud(cloudOutput.ID, &morpheus.Request{})
-			if err != nil {
-				return "", "", err
-			}
-			result := cloudDetails.Result.(*morpheus.GetCloudResult)
-			cloudStatus := result.Cloud
-			return result, cloudStatus.Status, nil
-		},
-		Timeout:      1 * time.Hour,
-		MinTimeout:   1 * time.Minute,
-		Delay:        1 * time.Minute,
-		PollInterval: 1 * time.Minute,
-	}
-
-	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	err = waitForCloud(ctx, client, cloudOutput.ID, d.Timeout(schema.TimeoutCreate), d.Get("wait_for_sync").(bool))
 	if err != nil {
 		return diag.Errorf("error creating cloud: %s", err)
 	}
@@ -320,7 +301,7 @@ func resourceAzureCloudCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceAzureCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -347,6 +328,7 @@ func resourceAzureCloudRead(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetCloudResult)
@@ -401,7 +383,7 @@ func resourceAzureCloudRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceAzureCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	cloud := make(map[string]interface{})
 	cloud["name"] = d.Get("name").(string)
@@ -480,7 +462,9 @@ func resourceAzureCloudUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	req := &morpheus.Request{Body: payload}
-	resp, err := client.UpdateCloud(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateCloud(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -494,14 +478,16 @@ func resourceAzureCloudUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceAzureCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteCloud(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCloud(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -0,0 +1,275 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBackup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus backup resource, protecting an instance or a server with backups run by a backup job, either an existing job, such as one managed with the morpheus_backup_job resource, or a job created for the backup from its schedule and retention count.",
+		CreateContext: resourceBackupCreate,
+		ReadContext:   resourceBackupRead,
+		UpdateContext: resourceBackupUpdate,
+		DeleteContext: resourceBackupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the backup",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the backup",
+				Required:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the backup is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"instance_id": {
+				Type:         schema.TypeInt,
+				Description:  "The ID of the instance backed up",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "server_id"},
+			},
+			"container_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the container of the instance backed up, the first container of the instance when not set",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"server_id"},
+			},
+			"server_id": {
+				Type:         schema.TypeInt,
+				Description:  "The ID of the server backed up",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "server_id"},
+			},
+			"job_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the existing backup job running the backup, a job is created for the backup from the schedule and retention count when not set",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"schedule_id"},
+			},
+			"schedule_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the backup schedule of the job created for the backup",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"job_id"},
+			},
+			"retention_count": {
+				Type:         schema.TypeInt,
+				Description:  "The number of restore points kept for the backup, the oldest restore points are pruned once the count is reached",
+				ValidateFunc: validation.IntAtLeast(1),
+				Optional:     true,
+				Computed:     true,
+			},
+			"storage_bucket_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the storage bucket the backups are stored in, the default backup storage bucket of the appliance when not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Description: "The code of the backup type, depending on the provisioning type of the instance or server",
+				Computed:    true,
+			},
+			"last_status": {
+				Type:        schema.TypeString,
+				Description: "The status of the last run of the backup",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func backupPayload(d *schema.ResourceData) map[string]interface{} {
+	backup := map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"enabled": d.Get("enabled").(bool),
+	}
+	if d.IsNewResource() {
+		if instanceId := d.Get("instance_id").(int); instanceId != 0 {
+			backup["locationType"] = "instance"
+			backup["instanceId"] = instanceId
+			if containerId := d.Get("container_id").(int); containerId != 0 {
+				backup["containerId"] = containerId
+			}
+		} else {
+			backup["locationType"] = "server"
+			backup["serverId"] = d.Get("server_id").(int)
+		}
+		if jobId := d.Get("job_id").(int); jobId != 0 {
+			backup["jobAction"] = "existing"
+			backup["jobId"] = jobId
+		} else {
+			backup["jobAction"] = "new"
+			backup["jobName"] = d.Get("name").(string)
+		}
+	}
+	if retentionCount := d.Get("retention_count").(int); retentionCount != 0 {
+		backup["retentionCount"] = retentionCount
+	}
+	// job_id is computed once the job of the backup is created, the schedule
+	// applies as long as no existing job is configured
+	if scheduleId := d.Get("schedule_id").(int); scheduleId != 0 && d.GetRawConfig().GetAttr("job_id").IsNull() {
+		backup["scheduleId"] = scheduleId
+	}
+	if storageBucketId := d.Get("storage_bucket_id").(int); storageBucketId != 0 {
+		backup["storageProviderId"] = storageBucketId
+	}
+	return map[string]interface{}{
+		"backup": backup,
+	}
+}
+
+func resourceBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: backupPayload(d),
+	}
+
+	resp, err := client.CreateBackup(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateBackupResult)
+	backup := result.Backup
+	if backup == nil {
+		return diag.Errorf("Backup not found in response data.") // should not happen
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(backup.ID))
+
+	resourceBackupRead(ctx, d, meta)
+	return diags
+}
+
+func resourceBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetBackup(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetBackupResult)
+	backup := result.Backup
+	if backup == nil {
+		return diag.Errorf("Backup not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(backup.ID))
+	d.Set("name", backup.Name)
+	d.Set("enabled", backup.Enabled)
+	if backup.LocationType == "instance" {
+		d.Set("instance_id", backup.Instance.ID)
+		d.Set("container_id", backup.ContainerId)
+	}
+	if backup.LocationType == "server" {
+		// the server of the backup is not decoded by the sdk
+		if data, ok := resp.JsonData.(map[string]interface{}); ok {
+			if backupData, ok := data["backup"].(map[string]interface{}); ok {
+				serverId := jsonRefIdValue(backupData["server"])
+				if serverId == 0 {
+					serverId = jsonInt64Value(backupData["serverId"])
+				}
+				d.Set("server_id", serverId)
+			}
+		}
+	}
+	d.Set("job_id", backup.Job.ID)
+	d.Set("schedule_id", backup.Schedule.ID)
+	d.Set("retention_count", backup.RetentionCount)
+	d.Set("storage_bucket_id", backup.StorageProvider.ID)
+	d.Set("backup_type", backup.BackupType.Code)
+	d.Set("last_status", backup.LastStatus)
+
+	return diags
+}
+
+func resourceBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: backupPayload(d),
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBackup(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceBackupRead(ctx, d, meta)
+}
+
+func resourceBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteBackup(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
@@ -13,7 +13,7 @@ import (
 
 func resourceBackupCreationPolicy() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus backup creation policy resource",
+		Description:   "Provides a Morpheus backup creation policy resource, enforcing or defaulting the creation of a backup of the instances provisioned in its scope. The backups are created with the default backup target and schedule of the appliance, managed with the morpheus_backup_setting resource.",
 		CreateContext: resourceBackupCreationPolicyCreate,
 		ReadContext:   resourceBackupCreationPolicyRead,
 		UpdateContext: resourceBackupCreationPolicyUpdate,
@@ -43,13 +43,14 @@ func resourceBackupCreationPolicy() *schema.Resource {
 				Default:     true,
 			},
 			"enforcement_type": {
-				Type:        schema.TypeString,
-				Description: "The policy enforcement type (fixed or user)",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "The policy enforcement type, fixed or user configurable on the instance provisioning wizard (fixed, user)",
+				ValidateFunc: validation.StringInSlice([]string{"fixed", "user"}, false),
+				Required:     true,
 			},
 			"create_backup": {
 				Type:        schema.TypeBool,
-				Description: "Whether to create a backup",
+				Description: "Whether to create a backup of the provisioned instances, the default value of the provisioning wizard when the enforcement type is user",
 				Required:    true,
 			},
 			"scope": {
@@ -108,7 +109,7 @@ func resourceBackupCreationPolicy() *schema.Resource {
 }
 
 func resourceBackupCreationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -185,7 +186,7 @@ func resourceBackupCreationPolicyCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceBackupCreationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -215,6 +216,7 @@ func resourceBackupCreationPolicyRead(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -225,7 +227,7 @@ func resourceBackupCreationPolicyRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("description", backupCreationPolicy.Description)
 	d.Set("enabled", backupCreationPolicy.Enabled)
 	d.Set("enforcement_type", backupCreationPolicy.Config.CreateBackupType)
-	d.Set("create_backup", backupCreationPolicy.Config.CreateBackup)
+	d.Set("create_backup", jsonBoolValue(backupCreationPolicy.Config.CreateBackup))
 	switch backupCreationPolicy.RefType {
 	case "ComputeSite":
 		d.Set("scope", "group")
@@ -257,7 +259,7 @@ func resourceBackupCreationPolicyRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceBackupCreationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -315,7 +317,9 @@ func resourceBackupCreationPolicyUpdate(ctx context.Context, d *schema.ResourceD
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -331,14 +335,16 @@ func resourceBackupCreationPolicyUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceBackupCreationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
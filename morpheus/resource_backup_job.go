@@ -0,0 +1,202 @@
+package morpheus
+
+import (
+	"context"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBackupJob() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus backup job resource, the job controls when the backups attached to it run and how many of their restore points are kept before the oldest are pruned.",
+		CreateContext: resourceBackupJobCreate,
+		ReadContext:   resourceBackupJobRead,
+		UpdateContext: resourceBackupJobUpdate,
+		DeleteContext: resourceBackupJobDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the backup job",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the backup job",
+				Required:    true,
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Description: "The code of the backup job",
+				Optional:    true,
+				Computed:    true,
+			},
+			"retention_count": {
+				Type:         schema.TypeInt,
+				Description:  "The number of restore points kept for each backup of the job, the oldest restore points are pruned once the count is reached",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"schedule_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the backup schedule the job runs on",
+				Optional:    true,
+			},
+			"next_fire": {
+				Type:        schema.TypeString,
+				Description: "The date and time the job runs next",
+				Computed:    true,
+			},
+			"backup_ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the backups attached to the job",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func backupJobPayload(d *schema.ResourceData) map[string]interface{} {
+	job := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	if code := d.Get("code").(string); code != "" {
+		job["code"] = code
+	}
+	if retentionCount := d.Get("retention_count").(int); retentionCount != 0 {
+		job["retentionCount"] = retentionCount
+	}
+	if scheduleId := d.Get("schedule_id").(int); scheduleId != 0 {
+		job["scheduleId"] = scheduleId
+	} else if !d.IsNewResource() {
+		job["scheduleId"] = nil
+	}
+	return map[string]interface{}{
+		"job": job,
+	}
+}
+
+func resourceBackupJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: backupJobPayload(d),
+	}
+
+	resp, err := client.CreateBackupJob(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateBackupJobResult)
+	backupJob := result.BackupJob
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(backupJob.ID))
+
+	resourceBackupJobRead(ctx, d, meta)
+	return diags
+}
+
+func resourceBackupJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetBackupJob(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetBackupJobResult)
+	backupJob := result.BackupJob
+	if backupJob == nil {
+		return diag.Errorf("Backup job not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(backupJob.ID))
+	d.Set("name", backupJob.Name)
+	d.Set("code", backupJob.Code)
+	d.Set("retention_count", backupJob.RetentionCount)
+	d.Set("schedule_id", backupJob.Schedule.ID)
+	d.Set("next_fire", backupJob.NextFire)
+	var backupIds []int64
+	for _, backup := range backupJob.Backups {
+		backupIds = append(backupIds, backup.ID)
+	}
+	d.Set("backup_ids", backupIds)
+
+	return diags
+}
+
+func resourceBackupJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: backupJobPayload(d),
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBackupJob(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceBackupJobRead(ctx, d, meta)
+}
+
+func resourceBackupJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteBackupJob(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
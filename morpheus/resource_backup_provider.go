@@ -0,0 +1,220 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBackupProvider() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus backup provider resource, integrating an external backup solution such as Veeam, Rubrik or Commvault the backups of the instances can be run by",
+		CreateContext: resourceBackupProviderCreate,
+		ReadContext:   resourceBackupProviderRead,
+		UpdateContext: resourceBackupProviderUpdate,
+		DeleteContext: resourceBackupProviderDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the backup provider",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the backup provider",
+				Required:    true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "The type code of the backup provider (veeam, rubrik, commvault, etc.)",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the backup provider is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Description:  "The url of the API of the backup solution",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Required:     true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the account used to connect to the backup solution",
+				Required:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "The password of the account used to connect to the backup solution, only sent to the appliance as it is never returned",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "The settings of the backup provider specific to its type, such as the default options of the backup jobs, passed through as is",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the backup provider",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func backupProviderPayload(d *schema.ResourceData) map[string]interface{} {
+	backupService := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"type":            d.Get("type").(string),
+		"enabled":         d.Get("enabled").(bool),
+		"serviceUrl":      d.Get("url").(string),
+		"serviceUsername": d.Get("username").(string),
+		"servicePassword": d.Get("password").(string),
+		"config":          d.Get("config").(map[string]interface{}),
+	}
+	return map[string]interface{}{
+		"backupService": backupService,
+	}
+}
+
+func resourceBackupProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   backupProvidersPath,
+		Body:   backupProviderPayload(d),
+		Result: &GetBackupProviderResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	backupProvider := resp.Result.(*GetBackupProviderResult).BackupProvider
+	if backupProvider == nil {
+		return diag.Errorf("Backup provider not found in response data.") // should not happen
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(backupProvider.ID))
+
+	resourceBackupProviderRead(ctx, d, meta)
+	return diags
+}
+
+func resourceBackupProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%s", backupProvidersPath, d.Id()),
+		Result: &GetBackupProviderResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	backupProvider := resp.Result.(*GetBackupProviderResult).BackupProvider
+	if backupProvider == nil {
+		return diag.Errorf("Backup provider not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(backupProvider.ID))
+	d.Set("name", backupProvider.Name)
+	d.Set("type", backupProvider.Type.Code)
+	d.Set("enabled", backupProvider.Enabled)
+	d.Set("url", backupProvider.ServiceUrl)
+	d.Set("username", backupProvider.ServiceUsername)
+	d.Set("status", backupProvider.Status)
+
+	// only the settings of the configuration are read back, the appliance
+	// adding its own settings to the config of the backup provider
+	config := make(map[string]interface{})
+	for key := range d.Get("config").(map[string]interface{}) {
+		if value, ok := backupProvider.Config[key]; ok {
+			config[key] = jsonStringValue(value)
+		}
+	}
+	d.Set("config", config)
+
+	return diags
+}
+
+func resourceBackupProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method: "PUT",
+			Path:   fmt.Sprintf("%s/%s", backupProvidersPath, d.Id()),
+			Body:   backupProviderPayload(d),
+			Result: &GetBackupProviderResult{},
+		})
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceBackupProviderRead(ctx, d, meta)
+}
+
+func resourceBackupProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method: "DELETE",
+			Path:   fmt.Sprintf("%s/%s", backupProvidersPath, d.Id()),
+		})
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
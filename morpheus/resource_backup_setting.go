@@ -68,7 +68,7 @@ func resourceBackupSetting() *schema.Resource {
 }
 
 func resourceBackupSettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -98,7 +98,9 @@ func resourceBackupSettingCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	resp, err := client.UpdateBackupSettings(req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBackupSettings(req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -115,7 +117,7 @@ func resourceBackupSettingCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceBackupSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -137,6 +139,7 @@ func resourceBackupSettingRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetBackupSettingsResult)
@@ -153,7 +156,7 @@ func resourceBackupSettingRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceBackupSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -182,7 +185,9 @@ func resourceBackupSettingUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	log.Printf("API Update: %s", req)
 
-	resp, err := client.UpdateBackupSettings(req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBackupSettings(req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
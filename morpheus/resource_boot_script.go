@@ -48,7 +48,7 @@ func resourceBootScript() *schema.Resource {
 }
 
 func resourceBootScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -79,7 +79,7 @@ func resourceBootScriptCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceBootScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -111,6 +111,7 @@ func resourceBootScriptRead(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetBootScriptResult)
@@ -122,7 +123,7 @@ func resourceBootScriptRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceBootScriptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	req := &morpheus.Request{
@@ -134,7 +135,9 @@ func resourceBootScriptUpdate(ctx context.Context, d *schema.ResourceData, meta
 		},
 	}
 
-	resp, err := client.UpdateBootScript(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBootScript(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -149,14 +152,16 @@ func resourceBootScriptUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceBootScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteBootScript(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteBootScript(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
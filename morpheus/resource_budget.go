@@ -0,0 +1,338 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var budgetYearRegexp = regexp.MustCompile(`^[0-9]{4}$`)
+
+func resourceBudget() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus budget resource, setting the costs planned for a tenant, group, cloud or user over a year along with the warning and over budget thresholds the actual costs are alerted on",
+		CreateContext: resourceBudgetCreate,
+		ReadContext:   resourceBudgetRead,
+		UpdateContext: resourceBudgetUpdate,
+		DeleteContext: resourceBudgetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the budget",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the budget",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the budget",
+				Optional:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the budget is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Description:  "The scope whose costs are budgeted (tenant, group, cloud, user)",
+				ValidateFunc: validation.StringInSlice([]string{"tenant", "group", "cloud", "user"}, false),
+				Required:     true,
+				ForceNew:     true,
+			},
+			"tenant_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the tenant associated with the tenant scope, the tenant of the provider when not set",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"group_id", "cloud_id", "user_id"},
+			},
+			"group_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the group associated with the group scope",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"tenant_id", "cloud_id", "user_id"},
+			},
+			"cloud_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the cloud associated with the cloud scope",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"tenant_id", "group_id", "user_id"},
+			},
+			"user_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the user associated with the user scope",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"tenant_id", "group_id", "cloud_id"},
+			},
+			"year": {
+				Type:         schema.TypeString,
+				Description:  "The year of the budget (2026, etc.)",
+				ValidateFunc: validation.StringMatch(budgetYearRegexp, "must be a year"),
+				Required:     true,
+			},
+			"interval": {
+				Type:         schema.TypeString,
+				Description:  "The interval the costs of the year are budgeted by (year, quarter, month)",
+				ValidateFunc: validation.StringInSlice([]string{"year", "quarter", "month"}, false),
+				Required:     true,
+			},
+			"costs": {
+				Type:        schema.TypeList,
+				Description: "The budgeted costs of each interval of the year, 1 cost for a year interval, 4 for a quarter interval and 12 for a month interval",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeFloat,
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+			},
+			"warning_limit": {
+				Type:         schema.TypeFloat,
+				Description:  "The warning threshold of the budget, the actual costs exceeding it raising a warning alert",
+				ValidateFunc: validation.FloatAtLeast(0),
+				Optional:     true,
+			},
+			"over_limit": {
+				Type:         schema.TypeFloat,
+				Description:  "The over budget threshold of the budget, the actual costs exceeding it raising an over budget alert",
+				ValidateFunc: validation.FloatAtLeast(0),
+				Optional:     true,
+			},
+			"currency": {
+				Type:        schema.TypeString,
+				Description: "The currency of the costs of the budget",
+				Computed:    true,
+			},
+			"total_cost": {
+				Type:        schema.TypeFloat,
+				Description: "The total budgeted cost of the year",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceBudgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	budget, err := budgetPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"budget": budget,
+		},
+	}
+	resp, err := client.CreateBudget(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateBudgetResult)
+	budgetResult := result.Budget
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(budgetResult.ID))
+
+	resourceBudgetRead(ctx, d, meta)
+	return diags
+}
+
+func resourceBudgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindBudgetByName(name)
+	} else if id != "" {
+		resp, err = client.GetBudget(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Budget cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetBudgetResult)
+	budget := result.Budget
+	if budget == nil {
+		return diag.Errorf("Budget not found in response data.") // should not happen
+	}
+
+	d.SetId(int64ToString(budget.ID))
+	d.Set("name", budget.Name)
+	d.Set("description", budget.Description)
+	d.Set("enabled", budget.Enabled)
+	switch budget.RefScope {
+	case "group":
+		d.Set("scope", "group")
+		d.Set("group_id", jsonInt64Value(budget.RefId))
+	case "cloud":
+		d.Set("scope", "cloud")
+		d.Set("cloud_id", jsonInt64Value(budget.RefId))
+	case "user":
+		d.Set("scope", "user")
+		d.Set("user_id", jsonInt64Value(budget.RefId))
+	default:
+		d.Set("scope", "tenant")
+		if refId := jsonInt64Value(budget.RefId); refId != 0 {
+			d.Set("tenant_id", refId)
+		} else {
+			d.Set("tenant_id", budget.Account.ID)
+		}
+	}
+	d.Set("year", budget.Year)
+	d.Set("interval", budget.Interval)
+	d.Set("costs", budget.Costs)
+	d.Set("warning_limit", jsonFloat64Value(budget.WarningLimit))
+	d.Set("over_limit", jsonFloat64Value(budget.OverLimit))
+	d.Set("currency", budget.Currency)
+	d.Set("total_cost", budget.TotalCost)
+
+	return diags
+}
+
+func resourceBudgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	budget, err := budgetPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"budget": budget,
+		},
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBudget(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateBudgetResult)
+	budgetResult := result.Budget
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(budgetResult.ID))
+	return resourceBudgetRead(ctx, d, meta)
+}
+
+func resourceBudgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteBudget(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// budgetPayload builds the budget of the payload, checking the number of
+// costs matches the number of intervals of the year
+func budgetPayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	intervals := map[string]int{"year": 1, "quarter": 4, "month": 12}
+	interval := d.Get("interval").(string)
+	costs := d.Get("costs").([]interface{})
+	if len(costs) != intervals[interval] {
+		return nil, fmt.Errorf("a budget with a %s interval requires %d costs, got %d", interval, intervals[interval], len(costs))
+	}
+
+	budget := make(map[string]interface{})
+
+	budget["name"] = d.Get("name").(string)
+	budget["description"] = d.Get("description").(string)
+	budget["enabled"] = d.Get("enabled").(bool)
+	budget["period"] = "year"
+	budget["year"] = d.Get("year").(string)
+	budget["interval"] = interval
+	budget["costs"] = costs
+	if v, ok := d.GetOk("warning_limit"); ok {
+		budget["warningLimit"] = v.(float64)
+	}
+	if v, ok := d.GetOk("over_limit"); ok {
+		budget["overLimit"] = v.(float64)
+	}
+
+	switch d.Get("scope") {
+	case "tenant":
+		budget["scope"] = "account"
+		if v, ok := d.GetOk("tenant_id"); ok {
+			budget["scopeTenantId"] = v.(int)
+		}
+	case "group":
+		budget["scope"] = "group"
+		budget["scopeGroupId"] = d.Get("group_id").(int)
+	case "cloud":
+		budget["scope"] = "cloud"
+		budget["scopeCloudId"] = d.Get("cloud_id").(int)
+	case "user":
+		budget["scope"] = "user"
+		budget["scopeUserId"] = d.Get("user_id").(int)
+	}
+	return budget, nil
+}
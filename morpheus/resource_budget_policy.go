@@ -2,8 +2,11 @@ package morpheus
 
 import (
 	"context"
-
+	"fmt"
 	"log"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,7 +16,7 @@ import (
 
 func resourceBudgetPolicy() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus budget policy resource",
+		Description:   "Provides a Morpheus budget policy resource, gating the provisioning of the instances in its scope on their price. The provisioning of an instance whose price, computed from its plan and the price sets of the cloud, exceeds the max price is refused.",
 		CreateContext: resourceBudgetPolicyCreate,
 		ReadContext:   resourceBudgetPolicyRead,
 		UpdateContext: resourceBudgetPolicyUpdate,
@@ -42,19 +45,23 @@ func resourceBudgetPolicy() *schema.Resource {
 				Default:     true,
 			},
 			"max_price": {
-				Type:        schema.TypeString,
-				Description: "The max budget price",
-				Required:    true,
+				Type:             schema.TypeString,
+				Description:      "The max price of an instance, a positive decimal number",
+				Required:         true,
+				ValidateFunc:     validateBudgetPolicyMaxPrice,
+				DiffSuppressFunc: suppressEquivalentNumberDiffs,
 			},
 			"currency": {
-				Type:        schema.TypeString,
-				Description: "The budget currency",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "The currency of the max price, as an ISO 4217 code (USD, EUR, etc.)",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Z]{3}$`), "must be an upper case ISO 4217 currency code"),
 			},
 			"unit_of_time": {
-				Type:        schema.TypeString,
-				Description: "The unit of time to measure the budget (hour or month)",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "The unit of time the max price is expressed in (hour, month)",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"hour", "month"}, false),
 			},
 			"scope": {
 				Type:         schema.TypeString,
@@ -112,7 +119,7 @@ func resourceBudgetPolicy() *schema.Resource {
 }
 
 func resourceBudgetPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -184,7 +191,7 @@ func resourceBudgetPolicyCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceBudgetPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -214,6 +221,7 @@ func resourceBudgetPolicyRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -257,7 +265,7 @@ func resourceBudgetPolicyRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceBudgetPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -311,7 +319,9 @@ func resourceBudgetPolicyUpdate(ctx context.Context, d *schema.ResourceData, met
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -327,14 +337,16 @@ func resourceBudgetPolicyUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceBudgetPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -348,3 +360,13 @@ func resourceBudgetPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 	d.SetId("")
 	return diags
 }
+
+// validateBudgetPolicyMaxPrice checks the max price is a positive number, the
+// appliance storing it as a string
+func validateBudgetPolicyMaxPrice(v interface{}, k string) (ws []string, errors []error) {
+	price, err := strconv.ParseFloat(strings.TrimSpace(v.(string)), 64)
+	if err != nil || price <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive number, got: %s", k, v.(string)))
+	}
+	return
+}
@@ -0,0 +1,292 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCatalogOrder() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus catalog order resource that orders a catalog item and deprovisions the resulting inventory item on destroy.",
+		CreateContext: resourceCatalogOrderCreate,
+		ReadContext:   resourceCatalogOrderRead,
+		DeleteContext: resourceCatalogOrderDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the catalog inventory item created by the order",
+				Computed:    true,
+			},
+			"catalog_item_type_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the catalog item type to order",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "The option values used to order the catalog item, keyed by the field name of each option type",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait for the ordered catalog item to finish provisioning",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the catalog inventory item",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the catalog inventory item",
+				Computed:    true,
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Description: "The status message of the catalog inventory item",
+				Computed:    true,
+			},
+			"ref_type": {
+				Type:        schema.TypeString,
+				Description: "The type of object provisioned by the order (instance, app, execution)",
+				Computed:    true,
+			},
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the instance provisioned by the order",
+				Computed:    true,
+			},
+			"app_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the app provisioned by the order",
+				Computed:    true,
+			},
+			"execution_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the workflow execution started by the order",
+				Computed:    true,
+			},
+			"order_date": {
+				Type:        schema.TypeString,
+				Description: "The date and time the catalog item was ordered",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceCatalogOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	config := make(map[string]interface{})
+	if d.Get("config") != nil {
+		configInput := d.Get("config").(map[string]interface{})
+		for key, value := range configInput {
+			config[key] = value.(string)
+		}
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"order": map[string]interface{}{
+				"items": []map[string]interface{}{
+					{
+						"type": map[string]interface{}{
+							"id": d.Get("catalog_item_type_id").(int),
+						},
+						"config": config,
+					},
+				},
+			},
+		},
+	}
+
+	resp, err := client.PlaceCatalogOrder(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.PlaceCatalogOrderResult)
+	if !result.Success {
+		return diag.Errorf("error ordering catalog item: %s", result.Msg)
+	}
+	if len(result.Order.Items) == 0 {
+		return diag.Errorf("catalog order did not return an inventory item")
+	}
+	item := result.Order.Items[0]
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(item.ID))
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &retry.StateChangeConf{
+			Pending: []string{"ORDERED", "IN_PROGRESS", "PROVISIONING", "PENDING"},
+			Target:  []string{"COMPLETE", "COMPLETED", "RUNNING"},
+			Refresh: func() (interface{}, string, error) {
+				resp, err := getCatalogInventoryItem(client, item.ID)
+				if err != nil {
+					return "", "", err
+				}
+				inventoryItem := resp.Result.(*morpheus.GetCatalogInventoryItemResult).CatalogInventoryItem
+				status := strings.ToUpper(inventoryItem.Status)
+				if status == "FAILED" {
+					return inventoryItem, status, fmt.Errorf("catalog item provisioning failed: %s", inventoryItem.StatusMessage)
+				}
+				return inventoryItem, status, nil
+			},
+			Timeout:      d.Timeout(schema.TimeoutCreate),
+			MinTimeout:   10 * time.Second,
+			Delay:        10 * time.Second,
+			PollInterval: 30 * time.Second,
+		}
+
+		// Wait, catching any errors
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			resourceCatalogOrderRead(ctx, d, meta)
+			return diag.Errorf("error ordering catalog item: %s", err)
+		}
+	}
+
+	resourceCatalogOrderRead(ctx, d, meta)
+	return diags
+}
+
+func resourceCatalogOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := getCatalogInventoryItem(client, toInt64(id))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetCatalogInventoryItemResult)
+	item := result.CatalogInventoryItem
+	if item != nil {
+		d.SetId(int64ToString(item.ID))
+		d.Set("name", item.Name)
+		d.Set("status", item.Status)
+		d.Set("status_message", item.StatusMessage)
+		d.Set("ref_type", item.RefType)
+		d.Set("instance_id", item.Instance.ID)
+		d.Set("app_id", item.App.ID)
+		d.Set("execution_id", item.Execution.ID)
+		d.Set("order_date", item.OrderDate)
+	} else {
+		return diag.Errorf("read operation: catalog inventory item not found in response data") // should not happen
+	}
+
+	return diags
+}
+
+func resourceCatalogOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	queryParams := map[string]string{}
+	if USE_FORCE {
+		queryParams["force"] = "true"
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method:      "DELETE",
+			Path:        fmt.Sprintf("/api/catalog/items/%d", toInt64(id)),
+			QueryParams: queryParams,
+			Result:      &morpheus.DeleteCatalogInventoryItemResult{},
+		})
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// Deprovisioning the inventory item is asynchronous, wait until
+	// the inventory item is no longer available
+	stateConf := retry.StateChangeConf{
+		Delay:        10 * time.Second,
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		PollInterval: 10 * time.Second,
+		MinTimeout:   10 * time.Second,
+		Pending:      []string{"200"},
+		Target:       []string{"404"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err = getCatalogInventoryItem(client, toInt64(id))
+			if err != nil {
+				if resp != nil {
+					return resp, strconv.Itoa(resp.StatusCode), nil
+				}
+				return "", "", err
+			}
+
+			return resp, strconv.Itoa(resp.StatusCode), nil
+		},
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diags
+}
+
+// getCatalogInventoryItem fetches an inventory item, the sdk method
+// targets the catalog types endpoint instead of the inventory items one
+func getCatalogInventoryItem(client *apiClient, id int64) (*morpheus.Response, error) {
+	return client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/catalog/items/%d", id),
+		Result: &morpheus.GetCatalogInventoryItemResult{},
+	})
+}
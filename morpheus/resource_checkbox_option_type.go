@@ -100,16 +100,17 @@ func resourceCheckboxOptionType() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"force_delete": forceDeleteSchema(),
+			"tenant_id":    tenantIdSchema("The id of the tenant the checkbox option type is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceCheckboxOptionTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -121,6 +122,7 @@ func resourceCheckboxOptionTypeCreate(ctx context.Context, d *schema.ResourceDat
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	var defaultCheck string
 	if d.Get("default_checked").(bool) {
 		defaultCheck = "on"
@@ -147,7 +149,7 @@ func resourceCheckboxOptionTypeCreate(ctx context.Context, d *schema.ResourceDat
 			},
 		},
 	}
-	resp, err := client.CreateOptionType(req)
+	resp, err := optionTypeApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -164,8 +166,6 @@ func resourceCheckboxOptionTypeCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCheckboxOptionTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -176,9 +176,9 @@ func resourceCheckboxOptionTypeRead(ctx context.Context, d *schema.ResourceData,
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindOptionTypeByName(name)
+		resp, err = optionTypeApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetOptionType(toInt64(id), &morpheus.Request{})
+		resp, err = optionTypeApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("OptionType cannot be read without name or id")
 	}
@@ -196,6 +196,8 @@ func resourceCheckboxOptionTypeRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetOptionTypeResult)
@@ -227,7 +229,6 @@ func resourceCheckboxOptionTypeRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCheckboxOptionTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -237,6 +238,7 @@ func resourceCheckboxOptionTypeUpdate(ctx context.Context, d *schema.ResourceDat
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	var defaultCheck string
 	if d.Get("default_checked").(bool) {
 		defaultCheck = "on"
@@ -263,7 +265,9 @@ func resourceCheckboxOptionTypeUpdate(ctx context.Context, d *schema.ResourceDat
 			},
 		},
 	}
-	resp, err := client.UpdateOptionType(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return optionTypeApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -278,14 +282,19 @@ func resourceCheckboxOptionTypeUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCheckboxOptionTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteOptionType(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "option type", func() ([]string, error) {
+		return optionTypeDependents(meta, d, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return optionTypeApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -84,12 +84,11 @@ func resourceChefBootstrapTask() *schema.Resource {
 				Description: "The chef node name",
 				Optional:    true,
 			},
-			"node_attributes": {
-				Type:             schema.TypeString,
-				Description:      "The chef node attributes (JSON)",
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentJsonDiffs,
-			},
+			"node_attributes": jsonStringSchema(&schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The chef node attributes (JSON)",
+				Optional:    true,
+			}),
 			"retryable": {
 				Type:        schema.TypeBool,
 				Description: "Whether to retry the task if there is a failure",
@@ -121,16 +120,16 @@ func resourceChefBootstrapTask() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
 				Default:      "private",
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the chef bootstrap task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceChefBootstrapTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -154,6 +153,7 @@ func resourceChefBootstrapTaskCreate(ctx context.Context, d *schema.ResourceData
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -172,7 +172,7 @@ func resourceChefBootstrapTaskCreate(ctx context.Context, d *schema.ResourceData
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -189,8 +189,6 @@ func resourceChefBootstrapTaskCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceChefBootstrapTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -201,9 +199,9 @@ func resourceChefBootstrapTaskRead(ctx context.Context, d *schema.ResourceData,
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -221,6 +219,8 @@ func resourceChefBootstrapTaskRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -246,7 +246,6 @@ func resourceChefBootstrapTaskRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceChefBootstrapTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -270,6 +269,7 @@ func resourceChefBootstrapTaskUpdate(ctx context.Context, d *schema.ResourceData
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -288,7 +288,9 @@ func resourceChefBootstrapTaskUpdate(ctx context.Context, d *schema.ResourceData
 			},
 		},
 	}
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -303,14 +305,14 @@ func resourceChefBootstrapTaskUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceChefBootstrapTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
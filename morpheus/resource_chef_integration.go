@@ -116,6 +116,7 @@ func resourceChefIntegration() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			*/
+			"force_delete": forceDeleteSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -124,7 +125,7 @@ func resourceChefIntegration() *schema.Resource {
 }
 
 func resourceChefIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -196,7 +197,7 @@ func resourceChefIntegrationCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceChefIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -226,6 +227,7 @@ func resourceChefIntegrationRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetIntegrationResult)
@@ -266,7 +268,7 @@ func resourceChefIntegrationRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceChefIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	integration := make(map[string]interface{})
@@ -312,7 +314,9 @@ func resourceChefIntegrationUpdate(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	resp, err := client.UpdateIntegration(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -328,14 +332,21 @@ func resourceChefIntegrationUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceChefIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteIntegration(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "integration", func() ([]string, error) {
+		return integrationDependents(meta, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
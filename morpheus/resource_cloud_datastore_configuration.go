@@ -0,0 +1,330 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudDatastoreConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus cloud datastore configuration resource for managing the settings of a datastore synchronized from any type of cloud. Destroying the resource only removes it from the state, the datastore keeps its settings.",
+		CreateContext: resourceCloudDatastoreConfigurationCreate,
+		ReadContext:   resourceCloudDatastoreConfigurationRead,
+		UpdateContext: resourceCloudDatastoreConfigurationUpdate,
+		DeleteContext: resourceCloudDatastoreConfigurationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The id of the cloud datastore",
+				Computed:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the cloud the datastore belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"datastore_id": {
+				Type:         schema.TypeInt,
+				Description:  "The id of the cloud datastore",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"datastore_id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "The name of the cloud datastore",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"datastore_id", "name"},
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the cloud datastore is active",
+				Optional:    true,
+				Computed:    true,
+			},
+			"visibility": {
+				Description:  "Determines whether the cloud datastore is visible in sub-tenants or not",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Computed:     true,
+			},
+			"group_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all groups access to the datastore",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids to grant access to the datastore",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"group_default_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids the datastore is the default datastore of, the groups are granted access to the datastore",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_access": {
+				Type:        schema.TypeList,
+				Description: "The tenant datastore access",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The id of the tenant",
+							Required:    true,
+						},
+						"default_store": {
+							Type:        schema.TypeBool,
+							Description: "Whether to mark the cloud datastore as a default store for this tenant",
+							Optional:    true,
+						},
+						"image_target": {
+							Type:        schema.TypeBool,
+							Description: "Whether to mark the cloud datastore as an image target for this tenant",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of the cloud datastore",
+				Computed:    true,
+			},
+			"online": {
+				Type:        schema.TypeBool,
+				Description: "Whether the cloud datastore is online",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudDatastoreConfigurationImport,
+		},
+	}
+}
+
+func resourceCloudDatastoreConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	cloudId := int64(d.Get("cloud_id").(int))
+	datastoreId := int64(d.Get("datastore_id").(int))
+	if datastoreId == 0 {
+		// Find by name, then update by ID
+		name := d.Get("name").(string)
+		resp, err := client.ListCloudDatastores(cloudId, &morpheus.Request{
+			QueryParams: map[string]string{
+				"name": name,
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		datastoreResult := resp.Result.(*morpheus.ListCloudDatastoresResult)
+		if datastoreResult.Datastores == nil || len(*datastoreResult.Datastores) == 0 {
+			return diag.Errorf("Unable to find a datastore named %s", name)
+		}
+		datastoreId = (*datastoreResult.Datastores)[0].ID
+	}
+
+	if err := updateCloudDatastoreConfiguration(ctx, client, d, cloudId, datastoreId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(datastoreId))
+	return resourceCloudDatastoreConfigurationRead(ctx, d, meta)
+}
+
+func resourceCloudDatastoreConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	cloudId := int64(d.Get("cloud_id").(int))
+
+	resp, err := client.GetCloudDatastore(cloudId, toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetCloudDatastoreResult)
+	datastore := result.Datastore
+	if datastore == nil {
+		return diag.Errorf("read operation: cloud datastore not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(datastore.ID))
+	d.Set("datastore_id", datastore.ID)
+	d.Set("name", datastore.Name)
+	d.Set("active", datastore.Active)
+	d.Set("visibility", datastore.Visibility)
+	d.Set("type", datastore.Type)
+	d.Set("online", datastore.Online)
+	d.Set("group_access_all", datastore.ResourcePermission.All)
+	var groupIds []int
+	var groupDefaultIds []int
+	for _, site := range datastore.ResourcePermission.Sites {
+		if site.Default {
+			groupDefaultIds = append(groupDefaultIds, site.ID)
+		} else {
+			groupIds = append(groupIds, site.ID)
+		}
+	}
+	d.Set("group_access_ids", groupIds)
+	d.Set("group_default_ids", groupDefaultIds)
+	var tenantConfigs []map[string]interface{}
+	for _, tenant := range datastore.Tenants {
+		tenantConfigs = append(tenantConfigs, map[string]interface{}{
+			"id":            tenant.ID,
+			"default_store": tenant.DefaultStore,
+			"image_target":  tenant.DefaultTarget,
+		})
+	}
+	d.Set("tenant_access", tenantConfigs)
+
+	return diags
+}
+
+func resourceCloudDatastoreConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	cloudId := int64(d.Get("cloud_id").(int))
+	if err := updateCloudDatastoreConfiguration(ctx, client, d, cloudId, toInt64(d.Id())); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCloudDatastoreConfigurationRead(ctx, d, meta)
+}
+
+func resourceCloudDatastoreConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The datastore belongs to the cloud and is removed by the cloud sync
+	d.SetId("")
+	return diags
+}
+
+// updateCloudDatastoreConfiguration saves the configured settings of a cloud datastore
+func updateCloudDatastoreConfiguration(ctx context.Context, client *apiClient, d *schema.ResourceData, cloudId int64, datastoreId int64) error {
+	datastore := make(map[string]interface{})
+	// only send the active flag when configured, the datastore keeps its synced state otherwise
+	if !d.GetRawConfig().GetAttr("active").IsNull() {
+		datastore["active"] = d.Get("active").(bool)
+	}
+	if visibility, ok := d.GetOk("visibility"); ok {
+		datastore["visibility"] = visibility.(string)
+	}
+
+	body := map[string]interface{}{
+		"datastore": datastore,
+	}
+
+	// the group and tenant permissions are left untouched when not configured
+	config := d.GetRawConfig()
+	if !config.GetAttr("group_access_all").IsNull() || !config.GetAttr("group_access_ids").IsNull() || !config.GetAttr("group_default_ids").IsNull() {
+		resourcePermissions := make(map[string]interface{})
+		resourcePermissions["all"] = d.Get("group_access_all").(bool)
+		sites := make([]map[string]interface{}, 0)
+		for _, groupId := range d.Get("group_access_ids").(*schema.Set).List() {
+			sites = append(sites, map[string]interface{}{
+				"id": groupId.(int),
+			})
+		}
+		for _, groupId := range d.Get("group_default_ids").(*schema.Set).List() {
+			sites = append(sites, map[string]interface{}{
+				"id":      groupId.(int),
+				"default": true,
+			})
+		}
+		resourcePermissions["sites"] = sites
+		datastore["resourcePermissions"] = resourcePermissions
+	}
+
+	if !config.GetAttr("tenant_access").IsNull() {
+		var tenantPerm TenantPermission
+		for _, item := range d.Get("tenant_access").([]interface{}) {
+			tenant := item.(map[string]interface{})
+			tenantId := tenant["id"].(int)
+			tenantPerm.Accounts = append(tenantPerm.Accounts, tenantId)
+			if tenant["default_store"].(bool) {
+				tenantPerm.Defaultstore = append(tenantPerm.Defaultstore, tenantId)
+			}
+			if tenant["image_target"].(bool) {
+				tenantPerm.Defaulttarget = append(tenantPerm.Defaulttarget, tenantId)
+			}
+		}
+		body["tenantPermissions"] = tenantPerm
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateCloudDatastore(cloudId, datastoreId, &morpheus.Request{
+			Body: body,
+		})
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.UpdateCloudDatastoreResult)
+	if !result.Success && result.Message != "" {
+		return fmt.Errorf("error updating cloud datastore: %s", result.Message)
+	}
+	return nil
+}
+
+// resourceCloudDatastoreConfigurationImport imports a datastore using
+// the <cloud_id>:<datastore_id> format
+func resourceCloudDatastoreConfigurationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importData := strings.SplitN(d.Id(), ":", 2)
+	if len(importData) != 2 || importData[0] == "" || importData[1] == "" {
+		return nil, fmt.Errorf("unexpected format of import id (%s), expected <cloud_id>:<datastore_id>", d.Id())
+	}
+	cloudId, err := strconv.Atoi(importData[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cloud id %s: %s", importData[0], err)
+	}
+	if _, err := strconv.Atoi(importData[1]); err != nil {
+		return nil, fmt.Errorf("invalid datastore id %s: %s", importData[1], err)
+	}
+	d.Set("cloud_id", cloudId)
+	d.SetId(importData[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -20,6 +20,7 @@ func resourceCloudFormationAppBlueprint() *schema.Resource {
 		ReadContext:   resourceCloudFormationAppBlueprintRead,
 		UpdateContext: resourceCloudFormationAppBlueprintUpdate,
 		DeleteContext: resourceCloudFormationAppBlueprintDelete,
+		CustomizeDiff: appBlueprintSourceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -109,7 +110,7 @@ func resourceCloudFormationAppBlueprint() *schema.Resource {
 }
 
 func resourceCloudFormationAppBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -144,12 +145,7 @@ func resourceCloudFormationAppBlueprintCreate(ctx context.Context, d *schema.Res
 
 	case "repository":
 		cloudformationConfig["configType"] = "git"
-		cloudformationGitConfig := make(map[string]interface{})
-		cloudformationGitConfig["integrationId"] = d.Get("integration_id")
-		cloudformationGitConfig["repoId"] = d.Get("repository_id")
-		cloudformationGitConfig["branch"] = d.Get("version_ref").(string)
-		cloudformationGitConfig["path"] = d.Get("working_path").(string)
-		cloudformationConfig["git"] = cloudformationGitConfig
+		cloudformationConfig["git"] = appBlueprintGitPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -181,7 +177,7 @@ func resourceCloudFormationAppBlueprintCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudFormationAppBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -213,6 +209,7 @@ func resourceCloudFormationAppBlueprintRead(ctx context.Context, d *schema.Resou
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var cloudformationBlueprint CloudFormationAppBlueprint
@@ -238,16 +235,13 @@ func resourceCloudFormationAppBlueprintRead(ctx context.Context, d *schema.Resou
 		d.Set("blueprint_content", cloudformationBlueprint.Blueprint.Config.CloudFormation.YAML)
 	case "git":
 		d.Set("source_type", "repository")
-		d.Set("working_path", cloudformationBlueprint.Blueprint.Config.CloudFormation.Git.Path)
-		d.Set("integration_id", cloudformationBlueprint.Blueprint.Config.CloudFormation.Git.IntegrationId)
-		d.Set("repository_id", cloudformationBlueprint.Blueprint.Config.CloudFormation.Git.RepoId)
-		d.Set("version_ref", cloudformationBlueprint.Blueprint.Config.CloudFormation.Git.Branch)
+		setAppBlueprintGit(d, cloudformationBlueprint.Blueprint.Config.CloudFormation.Git)
 	}
 	return diags
 }
 
 func resourceCloudFormationAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	name := d.Get("name").(string)
@@ -280,12 +274,7 @@ func resourceCloudFormationAppBlueprintUpdate(ctx context.Context, d *schema.Res
 
 	case "repository":
 		cloudformationConfig["configType"] = "git"
-		cloudformationGitConfig := make(map[string]interface{})
-		cloudformationGitConfig["integrationId"] = d.Get("integration_id")
-		cloudformationGitConfig["repoId"] = d.Get("repository_id")
-		cloudformationGitConfig["branch"] = d.Get("version_ref").(string)
-		cloudformationGitConfig["path"] = d.Get("working_path").(string)
-		cloudformationConfig["git"] = cloudformationGitConfig
+		cloudformationConfig["git"] = appBlueprintGitPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -299,7 +288,9 @@ func resourceCloudFormationAppBlueprintUpdate(ctx context.Context, d *schema.Res
 			},
 		},
 	}
-	resp, err := client.UpdateBlueprint(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBlueprint(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -314,14 +305,16 @@ func resourceCloudFormationAppBlueprintUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudFormationAppBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteBlueprint(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteBlueprint(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -347,20 +340,15 @@ type CloudFormationAppBlueprint struct {
 			Name           string `json:"name"`
 			Description    string `json:"description"`
 			CloudFormation struct {
-				Configtype       string `json:"configType"`
-				CloudInitEnabled bool   `json:"cloudInitEnabled"`
-				InstallAgent     bool   `json:"installAgent"`
-				JSON             string `json:"json"`
-				YAML             string `json:"yaml"`
-				IAM              bool   `json:"IAM"`
-				IAMNamed         bool   `json:"CAPABILITY_NAMED_IAM"`
-				AutoExpand       bool   `json:"CAPABILITY_AUTO_EXPAND"`
-				Git              struct {
-					Path          string `json:"path"`
-					RepoId        int    `json:"repoId"`
-					IntegrationId int    `json:"integrationId"`
-					Branch        string `json:"branch"`
-				} `json:"git"`
+				Configtype       string          `json:"configType"`
+				CloudInitEnabled bool            `json:"cloudInitEnabled"`
+				InstallAgent     bool            `json:"installAgent"`
+				JSON             string          `json:"json"`
+				YAML             string          `json:"yaml"`
+				IAM              bool            `json:"IAM"`
+				IAMNamed         bool            `json:"CAPABILITY_NAMED_IAM"`
+				AutoExpand       bool            `json:"CAPABILITY_AUTO_EXPAND"`
+				Git              appBlueprintGit `json:"git"`
 			} `json:"cloudformation"`
 			Type     string `json:"type"`
 			Category string `json:"category"`
@@ -77,31 +77,28 @@ func resourceCloudFormationSpecTemplate() *schema.Resource {
 				Description: "Whether the auto expand capability is added to the cloud formation",
 				Optional:    true,
 			},
+			"config":    specTemplateConfigSchema(),
+			"tenant_id": tenantIdSchema("The id of the tenant the cloud formation spec template is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceCloudFormationSpecTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "spec_content", "spec_path")
 
 	specTemplateType := make(map[string]interface{})
 	specTemplateType["code"] = "cloudFormation"
 
-	config := make(map[string]interface{})
-
 	cloudformationConfig := make(map[string]interface{})
-	config["cloudformation"] = cloudformationConfig
 	if d.Get("capability_iam").(bool) {
 		cloudformationConfig["IAM"] = "on"
 	}
@@ -112,19 +109,11 @@ func resourceCloudFormationSpecTemplateCreate(ctx context.Context, d *schema.Res
 		cloudformationConfig["CAPABILITY_AUTO_EXPAND"] = "on"
 	}
 
-	switch d.Get("source_type") {
-	case "local":
-		sourceOptions["content"] = d.Get("spec_content")
-		sourceOptions["contentPath"] = d.Get("spec_path")
-	case "url":
-		sourceOptions["content"] = d.Get("spec_content")
-		sourceOptions["contentPath"] = d.Get("spec_path")
-	case "repository":
-		sourceOptions["contentPath"] = d.Get("spec_path")
-		sourceOptions["contentRef"] = d.Get("version_ref")
-		sourceOptions["repository"] = map[string]interface{}{
-			"id": d.Get("repository_id"),
-		}
+	config, err := specTemplateConfigPayload(d, map[string]interface{}{
+		"cloudformation": cloudformationConfig,
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	req := &morpheus.Request{
@@ -137,7 +126,7 @@ func resourceCloudFormationSpecTemplateCreate(ctx context.Context, d *schema.Res
 			},
 		},
 	}
-	resp, err := client.CreateSpecTemplate(req)
+	resp, err := specTemplateApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -154,8 +143,6 @@ func resourceCloudFormationSpecTemplateCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudFormationSpecTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -166,9 +153,9 @@ func resourceCloudFormationSpecTemplateRead(ctx context.Context, d *schema.Resou
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindSpecTemplateByName(name)
+		resp, err = specTemplateApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetSpecTemplate(toInt64(id), &morpheus.Request{})
+		resp, err = specTemplateApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Spec template cannot be read without name or id")
 	}
@@ -186,6 +173,8 @@ func resourceCloudFormationSpecTemplateRead(ctx context.Context, d *schema.Resou
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	var cloudFormationSpecTemplate CloudFormationSpecTemplate
@@ -194,7 +183,6 @@ func resourceCloudFormationSpecTemplateRead(ctx context.Context, d *schema.Resou
 	}
 	d.SetId(intToString(cloudFormationSpecTemplate.Spectemplate.ID))
 	d.Set("name", cloudFormationSpecTemplate.Spectemplate.Name)
-	d.Set("source_type", cloudFormationSpecTemplate.Spectemplate.File.Sourcetype)
 
 	if cloudFormationSpecTemplate.Spectemplate.Config.CloudFormation.Iam == "on" {
 		d.Set("capability_iam", true)
@@ -214,37 +202,25 @@ func resourceCloudFormationSpecTemplateRead(ctx context.Context, d *schema.Resou
 		d.Set("capability_auto_expand", false)
 	}
 
-	switch cloudFormationSpecTemplate.Spectemplate.File.Sourcetype {
-	case "local":
-		d.Set("source_type", "local")
-		d.Set("spec_content", cloudFormationSpecTemplate.Spectemplate.File.Content)
-	case "url":
-		d.Set("source_type", "url")
-		d.Set("spec_path", cloudFormationSpecTemplate.Spectemplate.File.Contentpath)
-	case "git":
-		d.Set("source_type", "repository")
-		d.Set("spec_path", cloudFormationSpecTemplate.Spectemplate.File.Contentpath)
-		d.Set("repository_id", cloudFormationSpecTemplate.Spectemplate.File.Repository.ID)
-		d.Set("version_ref", cloudFormationSpecTemplate.Spectemplate.File.Contentref)
+	setSourceFile(d, cloudFormationSpecTemplate.Spectemplate.File, "spec_content", "spec_path")
+
+	if err := setSpecTemplateConfig(d, resp, "cloudformation"); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
 }
 
 func resourceCloudFormationSpecTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "spec_content", "spec_path")
 
 	specTemplateType := make(map[string]interface{})
 	specTemplateType["code"] = "cloudFormation"
 
-	config := make(map[string]interface{})
 	cloudformationConfig := make(map[string]interface{})
-	config["cloudformation"] = cloudformationConfig
 
 	if d.Get("capability_iam").(bool) {
 		cloudformationConfig["IAM"] = "on"
@@ -256,19 +232,11 @@ func resourceCloudFormationSpecTemplateUpdate(ctx context.Context, d *schema.Res
 		cloudformationConfig["CAPABILITY_AUTO_EXPAND"] = "on"
 	}
 
-	switch d.Get("source_type") {
-	case "local":
-		sourceOptions["content"] = d.Get("spec_content")
-		sourceOptions["contentPath"] = d.Get("spec_path")
-	case "url":
-		sourceOptions["content"] = d.Get("spec_content")
-		sourceOptions["contentPath"] = d.Get("spec_path")
-	case "repository":
-		sourceOptions["contentPath"] = d.Get("spec_path")
-		sourceOptions["contentRef"] = d.Get("version_ref")
-		sourceOptions["repository"] = map[string]interface{}{
-			"id": d.Get("repository_id"),
-		}
+	config, err := specTemplateConfigPayload(d, map[string]interface{}{
+		"cloudformation": cloudformationConfig,
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	req := &morpheus.Request{
@@ -281,7 +249,9 @@ func resourceCloudFormationSpecTemplateUpdate(ctx context.Context, d *schema.Res
 			},
 		},
 	}
-	resp, err := client.UpdateSpecTemplate(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return specTemplateApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -296,14 +266,14 @@ func resourceCloudFormationSpecTemplateUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudFormationSpecTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteSpecTemplate(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return specTemplateApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -336,25 +306,15 @@ type CloudFormationSpecTemplate struct {
 		Externaltype interface{} `json:"externalType"`
 		Deploymentid interface{} `json:"deploymentId"`
 		Status       interface{} `json:"status"`
-		File         struct {
-			ID          int         `json:"id"`
-			Sourcetype  string      `json:"sourceType"`
-			Contentref  interface{} `json:"contentRef"`
-			Contentpath interface{} `json:"contentPath"`
-			Repository  struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-			} `json:"repository"`
-			Content string `json:"content"`
-		} `json:"file"`
-		Config struct {
+		File         SourceFile  `json:"file"`
+		Config       struct {
 			CloudFormation struct {
 				Iam                  string `json:"IAM"`
 				CapabilityNamedIam   string `json:"CAPABILITY_NAMED_IAM"`
 				CapabilityAutoExpand string `json:"CAPABILITY_AUTO_EXPAND"`
 			} `json:"cloudformation"`
 		} `json:"config"`
-		Createdby   string      `json:"createdBy"`
+		Createdby   JSONString  `json:"createdBy"`
 		Updatedby   interface{} `json:"updatedBy"`
 		Datecreated time.Time   `json:"dateCreated"`
 		Lastupdated time.Time   `json:"lastUpdated"`
@@ -0,0 +1,320 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudNetwork() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus cloud network resource for managing the settings of a network synchronized from a cloud, such as its ip pool, domain, visibility, group and tenant permissions, without creating the network itself. Destroying the resource only removes it from the state, the network keeps its settings.",
+		CreateContext: resourceCloudNetworkCreate,
+		ReadContext:   resourceCloudNetworkRead,
+		UpdateContext: resourceCloudNetworkUpdate,
+		DeleteContext: resourceCloudNetworkDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The id of the cloud network",
+				Computed:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the cloud the network belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"network_id": {
+				Type:         schema.TypeInt,
+				Description:  "The id of the cloud network",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"network_id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "The name of the cloud network",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"network_id", "name"},
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of the cloud network",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the cloud network",
+				Optional:    true,
+				Computed:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the cloud network is active, the inactive networks are not available for provisioning",
+				Optional:    true,
+				Computed:    true,
+			},
+			"dhcp_server": {
+				Type:        schema.TypeBool,
+				Description: "Whether the cloud network has a DHCP server, the instances being assigned their ip address by DHCP rather than from the ip pool",
+				Optional:    true,
+				Computed:    true,
+			},
+			"allow_static_override": {
+				Type:        schema.TypeBool,
+				Description: "Whether a static ip address can be set on the instances provisioned on the cloud network",
+				Optional:    true,
+				Computed:    true,
+			},
+			"pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the ip pool the ip addresses of the instances are assigned from",
+				Optional:    true,
+				Computed:    true,
+			},
+			"domain_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the network domain of the instances provisioned on the cloud network",
+				Optional:    true,
+				Computed:    true,
+			},
+			"appliance_url_proxy_bypass": {
+				Type:        schema.TypeBool,
+				Description: "Whether the instances provisioned on the cloud network bypass the proxy to reach the appliance url",
+				Optional:    true,
+				Computed:    true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Determines whether the cloud network is visible in sub-tenants or not (private, public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"group_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all groups access to the cloud network",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids to grant access to the cloud network",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of tenant ids to grant access to the cloud network",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The code of the type of the cloud network",
+				Computed:    true,
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Description: "The CIDR of the cloud network",
+				Computed:    true,
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the network in the cloud",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the cloud network",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceCloudNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	cloudId := d.Get("cloud_id").(int)
+	networkId := int64(d.Get("network_id").(int))
+	if networkId == 0 {
+		// Find by name, then update by ID
+		name := d.Get("name").(string)
+		resp, err := client.Execute(&morpheus.Request{
+			Method: "GET",
+			Path:   morpheus.NetworksPath,
+			QueryParams: map[string]string{
+				"name":   name,
+				"zoneId": fmt.Sprintf("%d", cloudId),
+			},
+			Result: &LocalListNetworksResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		listResult := resp.Result.(*LocalListNetworksResult)
+		// the name filter also matches the networks whose name contains it
+		for _, network := range listResult.Networks {
+			if network.Name == name && network.Zone.ID == int64(cloudId) {
+				networkId = network.ID
+				break
+			}
+		}
+		if networkId == 0 {
+			return diag.Errorf("Unable to find a network named %s in cloud %d", name, cloudId)
+		}
+	}
+
+	if err := updateCloudNetwork(ctx, client, d, networkId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(networkId))
+	return resourceCloudNetworkRead(ctx, d, meta)
+}
+
+func resourceCloudNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := getLocalNetwork(client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	network := resp.Result.(*LocalGetNetworkResult).Network
+	if network == nil {
+		return diag.Errorf("read operation: cloud network not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(network.ID))
+	d.Set("cloud_id", network.Zone.ID)
+	d.Set("network_id", network.ID)
+	d.Set("name", network.Name)
+	d.Set("display_name", network.DisplayName)
+	d.Set("description", network.Description)
+	d.Set("active", network.Active)
+	d.Set("dhcp_server", network.DhcpServer)
+	d.Set("allow_static_override", network.AllowStaticOverride)
+	d.Set("pool_id", jsonRefIdValue(network.Pool))
+	d.Set("domain_id", jsonRefIdValue(network.NetworkDomain))
+	d.Set("appliance_url_proxy_bypass", network.ApplianceUrlProxyBypass)
+	d.Set("visibility", network.Visibility)
+	d.Set("type", network.Type.Code)
+	d.Set("cidr", network.Cidr)
+	d.Set("external_id", network.ExternalId)
+	d.Set("status", network.Status)
+	setNetworkPermissions(d, network)
+
+	return diags
+}
+
+func resourceCloudNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	if err := updateCloudNetwork(ctx, client, d, toInt64(d.Id())); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCloudNetworkRead(ctx, d, meta)
+}
+
+func resourceCloudNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The network belongs to the cloud and is removed by the cloud sync
+	d.SetId("")
+	return diags
+}
+
+// updateCloudNetwork saves the configured settings of a cloud network
+func updateCloudNetwork(ctx context.Context, client *apiClient, d *schema.ResourceData, networkId int64) error {
+	network := make(map[string]interface{})
+	// only send the settings when configured, the network keeps its synced state otherwise
+	config := d.GetRawConfig()
+	for attribute, field := range map[string]string{"active": "active", "dhcp_server": "dhcpServer", "allow_static_override": "allowStaticOverride", "appliance_url_proxy_bypass": "applianceUrlProxyBypass"} {
+		if !config.GetAttr(attribute).IsNull() {
+			network[field] = d.Get(attribute).(bool)
+		}
+	}
+	for attribute, field := range map[string]string{"display_name": "displayName", "description": "description", "visibility": "visibility"} {
+		if !config.GetAttr(attribute).IsNull() {
+			network[field] = d.Get(attribute).(string)
+		}
+	}
+	if poolId, ok := d.GetOk("pool_id"); ok {
+		network["pool"] = map[string]interface{}{
+			"id": poolId.(int),
+		}
+	}
+	if domainId, ok := d.GetOk("domain_id"); ok {
+		network["networkDomain"] = map[string]interface{}{
+			"id": domainId.(int),
+		}
+	}
+
+	body := map[string]interface{}{
+		"network": network,
+	}
+
+	// the group and tenant permissions are left untouched when not configured
+	if !config.GetAttr("group_access_all").IsNull() || !config.GetAttr("group_access_ids").IsNull() {
+		network["resourcePermissions"] = networkResourcePermissions(d)
+	}
+	if !config.GetAttr("tenant_ids").IsNull() {
+		body["tenantPermissions"] = networkTenantPermissions(d)
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method: "PUT",
+			Path:   fmt.Sprintf("%s/%d", morpheus.NetworksPath, networkId),
+			Body:   body,
+			Result: &LocalUpdateNetworkResult{},
+		})
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*LocalUpdateNetworkResult)
+	if !result.Success && result.Message != "" {
+		return fmt.Errorf("error updating cloud network: %s", result.Message)
+	}
+	return nil
+}
@@ -0,0 +1,387 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudResourcePool() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus cloud resource pool resource for managing the visibility, group and tenant permissions of a resource pool or VPC synchronized from a cloud. Destroying the resource only removes it from the state, the resource pool keeps its settings.",
+		CreateContext: resourceCloudResourcePoolCreate,
+		ReadContext:   resourceCloudResourcePoolRead,
+		UpdateContext: resourceCloudResourcePoolUpdate,
+		DeleteContext: resourceCloudResourcePoolDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The id of the cloud resource pool",
+				Computed:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the cloud the resource pool belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"resource_pool_id": {
+				Type:         schema.TypeInt,
+				Description:  "The id of the cloud resource pool",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"resource_pool_id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "The name of the cloud resource pool",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"resource_pool_id", "name"},
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the cloud resource pool is active",
+				Optional:    true,
+				Computed:    true,
+			},
+			"visibility": {
+				Description:  "Determines whether the cloud resource pool is visible in sub-tenants or not",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Computed:     true,
+			},
+			"default_pool": {
+				Type:        schema.TypeBool,
+				Description: "Whether the resource pool is the default resource pool of the cloud",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all groups access to the resource pool",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids to grant access to the resource pool",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"group_default_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids the resource pool is the default resource pool of, the groups are granted access to the resource pool",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of tenant ids to grant access to the resource pool",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"availability_zones": {
+				Type:        schema.TypeSet,
+				Description: "A list of availability zones the workloads provisioned into the resource pool are placed in, every availability zone when empty",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"edge_cluster": {
+				Type:        schema.TypeString,
+				Description: "The name of the NSX edge cluster the networks of the workloads provisioned into the resource pool are attached to",
+				Optional:    true,
+			},
+			"host_groups": {
+				Type:        schema.TypeSet,
+				Description: "A list of host groups the workloads provisioned into the resource pool are placed on, every host of the resource pool when empty",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of the cloud resource pool",
+				Computed:    true,
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the resource pool in the cloud",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the cloud resource pool",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudResourcePoolImport,
+		},
+	}
+}
+
+func resourceCloudResourcePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	cloudId := int64(d.Get("cloud_id").(int))
+	resourcePoolId := int64(d.Get("resource_pool_id").(int))
+	if resourcePoolId == 0 {
+		// Find by name, then update by ID
+		name := d.Get("name").(string)
+		resp, err := client.ListResourcePools(cloudId, &morpheus.Request{
+			QueryParams: map[string]string{
+				"name": name,
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		listResult := resp.Result.(*morpheus.ListResourcePoolsResult)
+		if listResult.ResourcePools == nil || len(*listResult.ResourcePools) == 0 {
+			return diag.Errorf("Unable to find a resource pool named %s", name)
+		}
+		resourcePoolId = (*listResult.ResourcePools)[0].ID
+	}
+
+	if err := updateCloudResourcePool(ctx, client, d, cloudId, resourcePoolId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(resourcePoolId))
+	return resourceCloudResourcePoolRead(ctx, d, meta)
+}
+
+func resourceCloudResourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	cloudId := int64(d.Get("cloud_id").(int))
+
+	resp, err := client.GetResourcePool(cloudId, toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetResourcePoolResult)
+	resourcePool := result.ResourcePool
+	if resourcePool == nil {
+		return diag.Errorf("read operation: cloud resource pool not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(resourcePool.ID))
+	d.Set("resource_pool_id", resourcePool.ID)
+	d.Set("name", resourcePool.Name)
+	d.Set("active", resourcePool.Active)
+	d.Set("visibility", resourcePool.Visibility)
+	d.Set("default_pool", resourcePool.DefaultPool)
+	d.Set("type", resourcePool.Type)
+	d.Set("external_id", resourcePool.ExternalId)
+	d.Set("status", resourcePool.Status)
+
+	// the sdk types the group permissions and the config loosely, read them from the raw response
+	var groupAccessAll bool
+	var groupIds []int
+	var groupDefaultIds []int
+	var placement map[string]interface{}
+	if data, ok := resp.JsonData.(map[string]interface{}); ok {
+		if poolData, ok := data["resourcePool"].(map[string]interface{}); ok {
+			placement, _ = poolData["config"].(map[string]interface{})
+			if permission, ok := poolData["resourcePermission"].(map[string]interface{}); ok {
+				switch all := permission["all"].(type) {
+				case bool:
+					groupAccessAll = all
+				case string:
+					groupAccessAll, _ = strconv.ParseBool(all)
+				}
+				sites, _ := permission["sites"].([]interface{})
+				for _, item := range sites {
+					site, ok := item.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					siteId, ok := site["id"].(float64)
+					if !ok {
+						continue
+					}
+					if isDefault, _ := site["default"].(bool); isDefault {
+						groupDefaultIds = append(groupDefaultIds, int(siteId))
+					} else {
+						groupIds = append(groupIds, int(siteId))
+					}
+				}
+			}
+		}
+	}
+	d.Set("availability_zones", jsonStringListValue(placement["availabilityZones"]))
+	d.Set("edge_cluster", jsonStringValue(placement["edgeCluster"]))
+	d.Set("host_groups", jsonStringListValue(placement["hostGroups"]))
+	d.Set("group_access_all", groupAccessAll)
+	d.Set("group_access_ids", groupIds)
+	d.Set("group_default_ids", groupDefaultIds)
+
+	var tenantIds []int64
+	for _, tenant := range resourcePool.Tenants {
+		tenantIds = append(tenantIds, tenant.ID)
+	}
+	d.Set("tenant_ids", tenantIds)
+
+	return diags
+}
+
+func resourceCloudResourcePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	cloudId := int64(d.Get("cloud_id").(int))
+	if err := updateCloudResourcePool(ctx, client, d, cloudId, toInt64(d.Id())); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCloudResourcePoolRead(ctx, d, meta)
+}
+
+func resourceCloudResourcePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The resource pool belongs to the cloud and is removed by the cloud sync
+	d.SetId("")
+	return diags
+}
+
+// updateCloudResourcePool saves the configured settings of a cloud resource pool
+func updateCloudResourcePool(ctx context.Context, client *apiClient, d *schema.ResourceData, cloudId int64, resourcePoolId int64) error {
+	resourcePool := make(map[string]interface{})
+	// only send the flags when configured, the resource pool keeps its synced state otherwise
+	config := d.GetRawConfig()
+	if !config.GetAttr("active").IsNull() {
+		resourcePool["active"] = d.Get("active").(bool)
+	}
+	if !config.GetAttr("default_pool").IsNull() {
+		resourcePool["defaultPool"] = d.Get("default_pool").(bool)
+	}
+	if visibility, ok := d.GetOk("visibility"); ok {
+		resourcePool["visibility"] = visibility.(string)
+	}
+
+	// the placement filters are part of the config of the resource pool,
+	// merged into its current config to keep the synced settings
+	if d.HasChanges("availability_zones", "edge_cluster", "host_groups") {
+		resp, err := client.GetResourcePool(cloudId, resourcePoolId, &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		poolConfig := make(map[string]interface{})
+		if data, ok := resp.JsonData.(map[string]interface{}); ok {
+			if poolData, ok := data["resourcePool"].(map[string]interface{}); ok {
+				if config, ok := poolData["config"].(map[string]interface{}); ok {
+					poolConfig = config
+				}
+			}
+		}
+		poolConfig["availabilityZones"] = d.Get("availability_zones").(*schema.Set).List()
+		poolConfig["edgeCluster"] = d.Get("edge_cluster").(string)
+		poolConfig["hostGroups"] = d.Get("host_groups").(*schema.Set).List()
+		resourcePool["config"] = poolConfig
+	}
+
+	body := map[string]interface{}{
+		"resourcePool": resourcePool,
+	}
+
+	// the group and tenant permissions are left untouched when not configured
+	if !config.GetAttr("group_access_all").IsNull() || !config.GetAttr("group_access_ids").IsNull() || !config.GetAttr("group_default_ids").IsNull() {
+		resourcePermissions := make(map[string]interface{})
+		resourcePermissions["all"] = d.Get("group_access_all").(bool)
+		sites := make([]map[string]interface{}, 0)
+		for _, groupId := range d.Get("group_access_ids").(*schema.Set).List() {
+			sites = append(sites, map[string]interface{}{
+				"id": groupId.(int),
+			})
+		}
+		for _, groupId := range d.Get("group_default_ids").(*schema.Set).List() {
+			sites = append(sites, map[string]interface{}{
+				"id":      groupId.(int),
+				"default": true,
+			})
+		}
+		resourcePermissions["sites"] = sites
+		resourcePool["resourcePermissions"] = resourcePermissions
+	}
+
+	if !config.GetAttr("tenant_ids").IsNull() {
+		var tenantPerm TenantPermission
+		for _, tenantId := range d.Get("tenant_ids").(*schema.Set).List() {
+			tenantPerm.Accounts = append(tenantPerm.Accounts, tenantId.(int))
+		}
+		body["tenantPermissions"] = tenantPerm
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateResourcePool(cloudId, resourcePoolId, &morpheus.Request{
+			Body: body,
+		})
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.UpdateResourcePoolResult)
+	if !result.Success && result.Message != "" {
+		return fmt.Errorf("error updating cloud resource pool: %s", result.Message)
+	}
+	return nil
+}
+
+// resourceCloudResourcePoolImport imports a resource pool using
+// the <cloud_id>:<resource_pool_id> format
+func resourceCloudResourcePoolImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importData := strings.SplitN(d.Id(), ":", 2)
+	if len(importData) != 2 || importData[0] == "" || importData[1] == "" {
+		return nil, fmt.Errorf("unexpected format of import id (%s), expected <cloud_id>:<resource_pool_id>", d.Id())
+	}
+	cloudId, err := strconv.Atoi(importData[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cloud id %s: %s", importData[0], err)
+	}
+	if _, err := strconv.Atoi(importData[1]); err != nil {
+		return nil, fmt.Errorf("invalid resource pool id %s: %s", importData[1], err)
+	}
+	d.Set("cloud_id", cloudId)
+	d.SetId(importData[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -204,7 +204,7 @@ func resourceClusterLayout() *schema.Resource {
 }
 
 func resourceClusterLayoutCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -299,7 +299,7 @@ func resourceClusterLayoutCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceClusterLayoutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -331,6 +331,7 @@ func resourceClusterLayoutRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var clusterLayout ClusterLayoutPayload
@@ -401,7 +402,7 @@ func resourceClusterLayoutRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceClusterLayoutUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	clusterLayout := make(map[string]interface{})
@@ -468,7 +469,9 @@ func resourceClusterLayoutUpdate(ctx context.Context, d *schema.ResourceData, me
 		},
 	}
 
-	resp, err := client.UpdateClusterLayout(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateClusterLayout(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -489,14 +492,16 @@ func resourceClusterLayoutUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceClusterLayoutDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteClusterLayout(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteClusterLayout(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -80,7 +80,7 @@ func resourceClusterPackage() *schema.Resource {
 }
 
 func resourceClusterPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -120,7 +120,7 @@ func resourceClusterPackageCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceClusterPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -150,6 +150,7 @@ func resourceClusterPackageRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetClusterPackageResult)
@@ -182,7 +183,7 @@ func resourceClusterPackageRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceClusterPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	req := &morpheus.Request{
@@ -201,7 +202,9 @@ func resourceClusterPackageUpdate(ctx context.Context, d *schema.ResourceData, m
 		},
 	}
 
-	resp, err := client.UpdateClusterPackage(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateClusterPackage(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -212,14 +215,16 @@ func resourceClusterPackageUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceClusterPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteClusterPackage(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteClusterPackage(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
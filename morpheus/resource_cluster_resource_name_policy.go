@@ -113,7 +113,7 @@ func resourceClusterResourceNamePolicy() *schema.Resource {
 }
 
 func resourceClusterResourceNamePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -191,7 +191,7 @@ func resourceClusterResourceNamePolicyCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceClusterResourceNamePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -221,6 +221,7 @@ func resourceClusterResourceNamePolicyRead(ctx context.Context, d *schema.Resour
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -264,7 +265,7 @@ func resourceClusterResourceNamePolicyRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceClusterResourceNamePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -323,7 +324,9 @@ func resourceClusterResourceNamePolicyUpdate(ctx context.Context, d *schema.Reso
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -339,14 +342,16 @@ func resourceClusterResourceNamePolicyUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceClusterResourceNamePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -4,9 +4,9 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
-	"strings"
-
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,7 +16,7 @@ import (
 
 func resourceCredential() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus credential resource.",
+		Description:   "Provides a Morpheus credential resource. The secrets of the credential are saved to the internal credential store or to an external credential store integration, such as HashiCorp Vault or CyberArk, and only their hashes are kept in the state.",
 		CreateContext: resourceCredentialCreate,
 		ReadContext:   resourceCredentialRead,
 		UpdateContext: resourceCredentialUpdate,
@@ -28,11 +28,22 @@ func resourceCredential() *schema.Resource {
 				Description: "The ID of the credential",
 				Computed:    true,
 			},
+			"store_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the external credential store integration the secrets are saved to, the internal store is used when not set",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"credential_store_integration_id"},
+			},
 			"credential_store_integration_id": {
-				Type:        schema.TypeInt,
-				Description: "The ID of the credential store integration",
-				Optional:    true,
-				ForceNew:    true,
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store integration",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Deprecated:    "use store_id instead",
+				ConflictsWith: []string{"store_id"},
 			},
 			"type": {
 				Type:         schema.TypeString,
@@ -135,15 +146,54 @@ func resourceCredential() *schema.Resource {
 				Description: "The ID of the credential key pair",
 				Optional:    true,
 			},
+			"keepers": keepersSchema("Arbitrary values that, when changed, send the secrets of the credential to the appliance again, to force their rotation", false),
 		},
+		CustomizeDiff: credentialCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
+// credentialRequiredAttributes are the attributes each credential type is
+// built from, the other attributes being ignored for the type
+var credentialRequiredAttributes = map[string][]string{
+	"access-key-secret":         {"access_key", "secret_key"},
+	"api-key":                   {"api_key"},
+	"client-id-secret":          {"client_id", "client_secret"},
+	"email-private-key":         {"email", "key_pair_id"},
+	"tenant-username-keypair":   {"tenant", "username", "key_pair_id"},
+	"username-api-key":          {"username", "api_key"},
+	"username-keypair":          {"username", "key_pair_id"},
+	"username-password":         {"username", "password"},
+	"username-password-keypair": {"username", "password", "key_pair_id"},
+}
+
+// credentialCustomizeDiff checks at plan time the attributes of the type of
+// the credential are set, the appliance otherwise saving an incomplete
+// credential
+func credentialCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+	credentialType := d.Get("type").(string)
+	var missing []string
+	for _, attribute := range credentialRequiredAttributes[credentialType] {
+		if !d.NewValueKnown(attribute) {
+			continue
+		}
+		if _, ok := d.GetOk(attribute); !ok {
+			missing = append(missing, attribute)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s must be set for a %s credential", strings.Join(missing, ", "), credentialType)
+	}
+	return nil
+}
+
 func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -153,7 +203,9 @@ func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, meta
 	credential["enabled"] = d.Get("enabled").(bool)
 
 	integration := make(map[string]interface{})
-	if d.Get("credential_store_integration_id").(int) != 0 {
+	if d.Get("store_id").(int) != 0 {
+		integration["id"] = d.Get("store_id").(int)
+	} else if d.Get("credential_store_integration_id").(int) != 0 {
 		integration["id"] = d.Get("credential_store_integration_id").(int)
 	}
 	credential["integration"] = integration
@@ -228,7 +280,7 @@ func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -258,6 +310,7 @@ func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetCredentialResult)
@@ -267,9 +320,8 @@ func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta in
 		d.Set("name", credential.Name)
 		d.Set("description", credential.Description)
 		d.Set("enabled", credential.Enabled)
-		if credential.Integration.ID != 0 {
-			d.Set("credential_store_integration_id", credential.Integration.ID)
-		}
+		d.Set("store_id", credential.Integration.ID)
+		d.Set("credential_store_integration_id", credential.Integration.ID)
 		switch credential.Type.Code {
 		case "access-key-secret":
 			d.Set("access_key", credential.Username)
@@ -308,7 +360,7 @@ func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	credential := make(map[string]interface{})
 	credential["name"] = d.Get("name").(string)
@@ -368,7 +420,9 @@ func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta
 			"credential": credential,
 		},
 	}
-	resp, err := client.UpdateCredential(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateCredential(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -383,14 +437,16 @@ func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceCredentialDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteCredential(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCredential(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
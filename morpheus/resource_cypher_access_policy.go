@@ -119,7 +119,7 @@ func resourceCypherAccessPolicy() *schema.Resource {
 }
 
 func resourceCypherAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -182,7 +182,7 @@ func resourceCypherAccessPolicyCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCypherAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -212,6 +212,7 @@ func resourceCypherAccessPolicyRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -253,7 +254,7 @@ func resourceCypherAccessPolicyRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCypherAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -297,7 +298,9 @@ func resourceCypherAccessPolicyUpdate(ctx context.Context, d *schema.ResourceDat
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -313,14 +316,16 @@ func resourceCypherAccessPolicyUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCypherAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -9,10 +9,14 @@ import (
 	"log"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var cypherSecretMounts = []string{"secret", "password", "key"}
+
 func resourceCypherSecret() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Provides a Morpheus cypher secret resource.",
@@ -26,18 +30,44 @@ func resourceCypherSecret() *schema.Resource {
 				Description: "The ID of the cypher secret",
 				Computed:    true,
 			},
+			"mount": {
+				Type:         schema.TypeString,
+				Description:  "The mount point of the cypher secret (secret, password, key)",
+				ValidateFunc: validation.StringInSlice(cypherSecretMounts, false),
+				Optional:     true,
+				Default:      "secret",
+				ForceNew:     true,
+			},
 			"key": {
 				Type:        schema.TypeString,
-				Description: "The path of the cypher secret, excluding the secret prefix",
+				Description: "The path of the cypher secret, excluding the mount prefix",
 				Required:    true,
 				ForceNew:    true,
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Description: "The value of the cypher secret",
-				Required:    true,
-				Sensitive:   true,
-				ForceNew:    true,
+				Type:          schema.TypeString,
+				Description:   "The value of the cypher secret, stored in the Terraform state, the value generated by the appliance for the password and key mounts when not set",
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				ConflictsWith: []string{"value_wo"},
+			},
+			"value_wo": {
+				Type:          schema.TypeString,
+				Description:   "The write-only value of the cypher secret, never stored in the Terraform state, requires Terraform 1.11 or later and value_wo_version",
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"value"},
+				RequiredWith:  []string{"value_wo_version"},
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				Description:  "The version of the write-only value of the cypher secret, changing it writes the value_wo value again as the value itself is not compared to detect changes",
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"value_wo"},
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -46,48 +76,83 @@ func resourceCypherSecret() *schema.Resource {
 				Computed:    true,
 				ForceNew:    true,
 			},
+			"keepers": keepersSchema("Arbitrary values that, when changed, write the cypher secret again, to force its rotation", true),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceCypherSecretImport,
 		},
 	}
 }
 
 func resourceCypherSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	req := &morpheus.Request{
-		Body: map[string]interface{}{
-			"value": d.Get("value").(string),
-		},
-		QueryParams: map[string]string{
-			"ttl":  strconv.Itoa(d.Get("ttl").(int)),
-			"type": "string",
-		},
+	// the write-only value is only available in the configuration
+	value := d.Get("value").(string)
+	valueWo, valueDiags := d.GetRawConfigAt(cty.GetAttrPath("value_wo"))
+	if valueDiags.HasError() {
+		return valueDiags
 	}
-
-	secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
-	resp, err := client.CreateCypher(secretPath, req)
-	if err != nil {
-		log.Printf("API FAILURE: %s - %s", resp, err)
-		return diag.FromErr(err)
+	if valueWo.Type().Equals(cty.String) && valueWo.IsKnown() && !valueWo.IsNull() {
+		value = valueWo.AsString()
 	}
-	// Masking to avoid credential exposure
-	// log.Printf("API RESPONSE: %s", resp)
 
-	result := resp.Result.(*morpheus.CreateCypherResult)
+	mount := d.Get("mount").(string)
+	secretPath := cypherSecretPath(d)
+	ttl := strconv.Itoa(d.Get("ttl").(int))
+
+	var cypher *morpheus.Cypher
+	if value != "" {
+		req := &morpheus.Request{
+			Body: map[string]interface{}{
+				"value": value,
+			},
+			QueryParams: map[string]string{
+				"ttl":  ttl,
+				"type": "string",
+			},
+		}
+		resp, err := client.CreateCypher(secretPath, req)
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		// Masking to avoid credential exposure
+		// log.Printf("API RESPONSE: %s", resp)
+		cypher = resp.Result.(*morpheus.CreateCypherResult).Cypher
+	} else if mount != "secret" {
+		// reading a missing key of the password and key mounts generates its value
+		req := &morpheus.Request{
+			QueryParams: map[string]string{
+				"ttl": ttl,
+			},
+		}
+		resp, err := client.GetCypher(secretPath, req)
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		// Masking to avoid credential exposure
+		// log.Printf("API RESPONSE: %s", resp)
+		cypher = resp.Result.(*morpheus.GetCypherResult).Cypher
+	} else {
+		return diag.Errorf("a value or value_wo is required for a cypher secret of the secret mount")
+	}
+	if cypher == nil {
+		return diag.Errorf("Cypher not found in response data.") // should not happen
+	}
 	// Successfully created resource, now set id
-	d.SetId(int64ToString(result.Cypher.ID))
+	d.SetId(int64ToString(cypher.ID))
 
 	resourceCypherSecretRead(ctx, d, meta)
 	return diags
 }
 
 func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -96,8 +161,7 @@ func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta
 	var resp *morpheus.Response
 	var err error
 	if id != "" {
-		secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
-		resp, err = client.GetCypher(secretPath, &morpheus.Request{})
+		resp, err = client.GetCypher(cypherSecretPath(d), &morpheus.Request{})
 	} else {
 		return diag.Errorf("Cypher cannot be read without id")
 	}
@@ -115,15 +179,20 @@ func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	// Masking to avoid credential exposure
 	// log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetCypherResult)
 	if result.Cypher != nil {
 		d.SetId(int64ToString(result.Cypher.ID))
 		keyData := strings.Split(result.Cypher.ItemKey, "/")
-		keyData = keyData[1:]
-		d.Set("key", strings.Join(keyData, "/"))
+		d.Set("mount", keyData[0])
+		d.Set("key", strings.Join(keyData[1:], "/"))
 		d.Set("ttl", result.LeaseDuration)
+		// the value written with value_wo is not stored in the state
+		if value, ok := result.Data.(string); ok && d.Get("value_wo_version").(int) == 0 {
+			d.Set("value", value)
+		}
 	} else {
 		return diag.Errorf("read operation: contact not found in response data") // should not happen
 	}
@@ -132,14 +201,16 @@ func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceCypherSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	req := &morpheus.Request{}
-	secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
-	resp, err := client.DeleteCypher(secretPath, req)
+	secretPath := cypherSecretPath(d)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCypher(secretPath, req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -153,3 +224,33 @@ func resourceCypherSecretDelete(ctx context.Context, d *schema.ResourceData, met
 	d.SetId("")
 	return diags
 }
+
+// resourceCypherSecretImport imports a cypher by its key since the
+// cypher api is addressed by path rather than by id, the key being
+// prefixed with its mount when not in the secret mount
+func resourceCypherSecretImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	mount := "secret"
+	key := d.Id()
+	for _, m := range cypherSecretMounts {
+		if strings.HasPrefix(key, m+"/") {
+			mount = m
+			key = strings.TrimPrefix(key, m+"/")
+			break
+		}
+	}
+	if key == "" {
+		return nil, fmt.Errorf("the cypher key must be used as the import id")
+	}
+	d.Set("mount", mount)
+	d.Set("key", key)
+	return []*schema.ResourceData{d}, nil
+}
+
+// cypherSecretPath returns the path of the cypher secret in its mount
+func cypherSecretPath(d *schema.ResourceData) string {
+	mount := d.Get("mount").(string)
+	if mount == "" {
+		mount = "secret"
+	}
+	return fmt.Sprintf("%s/%s", mount, d.Get("key").(string))
+}
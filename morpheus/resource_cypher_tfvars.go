@@ -48,13 +48,13 @@ func resourceCypherTFVars() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceCypherTFVarsImport,
 		},
 	}
 }
 
 func resourceCypherTFVarsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -87,7 +87,7 @@ func resourceCypherTFVarsCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceCypherTFVarsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -115,6 +115,7 @@ func resourceCypherTFVarsRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	// Masking to avoid credential exposure
 	//log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetCypherResult)
@@ -124,6 +125,9 @@ func resourceCypherTFVarsRead(ctx context.Context, d *schema.ResourceData, meta
 		keyData = keyData[1:]
 		d.Set("key", strings.Join(keyData, "/"))
 		d.Set("ttl", result.LeaseDuration)
+		if value, ok := result.Data.(string); ok {
+			d.Set("value", value)
+		}
 	} else {
 		return diag.Errorf("read operation: cypher not found in response data") // should not happen
 	}
@@ -132,14 +136,16 @@ func resourceCypherTFVarsRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceCypherTFVarsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	req := &morpheus.Request{}
 	tfvarsPath := fmt.Sprintf("tfvars/%s", d.Get("key").(string))
-	resp, err := client.DeleteCypher(tfvarsPath, req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCypher(tfvarsPath, req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -153,3 +159,14 @@ func resourceCypherTFVarsDelete(ctx context.Context, d *schema.ResourceData, met
 	d.SetId("")
 	return diags
 }
+
+// resourceCypherTFVarsImport imports a cypher by its key since the
+// cypher api is addressed by path rather than by id
+func resourceCypherTFVarsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	key := strings.TrimPrefix(d.Id(), "tfvars/")
+	if key == "" {
+		return nil, fmt.Errorf("the cypher key must be used as the import id")
+	}
+	d.Set("key", key)
+	return []*schema.ResourceData{d}, nil
+}
@@ -13,7 +13,7 @@ import (
 
 func resourceDelayedDeletePolicy() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus delayed delete policy resource",
+		Description:   "Provides a Morpheus delayed delete policy resource, keeping the instances deleted in its scope for a number of days before their final removal, during which they can be restored",
 		CreateContext: resourceDelayedDeletePolicyCreate,
 		ReadContext:   resourceDelayedDeletePolicyRead,
 		UpdateContext: resourceDelayedDeletePolicyUpdate,
@@ -43,9 +43,10 @@ func resourceDelayedDeletePolicy() *schema.Resource {
 				Default:     true,
 			},
 			"delete_days": {
-				Type:        schema.TypeInt,
-				Description: "The number of days to retain the instance",
-				Required:    true,
+				Type:         schema.TypeInt,
+				Description:  "The number of days to retain the instance before its final removal",
+				ValidateFunc: validation.IntAtLeast(1),
+				Required:     true,
 			},
 			"scope": {
 				Type:         schema.TypeString,
@@ -103,7 +104,7 @@ func resourceDelayedDeletePolicy() *schema.Resource {
 }
 
 func resourceDelayedDeletePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -173,7 +174,7 @@ func resourceDelayedDeletePolicyCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceDelayedDeletePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -203,6 +204,7 @@ func resourceDelayedDeletePolicyRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -212,7 +214,7 @@ func resourceDelayedDeletePolicyRead(ctx context.Context, d *schema.ResourceData
 	d.Set("name", delayedDeletePolicy.Name)
 	d.Set("description", delayedDeletePolicy.Description)
 	d.Set("enabled", delayedDeletePolicy.Enabled)
-	d.Set("delete_days", delayedDeletePolicy.Config.RemovalAge)
+	d.Set("delete_days", jsonInt64Value(delayedDeletePolicy.Config.RemovalAge))
 
 	switch delayedDeletePolicy.RefType {
 	case "ComputeSite":
@@ -245,7 +247,7 @@ func resourceDelayedDeletePolicyRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceDelayedDeletePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -296,7 +298,9 @@ func resourceDelayedDeletePolicyUpdate(ctx context.Context, d *schema.ResourceDa
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -312,14 +316,16 @@ func resourceDelayedDeletePolicyUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceDelayedDeletePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -19,6 +19,7 @@ func resourceDeleteApprovalPolicy() *schema.Resource {
 		ReadContext:   resourceDeleteApprovalPolicyRead,
 		UpdateContext: resourceDeleteApprovalPolicyUpdate,
 		DeleteContext: resourceDeleteApprovalPolicyDelete,
+		CustomizeDiff: approvalPolicyCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -50,18 +51,18 @@ func resourceDeleteApprovalPolicy() *schema.Resource {
 			},
 			"integration_id": {
 				Type:        schema.TypeInt,
-				Description: "The ID of the approval integration used for approvals",
+				Description: "The ID of the approval integration used for approvals, such as a ServiceNow integration, when the internal approvals are not used",
 				Optional:    true,
 			},
 			"workflow_id": {
 				Type:        schema.TypeInt,
-				Description: "The ID of the approval workflow used for approvals",
+				Description: "The ID of the workflow of the approval integration raising the approvals, such as a ServiceNow workflow",
 				Optional:    true,
 			},
 			"scope": {
 				Type:         schema.TypeString,
-				Description:  "The filter or scope that the policy is applied to (global, group, cloud, user, role)",
-				ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role"}, false),
+				Description:  "The filter or scope that the policy is applied to (global, group, cloud, user, role, tenant)",
+				ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role", "tenant"}, false),
 				Required:     true,
 				ForceNew:     true,
 			},
@@ -70,35 +71,42 @@ func resourceDeleteApprovalPolicy() *schema.Resource {
 				Description:   "The id of the group associated with the group scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "user_id", "role_id"},
+				ConflictsWith: []string{"cloud_id", "user_id", "role_id", "tenant_id"},
 			},
 			"cloud_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the cloud associated with the cloud scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"group_id", "user_id", "role_id"},
+				ConflictsWith: []string{"group_id", "user_id", "role_id", "tenant_id"},
 			},
 			"user_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the user associated with the user scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "group_id", "role_id"},
+				ConflictsWith: []string{"cloud_id", "group_id", "role_id", "tenant_id"},
 			},
 			"role_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the role associated with the role scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id", "tenant_id"},
 			},
 			"apply_to_each_user": {
 				Type:          schema.TypeBool,
-				Description:   "Whether to assign the policy at the individual user level to all users assigned the associated role",
+				Description:   "Whether to assign the policy at the individual user level to all users assigned the associated role or belonging to the associated tenant",
 				Optional:      true,
 				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
 			},
+			"tenant_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the tenant associated with the tenant scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id", "role_id"},
+			},
 			"tenant_ids": {
 				Type:        schema.TypeList,
 				Description: "A list of tenant IDs to assign the policy to",
@@ -114,7 +122,7 @@ func resourceDeleteApprovalPolicy() *schema.Resource {
 }
 
 func resourceDeleteApprovalPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -164,6 +172,13 @@ func resourceDeleteApprovalPolicyCreate(ctx context.Context, d *schema.ResourceD
 		policy["role"] = map[string]interface{}{
 			"id": d.Get("role_id").(int),
 		}
+	case "tenant":
+		policy["refId"] = d.Get("tenant_id").(int)
+		policy["refType"] = "Account"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["account"] = map[string]interface{}{
+			"id": d.Get("tenant_id").(int),
+		}
 	}
 
 	req := &morpheus.Request{
@@ -188,7 +203,7 @@ func resourceDeleteApprovalPolicyCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceDeleteApprovalPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -218,6 +233,7 @@ func resourceDeleteApprovalPolicyRead(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -252,6 +268,10 @@ func resourceDeleteApprovalPolicyRead(ctx context.Context, d *schema.ResourceDat
 		d.Set("scope", "role")
 		d.Set("role_id", deleteApprovalPolicy.Role.ID)
 		d.Set("apply_to_each_user", deleteApprovalPolicy.EachUser)
+	case "Account":
+		d.Set("scope", "tenant")
+		d.Set("tenant_id", deleteApprovalPolicy.RefID)
+		d.Set("apply_to_each_user", deleteApprovalPolicy.EachUser)
 	default:
 		d.Set("scope", "global")
 	}
@@ -269,7 +289,7 @@ func resourceDeleteApprovalPolicyRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceDeleteApprovalPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -317,6 +337,13 @@ func resourceDeleteApprovalPolicyUpdate(ctx context.Context, d *schema.ResourceD
 		policy["role"] = map[string]interface{}{
 			"id": d.Get("role_id").(int),
 		}
+	case "tenant":
+		policy["refId"] = d.Get("tenant_id").(int)
+		policy["refType"] = "Account"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["account"] = map[string]interface{}{
+			"id": d.Get("tenant_id").(int),
+		}
 	}
 
 	req := &morpheus.Request{
@@ -324,7 +351,9 @@ func resourceDeleteApprovalPolicyUpdate(ctx context.Context, d *schema.ResourceD
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -340,14 +369,16 @@ func resourceDeleteApprovalPolicyUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceDeleteApprovalPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -0,0 +1,45 @@
+package morpheus
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDockerCluster() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus docker cluster resource for provisioning a cluster of docker hosts",
+		CreateContext: resourceDockerClusterCreate,
+		ReadContext:   resourceDockerClusterRead,
+		UpdateContext: resourceDockerClusterUpdate,
+		DeleteContext: resourceDockerClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: hostClusterSchema("docker cluster"),
+		Importer: &schema.ResourceImporter{
+			StateContext: hostClusterImport,
+		},
+	}
+}
+
+func resourceDockerClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return hostClusterCreate(ctx, d, meta, "docker-cluster", "docker cluster")
+}
+
+func resourceDockerClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return hostClusterRead(ctx, d, meta, "docker cluster")
+}
+
+func resourceDockerClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return hostClusterUpdate(ctx, d, meta, "docker cluster")
+}
+
+func resourceDockerClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return hostClusterDelete(ctx, d, meta, "docker cluster")
+}
@@ -63,6 +63,7 @@ func resourceDockerRegistryIntegration() *schema.Resource {
 				},
 				DiffSuppressOnRefresh: true,
 			},
+			"force_delete": forceDeleteSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -71,7 +72,7 @@ func resourceDockerRegistryIntegration() *schema.Resource {
 }
 
 func resourceDockerRegistryIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -108,7 +109,7 @@ func resourceDockerRegistryIntegrationCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceDockerRegistryIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -138,6 +139,7 @@ func resourceDockerRegistryIntegrationRead(ctx context.Context, d *schema.Resour
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetIntegrationResult)
@@ -153,7 +155,7 @@ func resourceDockerRegistryIntegrationRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceDockerRegistryIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	integration := make(map[string]interface{})
@@ -171,7 +173,9 @@ func resourceDockerRegistryIntegrationUpdate(ctx context.Context, d *schema.Reso
 		},
 	}
 
-	resp, err := client.UpdateIntegration(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -187,14 +191,21 @@ func resourceDockerRegistryIntegrationUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceDockerRegistryIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteIntegration(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "integration", func() ([]string, error) {
+		return integrationDependents(meta, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
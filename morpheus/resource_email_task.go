@@ -9,6 +9,7 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceEmailTask() *schema.Resource {
@@ -115,45 +116,43 @@ func resourceEmailTask() *schema.Resource {
 				Optional:    true,
 				Default:     10,
 			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
 			"allow_custom_config": {
 				Type:        schema.TypeBool,
 				Description: "Custom configuration data to pass during the execution of the email task",
 				Optional:    true,
 				Default:     false,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the email task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
-func resourceEmailTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+// emailTaskSourceFile names the attributes the body of the email is stored
+// in, the url and the path in the repository having their own attributes
+var emailTaskSourceFile = sourceFileAttributes{
+	sourceType: "source",
+	content:    "content",
+	url:        "content_url",
+	path:       "content_path",
+}
 
+func resourceEmailTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
-	contentConfig := make(map[string]interface{})
-
-	switch d.Get("source") {
-	case "local":
-		contentConfig["sourceType"] = "local"
-		contentConfig["content"] = d.Get("content").(string)
-	case "url":
-		contentConfig["sourceType"] = "url"
-		contentConfig["contentPath"] = d.Get("content_url").(string)
-	case "repository":
-		contentConfig["sourceType"] = "repository"
-		repository := make(map[string]interface{})
-		repository["id"] = d.Get("repository_id")
-		contentConfig["contentPath"] = d.Get("content_path")
-		if d.Get("version_ref") != "" {
-			contentConfig["contentRef"] = d.Get("version_ref")
-		}
-		contentConfig["repository"] = repository
-	}
+	contentConfig := emailTaskSourceFile.payload(d)
 
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
@@ -161,6 +160,7 @@ func resourceEmailTaskCreate(ctx context.Context, d *schema.ResourceData, meta i
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -182,11 +182,12 @@ func resourceEmailTaskCreate(ctx context.Context, d *schema.ResourceData, meta i
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
 
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -203,8 +204,6 @@ func resourceEmailTaskCreate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceEmailTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -215,9 +214,9 @@ func resourceEmailTaskRead(ctx context.Context, d *schema.ResourceData, meta int
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -235,6 +234,8 @@ func resourceEmailTaskRead(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -246,18 +247,7 @@ func resourceEmailTaskRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("labels", emailTask.Labels)
 	d.Set("email_address", emailTask.TaskOptions.EmailAddress)
 	d.Set("subject", emailTask.TaskOptions.EmailSubject)
-	d.Set("source", emailTask.File.SourceType)
-	if emailTask.File.SourceType == "url" {
-		d.Set("content_url", emailTask.File.ContentPath)
-	}
-	if emailTask.File.SourceType == "repository" {
-		d.Set("content_path", emailTask.File.ContentPath)
-		d.Set("repository_id", emailTask.File.Repository.ID)
-		d.Set("version_ref", emailTask.File.ContentRef)
-	}
-	if emailTask.File.SourceType == "local" {
-		d.Set("content", emailTask.File.Content)
-	}
+	emailTaskSourceFile.set(d, SourceFile(emailTask.File))
 	if emailTask.TaskOptions.EmailSkipTemplate == "on" {
 		d.Set("skip_wrapped_email_template", true)
 	} else {
@@ -267,38 +257,21 @@ func resourceEmailTaskRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("retry_count", emailTask.RetryCount)
 	d.Set("retry_delay_seconds", emailTask.RetryDelaySeconds)
 	d.Set("allow_custom_config", emailTask.AllowCustomConfig)
+	d.Set("visibility", emailTask.Visibility)
 	return diags
 }
 
 func resourceEmailTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
-	contentConfig := make(map[string]interface{})
-
-	switch d.Get("source") {
-	case "local":
-		contentConfig["sourceType"] = "local"
-		contentConfig["content"] = d.Get("content").(string)
-	case "url":
-		contentConfig["sourceType"] = "url"
-		contentConfig["contentPath"] = d.Get("content_url").(string)
-	case "repository":
-		contentConfig["sourceType"] = "repository"
-		repository := make(map[string]interface{})
-		repository["id"] = d.Get("repository_id")
-		contentConfig["contentPath"] = d.Get("content_path")
-		if d.HasChange("version_ref") {
-			contentConfig["contentRef"] = d.Get("version_ref")
-		}
-		contentConfig["repository"] = repository
-	}
+	contentConfig := emailTaskSourceFile.payload(d)
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
 		for _, s := range attr.(*schema.Set).List() {
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"task": map[string]interface{}{
@@ -319,11 +292,14 @@ func resourceEmailTaskUpdate(ctx context.Context, d *schema.ResourceData, meta i
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
 
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -338,14 +314,14 @@ func resourceEmailTaskUpdate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceEmailTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -3,17 +3,19 @@ package morpheus
 import (
 	"context"
 	"encoding/json"
-
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceExecuteSchedule() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides an execution schedule resource",
+		Description:   "Provides an execution schedule resource, the cron schedule the task and workflow jobs are run on",
 		CreateContext: resourceExecuteScheduleCreate,
 		ReadContext:   resourceExecuteScheduleRead,
 		UpdateContext: resourceExecuteScheduleUpdate,
@@ -42,14 +44,16 @@ func resourceExecuteSchedule() *schema.Resource {
 				Default:     true,
 			},
 			"time_zone": {
-				Type:        schema.TypeString,
-				Description: "The time zone used for scheduling",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "The time zone used for scheduling (America/Denver, UTC, etc.)",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Required:     true,
 			},
 			"schedule": {
-				Type:        schema.TypeString,
-				Description: "The cron style syntax for the scheduled execution",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "The cron style syntax for the scheduled execution, the minute, hour, day of month, month and day of week fields",
+				ValidateFunc: validateCronExpression,
+				Required:     true,
 			},
 		},
 		Importer: &schema.ResourceImporter{
@@ -59,7 +63,7 @@ func resourceExecuteSchedule() *schema.Resource {
 }
 
 func resourceExecuteScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -95,7 +99,7 @@ func resourceExecuteScheduleCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceExecuteScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -125,6 +129,7 @@ func resourceExecuteScheduleRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var executeSchedule ExecuteSchedule
@@ -143,7 +148,7 @@ func resourceExecuteScheduleRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceExecuteScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	schedule := make(map[string]interface{})
@@ -161,7 +166,9 @@ func resourceExecuteScheduleUpdate(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	resp, err := client.UpdateExecuteSchedule(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateExecuteSchedule(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -177,14 +184,16 @@ func resourceExecuteScheduleUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceExecuteScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteExecuteSchedule(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteExecuteSchedule(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -199,6 +208,16 @@ func resourceExecuteScheduleDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+// validateCronExpression checks the schedule has the 5 fields of a cron
+// expression, the appliance rejecting the other expressions on save only
+func validateCronExpression(v interface{}, k string) (ws []string, errors []error) {
+	fields := strings.Fields(v.(string))
+	if len(fields) != 5 {
+		errors = append(errors, fmt.Errorf("%q must be a cron expression with 5 fields (minute, hour, day of month, month, day of week), got: %s", k, v.(string)))
+	}
+	return
+}
+
 type ExecuteSchedule struct {
 	Schedule struct {
 		ID               int    `json:"id"`
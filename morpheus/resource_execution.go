@@ -0,0 +1,295 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceExecution() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus execution resource that runs an operational workflow against an instance or server and waits for it to complete.",
+		CreateContext: resourceExecutionCreate,
+		ReadContext:   resourceExecutionRead,
+		DeleteContext: resourceExecutionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the process created by the workflow execution",
+				Computed:    true,
+			},
+			"workflow_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the operational workflow to execute",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"instance_id": {
+				Type:         schema.TypeInt,
+				Description:  "The id of the instance the workflow is executed against",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "server_id"},
+			},
+			"server_id": {
+				Type:         schema.TypeInt,
+				Description:  "The id of the server the workflow is executed against",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "server_id"},
+			},
+			"custom_options": {
+				Type:        schema.TypeMap,
+				Description: "Custom options to pass to the workflow",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "A map of arbitrary values that, when changed, will cause the workflow to be executed again",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the workflow execution",
+				Computed:    true,
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Description: "The output of the workflow execution",
+				Computed:    true,
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Description: "The error message of the workflow execution",
+				Computed:    true,
+			},
+			"start_date": {
+				Type:        schema.TypeString,
+				Description: "The date and time the workflow execution started",
+				Computed:    true,
+			},
+			"end_date": {
+				Type:        schema.TypeString,
+				Description: "The date and time the workflow execution ended",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	workflowId := d.Get("workflow_id").(int)
+
+	customOptions := make(map[string]interface{})
+	if d.Get("custom_options") != nil {
+		customOptionsInput := d.Get("custom_options").(map[string]interface{})
+		for key, value := range customOptionsInput {
+			customOptions[key] = value.(string)
+		}
+	}
+
+	var path string
+	if instanceId, ok := d.GetOk("instance_id"); ok {
+		path = fmt.Sprintf("%s/%d/workflow", morpheus.InstancesPath, instanceId.(int))
+	} else {
+		path = fmt.Sprintf("%s/%d/workflow", morpheus.HostsPath, d.Get("server_id").(int))
+	}
+
+	processId, err := executeWorkflow(ctx, client, path, workflowId, customOptions, d.Timeout(schema.TimeoutCreate))
+	if processId != 0 {
+		d.SetId(int64ToString(processId))
+	}
+	if err != nil {
+		if d.Id() != "" {
+			resourceExecutionRead(ctx, d, meta)
+		}
+		return diag.Errorf("error executing workflow: %s", err)
+	}
+
+	resourceExecutionRead(ctx, d, meta)
+	return diags
+}
+
+func resourceExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := getExecutionProcess(client, toInt64(id))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			// The process history may have been purged, the execution
+			// itself still happened so it should not be run again.
+			log.Printf("API 404: %s - %s", resp, err)
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*ExecutionProcessResult)
+	process := result.Process
+	if process != nil {
+		d.Set("status", process.Status)
+		d.Set("output", process.Output)
+		d.Set("error", process.Error)
+		d.Set("start_date", process.StartDate)
+		d.Set("end_date", process.EndDate)
+	} else {
+		return diag.Errorf("read operation: process not found in response data") // should not happen
+	}
+
+	return diags
+}
+
+func resourceExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// A workflow execution cannot be undone, only remove it from state
+	d.SetId("")
+	return diags
+}
+
+// executeWorkflow runs an operational workflow against the instance or server
+// addressed by path and waits for the resulting process to complete
+func executeWorkflow(ctx context.Context, client *apiClient, path string, workflowId int, customOptions map[string]interface{}, timeout time.Duration) (int64, error) {
+	if customOptions == nil {
+		customOptions = make(map[string]interface{})
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   path,
+		QueryParams: map[string]string{
+			"workflowId": intToString(workflowId),
+		},
+		Body: map[string]interface{}{
+			"taskSet": map[string]interface{}{
+				intToString(workflowId): map[string]interface{}{
+					"customOptions": customOptions,
+				},
+			},
+		},
+		Result: &morpheus.RunWorkflowOnInstanceResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return 0, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.RunWorkflowOnInstanceResult)
+	if result.ProcessId == 0 {
+		return 0, fmt.Errorf("workflow execution did not return a process id")
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending", "queued", "running"},
+		Target:  []string{"complete"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := getExecutionProcess(client, result.ProcessId)
+			if err != nil {
+				return "", "", err
+			}
+			process := resp.Result.(*ExecutionProcessResult).Process
+			if process == nil {
+				// the process is not in the response yet, the wait fails
+				// once it is still missing after the not found checks
+				return nil, "pending", nil
+			}
+			if process.Status == "failed" {
+				return process, process.Status, fmt.Errorf("workflow execution failed: %s", process.Error)
+			}
+			return process, process.Status, nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   5 * time.Second,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForStateContext(ctx)
+	return result.ProcessId, err
+}
+
+func getExecutionProcess(client *apiClient, id int64) (*morpheus.Response, error) {
+	return client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/processes/%d", id),
+		Result: &ExecutionProcessResult{},
+	})
+}
+
+type ExecutionProcessResult struct {
+	Process *ExecutionProcess `json:"process"`
+}
+
+type ExecutionProcess struct {
+	ID          int64  `json:"id"`
+	UniqueId    string `json:"uniqueId"`
+	ProcessType struct {
+		Code string `json:"code"`
+		Name string `json:"name"`
+	} `json:"processType"`
+	DisplayName string `json:"displayName"`
+	InstanceId  int64  `json:"instanceId"`
+	ServerId    int64  `json:"serverId"`
+	Status      string `json:"status"`
+	Reason      string `json:"reason"`
+	Message     string `json:"message"`
+	Output      string `json:"output"`
+	Error       string `json:"error"`
+	StartDate   string `json:"startDate"`
+	EndDate     string `json:"endDate"`
+	Duration    int64  `json:"duration"`
+}
+
+// executeTeardownWorkflow runs the operational workflow configured with
+// teardown_workflow_id or teardown_workflow_name against an instance and
+// waits for it to succeed
+func executeTeardownWorkflow(ctx context.Context, client *apiClient, d *schema.ResourceData, instanceId int64) error {
+	workflowId := d.Get("teardown_workflow_id").(int)
+	if name := d.Get("teardown_workflow_name").(string); name != "" {
+		resp, err := client.FindTaskSetByName(name)
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return fmt.Errorf("unable to find the workflow %s: %s", name, err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		workflowId = int(resp.Result.(*morpheus.GetTaskSetResult).TaskSet.ID)
+	}
+	if workflowId == 0 {
+		return nil
+	}
+	path := fmt.Sprintf("%s/%d/workflow", morpheus.InstancesPath, instanceId)
+	_, err := executeWorkflow(ctx, client, path, workflowId, nil, d.Timeout(schema.TimeoutDelete))
+	return err
+}
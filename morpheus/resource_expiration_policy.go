@@ -0,0 +1,20 @@
+package morpheus
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// expirationPolicy is the lifecycle policy deleting the instances once expired
+var expirationPolicy = lifecyclePolicy{
+	code:         "lifecycle",
+	name:         "Expiration",
+	configPrefix: "lifecycle",
+	noun:         "expiration",
+	outcome:      "expires and is deleted",
+	daysKey:      "expiration_days",
+	hideKey:      "hide_lifecycle_if_fixed",
+}
+
+func resourceExpirationPolicy() *schema.Resource {
+	return expirationPolicy.resource("Provides a Morpheus expiration policy resource, setting the number of days after which the provisioned instances expire and are deleted, along with the extensions of the expiration the owners can request")
+}
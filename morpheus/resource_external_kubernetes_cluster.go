@@ -0,0 +1,249 @@
+package morpheus
+
+import (
+	"context"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceExternalKubernetesCluster() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus external kubernetes cluster resource for registering an existing kubernetes cluster so workloads can be targeted at it. Destroying the resource only removes the cluster from Morpheus, the cluster and its workloads are left untouched.",
+		CreateContext: resourceExternalKubernetesClusterCreate,
+		ReadContext:   resourceExternalKubernetesClusterRead,
+		UpdateContext: resourceExternalKubernetesClusterUpdate,
+		DeleteContext: resourceExternalKubernetesClusterDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the external kubernetes cluster",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the external kubernetes cluster",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the external kubernetes cluster",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the group associated with the external kubernetes cluster",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud associated with the external kubernetes cluster",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cluster_layout_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the external kubernetes cluster layout",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"api_url": {
+				Type:         schema.TypeString,
+				Description:  "The url of the kubernetes API of the cluster, required when authenticating with a service account token",
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"service_token"},
+			},
+			"service_token": {
+				Type:         schema.TypeString,
+				Description:  "The service account token used to authenticate to the kubernetes API",
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"service_token", "kubeconfig"},
+			},
+			"kubeconfig": {
+				Type:         schema.TypeString,
+				Description:  "The kubeconfig used to authenticate to the kubernetes API",
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"service_token", "kubeconfig"},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the external kubernetes cluster",
+				Computed:    true,
+			},
+			"kubernetes_version": {
+				Type:        schema.TypeString,
+				Description: "The kubernetes version of the cluster",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceExternalKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	cluster := externalKubernetesClusterPayload(d)
+	cluster["type"] = "external-kubernetes-cluster"
+	cluster["group"] = map[string]interface{}{
+		"id": d.Get("group_id").(int),
+	}
+	cluster["cloud"] = map[string]interface{}{
+		"id": d.Get("cloud_id").(int),
+	}
+	cluster["layout"] = map[string]interface{}{
+		"id": d.Get("cluster_layout_id").(int),
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"cluster": cluster,
+		},
+	}
+
+	resp, err := client.CreateCluster(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateClusterResult)
+	if !result.Success {
+		return diag.Errorf("error creating external kubernetes cluster: %s", result.Message)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.Cluster.ID))
+
+	return resourceExternalKubernetesClusterRead(ctx, d, meta)
+}
+
+func resourceExternalKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetCluster(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetClusterResult)
+	cluster := result.Cluster
+	if cluster == nil {
+		return diag.Errorf("read operation: external kubernetes cluster not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(cluster.ID))
+	d.Set("name", cluster.Name)
+	d.Set("description", cluster.Description)
+	d.Set("group_id", cluster.Site.Id)
+	d.Set("cloud_id", cluster.Zone.Id)
+	d.Set("cluster_layout_id", cluster.Layout.Id)
+	d.Set("api_url", cluster.ServiceUrl)
+	d.Set("status", cluster.Status)
+	d.Set("kubernetes_version", cluster.ServiceVersion)
+	// the service token and the kubeconfig are not returned by the api, keep the configured values
+
+	return diags
+}
+
+func resourceExternalKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"cluster": externalKubernetesClusterPayload(d),
+		},
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateCluster(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.UpdateClusterResult)
+	if !result.Success {
+		return diag.Errorf("error updating external kubernetes cluster: %s", result.Message)
+	}
+	return resourceExternalKubernetesClusterRead(ctx, d, meta)
+}
+
+func resourceExternalKubernetesClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	// only unregister the cluster, its workloads are not managed by Morpheus
+	req := &morpheus.Request{
+		QueryParams: map[string]string{
+			"removeInstances": "off",
+			"removeResources": "off",
+		},
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCluster(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// externalKubernetesClusterPayload builds the updatable settings of an external kubernetes cluster
+func externalKubernetesClusterPayload(d *schema.ResourceData) map[string]interface{} {
+	cluster := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+	}
+	if apiUrl, ok := d.GetOk("api_url"); ok {
+		cluster["serviceUrl"] = apiUrl.(string)
+	}
+	if serviceToken, ok := d.GetOk("service_token"); ok {
+		cluster["serviceToken"] = serviceToken.(string)
+	}
+	if kubeconfig, ok := d.GetOk("kubeconfig"); ok {
+		cluster["serviceAccess"] = kubeconfig.(string)
+	}
+	return cluster
+}
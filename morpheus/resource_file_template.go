@@ -78,16 +78,16 @@ func resourceFileTemplate() *schema.Resource {
 				Description: "The file template setting category",
 				Optional:    true,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the file template is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceFileTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -99,6 +99,7 @@ func resourceFileTemplateCreate(ctx context.Context, d *schema.ResourceData, met
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -116,7 +117,7 @@ func resourceFileTemplateCreate(ctx context.Context, d *schema.ResourceData, met
 		},
 	}
 
-	resp, err := client.CreateFileTemplate(req)
+	resp, err := fileTemplateApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -133,8 +134,6 @@ func resourceFileTemplateCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceFileTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -145,9 +144,9 @@ func resourceFileTemplateRead(ctx context.Context, d *schema.ResourceData, meta
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindFileTemplateByName(name)
+		resp, err = fileTemplateApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetFileTemplate(toInt64(id), &morpheus.Request{})
+		resp, err = fileTemplateApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("File template cannot be read without name or id")
 	}
@@ -165,6 +164,8 @@ func resourceFileTemplateRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetFileTemplateResult)
@@ -183,7 +184,6 @@ func resourceFileTemplateRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceFileTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 
 	name := d.Get("name").(string)
@@ -194,6 +194,7 @@ func resourceFileTemplateUpdate(ctx context.Context, d *schema.ResourceData, met
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -211,7 +212,9 @@ func resourceFileTemplateUpdate(ctx context.Context, d *schema.ResourceData, met
 		},
 	}
 
-	resp, err := client.UpdateFileTemplate(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return fileTemplateApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -226,14 +229,14 @@ func resourceFileTemplateUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceFileTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteFileTemplate(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return fileTemplateApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
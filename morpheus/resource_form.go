@@ -239,12 +239,12 @@ func resourceForm() *schema.Resource {
 							Optional:    true,
 							Computed:    true,
 						},
-						"custom_data": {
+						"custom_data": jsonStringSchema(&schema.Schema{
 							Type:        schema.TypeString,
 							Description: "Custom JSON data payload to pass (Must be a JSON string)",
 							Optional:    true,
 							Computed:    true,
-						},
+						}),
 						"dependent_field": {
 							Type:        schema.TypeString,
 							Description: "The field or code used to trigger the reloading of the field",
@@ -552,12 +552,12 @@ func resourceForm() *schema.Resource {
 										Optional:    true,
 										Computed:    true,
 									},
-									"custom_data": {
+									"custom_data": jsonStringSchema(&schema.Schema{
 										Type:        schema.TypeString,
 										Description: "Custom JSON data payload to pass (Must be a JSON string)",
 										Optional:    true,
 										Computed:    true,
-									},
+									}),
 									"dependent_field": {
 										Type:        schema.TypeString,
 										Description: "The field or code used to trigger the reloading of the field",
@@ -650,7 +650,7 @@ func resourceForm() *schema.Resource {
 }
 
 func resourceFormCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -1086,6 +1086,7 @@ func resourceFormCreate(ctx context.Context, d *schema.ResourceData, meta interf
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -1117,7 +1118,7 @@ func resourceFormCreate(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourceFormRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -1148,6 +1149,7 @@ func resourceFormRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetFormResult)
@@ -1382,7 +1384,7 @@ func resourceFormRead(ctx context.Context, d *schema.ResourceData, meta interfac
 }
 
 func resourceFormUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	// create the payload for option types not in a field group
@@ -1812,6 +1814,7 @@ func resourceFormUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -1826,7 +1829,9 @@ func resourceFormUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		},
 	}
 
-	resp, err := client.UpdateForm(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateForm(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -1841,7 +1846,7 @@ func resourceFormUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourceFormDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -1876,7 +1881,9 @@ func resourceFormDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("The %s morpheus_form resource is currently associated with the following catalog items and must be disassociated before being deleted: %s", d.Get("name"), inUseCatalogItems)
 	}
 
-	resp, err := client.DeleteForm(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteForm(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
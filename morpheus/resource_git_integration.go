@@ -106,6 +106,7 @@ func resourceGitIntegration() *schema.Resource {
 				Description: "A map of git repository ids for use with integrations that reference a git repository",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			"force_delete": forceDeleteSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -114,7 +115,7 @@ func resourceGitIntegration() *schema.Resource {
 }
 
 func resourceGitIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	integration := make(map[string]interface{})
 
@@ -183,7 +184,7 @@ func resourceGitIntegrationCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -213,6 +214,7 @@ func resourceGitIntegrationRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetIntegrationResult)
@@ -252,7 +254,7 @@ func resourceGitIntegrationRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceGitIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	integration := make(map[string]interface{})
@@ -278,7 +280,9 @@ func resourceGitIntegrationUpdate(ctx context.Context, d *schema.ResourceData, m
 		},
 	}
 
-	resp, err := client.UpdateIntegration(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -294,14 +298,21 @@ func resourceGitIntegrationUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteIntegration(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "integration", func() ([]string, error) {
+		return integrationDependents(meta, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -108,39 +108,35 @@ func resourceGroovyScriptTask() *schema.Resource {
 				Optional:    true,
 				Default:     10,
 			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
 			"allow_custom_config": {
 				Type:        schema.TypeBool,
 				Description: "Custom configuration data to pass during the execution of the groovy script",
 				Optional:    true,
 				Default:     false,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the groovy script task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceGroovyScriptTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("script_content") != "" {
-		sourceOptions["content"] = d.Get("script_content")
-	}
-	if d.Get("script_path") != "" {
-		sourceOptions["contentPath"] = d.Get("script_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "script_content", "script_path")
 
 	taskType := make(map[string]interface{})
 	taskType["code"] = "groovyTask"
@@ -151,6 +147,7 @@ func resourceGroovyScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -166,10 +163,11 @@ func resourceGroovyScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -187,8 +185,6 @@ func resourceGroovyScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGroovyScriptTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -199,9 +195,9 @@ func resourceGroovyScriptTaskRead(ctx context.Context, d *schema.ResourceData, m
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -219,6 +215,8 @@ func resourceGroovyScriptTaskRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -228,34 +226,20 @@ func resourceGroovyScriptTaskRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("code", groovyScriptTask.Code)
 	d.Set("labels", groovyScriptTask.Labels)
 	d.Set("result_type", groovyScriptTask.ResultType)
-	d.Set("source_type", groovyScriptTask.File.SourceType)
-	d.Set("script_content", groovyScriptTask.File.Content)
-	d.Set("script_path", groovyScriptTask.File.ContentPath)
-	d.Set("version_ref", groovyScriptTask.File.ContentRef)
+	setSourceFile(d, SourceFile(groovyScriptTask.File), "script_content", "script_path")
 	d.Set("retryable", groovyScriptTask.Retryable)
 	d.Set("retry_count", groovyScriptTask.RetryCount)
 	d.Set("retry_delay_seconds", groovyScriptTask.RetryDelaySeconds)
 	d.Set("allow_custom_config", groovyScriptTask.AllowCustomConfig)
+	d.Set("visibility", groovyScriptTask.Visibility)
 	return diags
 }
 
 func resourceGroovyScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("script_content") != "" {
-		sourceOptions["content"] = d.Get("script_content")
-	}
-	if d.Get("script_path") != "" {
-		sourceOptions["contentPath"] = d.Get("script_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "script_content", "script_path")
 
 	taskType := make(map[string]interface{})
 	taskType["code"] = "groovyTask"
@@ -266,6 +250,7 @@ func resourceGroovyScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -281,10 +266,13 @@ func resourceGroovyScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -299,14 +287,14 @@ func resourceGroovyScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGroovyScriptTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -2,7 +2,9 @@ package morpheus
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -46,16 +48,42 @@ func resourceMorpheusGroup() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			"dns_integration_id": {
+				Description: "The id of the DNS integration used by default by the instances provisioned in the group",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"service_registry_id": {
+				Description: "The id of the service registry integration used by default by the instances provisioned in the group",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"config_management_id": {
+				Description: "The id of the configuration management integration, such as chef or puppet, used by default by the instances provisioned in the group",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"cmdb_id": {
+				Description: "The id of the CMDB integration used by default by the instances provisioned in the group",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"cmdb_discovery": {
+				Description: "Whether the servers discovered in the clouds of the group are registered in the CMDB",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"tenant_id": tenantIdSchema("The id of the tenant the group is created in, the tenant_id argument of the provider or the tenant of the user when not set. The clouds of the group must be visible to the tenant"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceMorpheusGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -86,11 +114,12 @@ func resourceMorpheusGroupCreate(ctx context.Context, d *schema.ResourceData, me
 				"name":     name,
 				"code":     code,
 				"location": location,
+				"config":   groupConfigPayload(d),
 			},
 		},
 	}
 
-	resp, err := client.CreateGroup(req)
+	resp, err := groupApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -111,7 +140,9 @@ func resourceMorpheusGroupCreate(ctx context.Context, d *schema.ResourceData, me
 			},
 		}
 
-		resp2, err2 := client.UpdateGroupClouds(group.ID, req2)
+		resp2, err2 := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+			return updateGroupClouds(meta, d, group.ID, req2)
+		})
 		if err2 != nil {
 			log.Printf("API FAILURE: %s - %s", resp2, err2)
 			return diag.FromErr(err2)
@@ -126,8 +157,6 @@ func resourceMorpheusGroupCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceMorpheusGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -138,9 +167,9 @@ func resourceMorpheusGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindGroupByName(name)
+		resp, err = groupApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetGroup(toInt64(id), &morpheus.Request{})
+		resp, err = groupApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Group cannot be read without name or id")
 	}
@@ -156,6 +185,8 @@ func resourceMorpheusGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetGroupResult)
@@ -172,6 +203,11 @@ func resourceMorpheusGroupRead(ctx context.Context, d *schema.ResourceData, meta
 			}
 		}
 		d.Set("cloud_ids", clouds)
+		d.Set("dns_integration_id", groupConfigId(group.Config.DNSIntegrationID))
+		d.Set("service_registry_id", groupConfigId(group.Config.ServiceRegistryID))
+		d.Set("config_management_id", groupConfigId(group.Config.ConfigManagementID))
+		d.Set("cmdb_id", groupConfigId(group.Config.ConfigCMDBID))
+		d.Set("cmdb_discovery", group.Config.ConfigCMDBDiscovery)
 	} else {
 		return diag.Errorf("Group not found in response data.") // should not happen
 	}
@@ -180,7 +216,6 @@ func resourceMorpheusGroupRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceMorpheusGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 	code := d.Get("code").(string)
@@ -193,11 +228,14 @@ func resourceMorpheusGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 				"name":     name,
 				"code":     code,
 				"location": location,
+				"config":   groupConfigPayload(d),
 			},
 		},
 	}
 
-	resp, err := client.UpdateGroup(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return groupApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -210,9 +248,10 @@ func resourceMorpheusGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 	// then the api expects it an array of objects, but only looks for id right now
 	// once api is better this should get simpler
 	doUpdateClouds := false
-	var clouds []map[string]interface{}
+	clouds := make([]map[string]interface{}, 0)
 	cloudIds := d.Get("cloud_ids").(*schema.Set).List()
-	if len(cloudIds) > 0 {
+	// the clouds are removed from the group when cloud_ids is emptied
+	if d.HasChange("cloud_ids") {
 		doUpdateClouds = true
 		for _, v := range cloudIds {
 			cloudPayload := map[string]interface{}{
@@ -230,7 +269,9 @@ func resourceMorpheusGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 			},
 		}
 
-		resp2, err2 := client.UpdateGroupClouds(group.ID, req2)
+		resp2, err2 := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+			return updateGroupClouds(meta, d, group.ID, req2)
+		})
 		if err2 != nil {
 			log.Printf("API FAILURE: %s - %s", resp2, err2)
 			return diag.FromErr(err2)
@@ -244,14 +285,14 @@ func resourceMorpheusGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceMorpheusGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteGroup(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return groupApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -265,3 +306,46 @@ func resourceMorpheusGroupDelete(ctx context.Context, d *schema.ResourceData, me
 	d.SetId("")
 	return diags
 }
+
+// groupConfigPayload returns the integrations used by default by the
+// instances provisioned in a group, an empty id clears the integration
+func groupConfigPayload(d *schema.ResourceData) map[string]interface{} {
+	config := map[string]interface{}{
+		"configCmdbDiscovery": d.Get("cmdb_discovery"),
+	}
+	for key, attribute := range map[string]string{
+		"dnsIntegrationId":   "dns_integration_id",
+		"serviceRegistryId":  "service_registry_id",
+		"configManagementId": "config_management_id",
+		"configCmdbId":       "cmdb_id",
+	} {
+		if id := d.Get(attribute).(int); id != 0 {
+			config[key] = strconv.Itoa(id)
+		} else {
+			config[key] = ""
+		}
+	}
+	return config
+}
+
+// groupConfigId returns the id of an integration of the config of a group,
+// the api returns the ids as strings
+func groupConfigId(id string) int {
+	value, err := strconv.Atoi(id)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// updateGroupClouds sets the clouds of a group in the tenant of the group
+func updateGroupClouds(meta interface{}, d *schema.ResourceData, id int64, req *morpheus.Request) (*morpheus.Response, error) {
+	return meta.(*providerMeta).client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%d/update-zones", morpheus.GroupsPath, id),
+		QueryParams: req.QueryParams,
+		Headers:     tenantHeaders(resourceTenantId(meta, d)),
+		Body:        req.Body,
+		Result:      &morpheus.StandardResult{},
+	})
+}
@@ -80,7 +80,7 @@ func resourceGuidanceSetting() *schema.Resource {
 }
 
 func resourceGuidanceSettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -133,7 +133,9 @@ func resourceGuidanceSettingCreate(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	resp, err := client.UpdateGuidanceSettings(req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateGuidanceSettings(req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -150,7 +152,7 @@ func resourceGuidanceSettingCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGuidanceSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -172,6 +174,7 @@ func resourceGuidanceSettingRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetGuidanceSettingsResult)
@@ -190,7 +193,7 @@ func resourceGuidanceSettingRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceGuidanceSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	guidanceSettings := make(map[string]interface{})
 
@@ -232,7 +235,9 @@ func resourceGuidanceSettingUpdate(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	resp, err := client.UpdateGuidanceSettings(req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateGuidanceSettings(req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -70,7 +70,7 @@ func resourceHelmAppBlueprint() *schema.Resource {
 }
 
 func resourceHelmAppBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -90,12 +90,7 @@ func resourceHelmAppBlueprintCreate(ctx context.Context, d *schema.ResourceData,
 	config["helm"] = helmConfig
 
 	helmConfig["configType"] = "git"
-	helmGitConfig := make(map[string]interface{})
-	helmGitConfig["integrationId"] = d.Get("integration_id")
-	helmGitConfig["repoId"] = d.Get("repository_id")
-	helmGitConfig["branch"] = d.Get("version_ref").(string)
-	helmGitConfig["path"] = d.Get("working_path").(string)
-	helmConfig["git"] = helmGitConfig
+	helmConfig["git"] = appBlueprintGitPayload(d)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -126,7 +121,7 @@ func resourceHelmAppBlueprintCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceHelmAppBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -158,6 +153,7 @@ func resourceHelmAppBlueprintRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var helmBlueprint HelmAppBlueprint
@@ -168,16 +164,13 @@ func resourceHelmAppBlueprintRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("name", helmBlueprint.Blueprint.Name)
 	d.Set("description", helmBlueprint.Blueprint.Description)
 	d.Set("category", helmBlueprint.Blueprint.Category)
-	d.Set("working_path", helmBlueprint.Blueprint.Config.Helm.Git.Path)
-	d.Set("integration_id", helmBlueprint.Blueprint.Config.Helm.Git.IntegrationId)
-	d.Set("repository_id", helmBlueprint.Blueprint.Config.Helm.Git.RepoId)
-	d.Set("version_ref", helmBlueprint.Blueprint.Config.Helm.Git.Branch)
+	setAppBlueprintGit(d, helmBlueprint.Blueprint.Config.Helm.Git)
 
 	return diags
 }
 
 func resourceHelmAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	blueprint_type := "helm"
@@ -194,12 +187,7 @@ func resourceHelmAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData,
 	config["helm"] = helmConfig
 
 	helmConfig["configType"] = "git"
-	helmGitConfig := make(map[string]interface{})
-	helmGitConfig["integrationId"] = d.Get("integration_id")
-	helmGitConfig["repoId"] = d.Get("repository_id")
-	helmGitConfig["branch"] = d.Get("version_ref").(string)
-	helmGitConfig["path"] = d.Get("working_path").(string)
-	helmConfig["git"] = helmGitConfig
+	helmConfig["git"] = appBlueprintGitPayload(d)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -213,7 +201,9 @@ func resourceHelmAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	resp, err := client.UpdateBlueprint(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBlueprint(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -228,14 +218,16 @@ func resourceHelmAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceHelmAppBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteBlueprint(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteBlueprint(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -261,13 +253,8 @@ type HelmAppBlueprint struct {
 			Name        string `json:"name"`
 			Description string `json:"description"`
 			Helm        struct {
-				Configtype string `json:"configType"`
-				Git        struct {
-					Path          string `json:"path"`
-					RepoId        int    `json:"repoId"`
-					IntegrationId int    `json:"integrationId"`
-					Branch        string `json:"branch"`
-				} `json:"git"`
+				Configtype string          `json:"configType"`
+				Git        appBlueprintGit `json:"git"`
 			} `json:"helm"`
 			Type     string `json:"type"`
 			Category string `json:"category"`
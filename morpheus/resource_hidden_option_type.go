@@ -96,16 +96,17 @@ func resourceHiddenOptionType() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"force_delete": forceDeleteSchema(),
+			"tenant_id":    tenantIdSchema("The id of the tenant the hidden option type is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceHiddenOptionTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -117,6 +118,7 @@ func resourceHiddenOptionTypeCreate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"optionType": map[string]interface{}{
@@ -136,7 +138,7 @@ func resourceHiddenOptionTypeCreate(ctx context.Context, d *schema.ResourceData,
 			},
 		},
 	}
-	resp, err := client.CreateOptionType(req)
+	resp, err := optionTypeApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -153,7 +155,6 @@ func resourceHiddenOptionTypeCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceHiddenOptionTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -164,9 +165,9 @@ func resourceHiddenOptionTypeRead(ctx context.Context, d *schema.ResourceData, m
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindOptionTypeByName(name)
+		resp, err = optionTypeApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetOptionType(toInt64(id), &morpheus.Request{})
+		resp, err = optionTypeApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("OptionType cannot be read without name or id")
 	}
@@ -184,6 +185,8 @@ func resourceHiddenOptionTypeRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetOptionTypeResult)
@@ -212,7 +215,6 @@ func resourceHiddenOptionTypeRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceHiddenOptionTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -222,6 +224,7 @@ func resourceHiddenOptionTypeUpdate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"optionType": map[string]interface{}{
@@ -241,7 +244,9 @@ func resourceHiddenOptionTypeUpdate(ctx context.Context, d *schema.ResourceData,
 			},
 		},
 	}
-	resp, err := client.UpdateOptionType(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return optionTypeApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -256,14 +261,19 @@ func resourceHiddenOptionTypeUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceHiddenOptionTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteOptionType(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "option type", func() ([]string, error) {
+		return optionTypeDependents(meta, d, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return optionTypeApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -53,8 +53,8 @@ func resourceHostNamePolicy() *schema.Resource {
 			},
 			"scope": {
 				Type:         schema.TypeString,
-				Description:  "The filter or scope that the policy is applied to (global, group, cloud, user, role)",
-				ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role"}, false),
+				Description:  "The filter or scope that the policy is applied to (global, group, cloud, user, role, tenant)",
+				ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role", "tenant"}, false),
 				Required:     true,
 				ForceNew:     true,
 			},
@@ -63,35 +63,42 @@ func resourceHostNamePolicy() *schema.Resource {
 				Description:   "The id of the group associated with the group scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "user_id", "role_id"},
+				ConflictsWith: []string{"cloud_id", "user_id", "role_id", "tenant_id"},
 			},
 			"cloud_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the cloud associated with the cloud scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"group_id", "user_id", "role_id"},
+				ConflictsWith: []string{"group_id", "user_id", "role_id", "tenant_id"},
 			},
 			"user_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the user associated with the user scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "group_id", "role_id"},
+				ConflictsWith: []string{"cloud_id", "group_id", "role_id", "tenant_id"},
 			},
 			"role_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the role associated with the role scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id", "tenant_id"},
 			},
 			"apply_to_each_user": {
 				Type:          schema.TypeBool,
-				Description:   "Whether to assign the policy at the individual user level to all users assigned the associated role",
+				Description:   "Whether to assign the policy at the individual user level to all users assigned the associated role or belonging to the associated tenant",
 				Optional:      true,
 				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
 			},
+			"tenant_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the tenant associated with the tenant scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id", "role_id"},
+			},
 			"tenant_ids": {
 				Type:        schema.TypeList,
 				Description: "A list of tenant IDs to assign the policy to",
@@ -107,7 +114,7 @@ func resourceHostNamePolicy() *schema.Resource {
 }
 
 func resourceHostNamePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -154,6 +161,13 @@ func resourceHostNamePolicyCreate(ctx context.Context, d *schema.ResourceData, m
 		policy["role"] = map[string]interface{}{
 			"id": d.Get("role_id").(int),
 		}
+	case "tenant":
+		policy["refId"] = d.Get("tenant_id").(int)
+		policy["refType"] = "Account"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["account"] = map[string]interface{}{
+			"id": d.Get("tenant_id").(int),
+		}
 	}
 
 	req := &morpheus.Request{
@@ -178,7 +192,7 @@ func resourceHostNamePolicyCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceHostNamePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -208,6 +222,7 @@ func resourceHostNamePolicyRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -233,6 +248,10 @@ func resourceHostNamePolicyRead(ctx context.Context, d *schema.ResourceData, met
 		d.Set("scope", "role")
 		d.Set("role_id", hostNamePolicy.Role.ID)
 		d.Set("apply_to_each_user", hostNamePolicy.EachUser)
+	case "Account":
+		d.Set("scope", "tenant")
+		d.Set("tenant_id", hostNamePolicy.RefID)
+		d.Set("apply_to_each_user", hostNamePolicy.EachUser)
 	default:
 		d.Set("scope", "global")
 	}
@@ -250,7 +269,7 @@ func resourceHostNamePolicyRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceHostNamePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -295,6 +314,13 @@ func resourceHostNamePolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 		policy["role"] = map[string]interface{}{
 			"id": d.Get("role_id").(int),
 		}
+	case "tenant":
+		policy["refId"] = d.Get("tenant_id").(int)
+		policy["refType"] = "Account"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["account"] = map[string]interface{}{
+			"id": d.Get("tenant_id").(int),
+		}
 	}
 
 	req := &morpheus.Request{
@@ -302,7 +328,9 @@ func resourceHostNamePolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -318,14 +346,16 @@ func resourceHostNamePolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceHostNamePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -0,0 +1,546 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// imageBuildsPath is the API endpoint of the image builder
+const imageBuildsPath = "/api/image-builds"
+
+func resourceImageBuild() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus image build resource for building virtual images with the image builder, from a source image customized with a boot script and a preseed script",
+		CreateContext: resourceImageBuildCreate,
+		ReadContext:   resourceImageBuildRead,
+		UpdateContext: resourceImageBuildUpdate,
+		DeleteContext: resourceImageBuildDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+			Update: schema.DefaultTimeout(2 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the image build",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the image build",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the image build",
+				Optional:    true,
+				Computed:    true,
+			},
+			"image_build_type": {
+				Type:        schema.TypeString,
+				Description: "The code of the type of the image build (vmware, ...)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the group the build instance is provisioned to",
+				Required:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the target cloud the image is built in",
+				Required:    true,
+			},
+			"plan_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the service plan of the build instance",
+				Required:    true,
+			},
+			"source_image_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the virtual image the build starts from",
+				Required:    true,
+			},
+			"network_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the network the build instance is attached to",
+				Optional:    true,
+			},
+			"resource_pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the resource pool the build instance is provisioned to",
+				Optional:    true,
+			},
+			"boot_script_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the boot script run when the build instance boots",
+				Optional:    true,
+			},
+			"preseed_script_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the preseed script used for the unattended installation",
+				Optional:    true,
+			},
+			"ssh_username": {
+				Type:        schema.TypeString,
+				Description: "The username Morpheus uses to connect to the build instance",
+				Optional:    true,
+			},
+			"ssh_password": {
+				Type:        schema.TypeString,
+				Description: "The password Morpheus uses to connect to the build instance",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"storage_provider_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the storage provider the built images are saved to",
+				Optional:    true,
+			},
+			"build_output_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the built virtual images, the name of the image build is used when not set",
+				Optional:    true,
+			},
+			"conversion_formats": {
+				Type:        schema.TypeString,
+				Description: "The comma separated list of formats the built image is converted to (ovf, qcow2, vhd, ...)",
+				Optional:    true,
+			},
+			"is_cloud_init": {
+				Type:        schema.TypeBool,
+				Description: "Whether the built image supports cloud-init",
+				Optional:    true,
+			},
+			"keep_results": {
+				Type:        schema.TypeInt,
+				Description: "The number of built images to keep, older ones are deleted, 0 keeps every image",
+				Optional:    true,
+			},
+			"execution_schedule_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the execution schedule the image is built on, the image is only built on demand when not set",
+				Optional:    true,
+			},
+			"build_triggers": {
+				Type:        schema.TypeMap,
+				Description: "A map of arbitrary values that, when set on create or changed, trigger a new build of the image",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_build": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait for the triggered builds to complete, the wait is bounded by the create and update timeouts",
+				Optional:    true,
+				Default:     true,
+			},
+			"latest_build_status": {
+				Type:        schema.TypeString,
+				Description: "The status of the latest build",
+				Computed:    true,
+			},
+			"latest_image_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the virtual image produced by the latest successful build",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImageBuildImport,
+		},
+	}
+}
+
+func resourceImageBuildCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	imageBuild := imageBuildPayload(d)
+	imageBuild["type"] = d.Get("image_build_type").(string)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   imageBuildsPath,
+		Body: map[string]interface{}{
+			"imageBuild": imageBuild,
+		},
+		Result: &ImageBuildResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*ImageBuildResult)
+	if !result.Success {
+		return diag.Errorf("error creating image build: %s", result.Message)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.ImageBuild.ID))
+
+	if len(d.Get("build_triggers").(map[string]interface{})) > 0 {
+		if err := runImageBuild(ctx, client, result.ImageBuild.ID, d.Get("wait_for_build").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error building image: %s", err)
+		}
+	}
+
+	return resourceImageBuildRead(ctx, d, meta)
+}
+
+func resourceImageBuildRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%d", imageBuildsPath, toInt64(id)),
+		Result: &ImageBuildResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	imageBuild := resp.Result.(*ImageBuildResult).ImageBuild
+	if imageBuild == nil {
+		return diag.Errorf("read operation: image build not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(imageBuild.ID))
+	d.Set("name", imageBuild.Name)
+	d.Set("description", imageBuild.Description)
+	d.Set("image_build_type", imageBuild.Type.Code)
+	d.Set("group_id", imageBuild.Site.ID)
+	d.Set("cloud_id", imageBuild.Zone.ID)
+	d.Set("plan_id", imageBuild.Config.PlanId)
+	d.Set("source_image_id", imageBuild.Config.SourceImage)
+	d.Set("network_id", imageBuild.Config.NetworkId)
+	d.Set("resource_pool_id", imageBuild.Config.ResourcePoolId)
+	d.Set("boot_script_id", imageBuild.BootScript.ID)
+	d.Set("preseed_script_id", imageBuild.PreseedScript.ID)
+	d.Set("ssh_username", imageBuild.SshUsername)
+	// the ssh password is not returned by the api, keep the configured value
+	d.Set("storage_provider_id", imageBuild.StorageProvider.ID)
+	d.Set("build_output_name", imageBuild.BuildOutputName)
+	d.Set("conversion_formats", imageBuild.ConversionFormats)
+	d.Set("is_cloud_init", imageBuild.IsCloudInit)
+	d.Set("keep_results", imageBuild.KeepResults)
+	d.Set("execution_schedule_id", imageBuild.ExecuteSchedule.ID)
+
+	execution, err := getLatestImageBuildExecution(client, imageBuild.ID, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if execution != nil {
+		d.Set("latest_build_status", execution.Status)
+	}
+	successfulExecution, err := getLatestImageBuildExecution(client, imageBuild.ID, "complete")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if successfulExecution != nil && len(successfulExecution.VirtualImages) > 0 {
+		d.Set("latest_image_id", successfulExecution.VirtualImages[0].ID)
+	}
+
+	return diags
+}
+
+func resourceImageBuildUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method: "PUT",
+			Path:   fmt.Sprintf("%s/%d", imageBuildsPath, toInt64(id)),
+			Body: map[string]interface{}{
+				"imageBuild": imageBuildPayload(d),
+			},
+			Result: &ImageBuildResult{},
+		})
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*ImageBuildResult)
+	if !result.Success {
+		return diag.Errorf("error updating image build: %s", result.Message)
+	}
+
+	if d.HasChange("build_triggers") && len(d.Get("build_triggers").(map[string]interface{})) > 0 {
+		if err := runImageBuild(ctx, client, toInt64(id), d.Get("wait_for_build").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error building image: %s", err)
+		}
+	}
+
+	return resourceImageBuildRead(ctx, d, meta)
+}
+
+func resourceImageBuildDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("%s/%d", imageBuildsPath, toInt64(id)),
+	}
+	if USE_FORCE {
+		req.QueryParams = map[string]string{
+			"force": "true",
+		}
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// resourceImageBuildImport sets the defaults of the attributes not returned by the api on import
+func resourceImageBuildImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("wait_for_build", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+// imageBuildPayload builds the updatable settings of an image build
+func imageBuildPayload(d *schema.ResourceData) map[string]interface{} {
+	config := map[string]interface{}{
+		"planId":      d.Get("plan_id").(int),
+		"sourceImage": d.Get("source_image_id").(int),
+	}
+	if networkId := d.Get("network_id").(int); networkId != 0 {
+		config["networkId"] = networkId
+	}
+	if resourcePoolId := d.Get("resource_pool_id").(int); resourcePoolId != 0 {
+		config["resourcePoolId"] = resourcePoolId
+	}
+
+	imageBuild := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"group": map[string]interface{}{
+			"id": d.Get("group_id").(int),
+		},
+		"cloud": map[string]interface{}{
+			"id": d.Get("cloud_id").(int),
+		},
+		"config":            config,
+		"sshUsername":       d.Get("ssh_username").(string),
+		"buildOutputName":   d.Get("build_output_name").(string),
+		"conversionFormats": d.Get("conversion_formats").(string),
+		"isCloudInit":       d.Get("is_cloud_init").(bool),
+		"keepResults":       d.Get("keep_results").(int),
+	}
+	if d.HasChange("ssh_password") {
+		imageBuild["sshPassword"] = d.Get("ssh_password").(string)
+	}
+	if bootScriptId := d.Get("boot_script_id").(int); bootScriptId != 0 {
+		imageBuild["bootScript"] = map[string]interface{}{
+			"id": bootScriptId,
+		}
+	}
+	if preseedScriptId := d.Get("preseed_script_id").(int); preseedScriptId != 0 {
+		imageBuild["preseedScript"] = map[string]interface{}{
+			"id": preseedScriptId,
+		}
+	}
+	if storageProviderId := d.Get("storage_provider_id").(int); storageProviderId != 0 {
+		imageBuild["storageProvider"] = map[string]interface{}{
+			"id": storageProviderId,
+		}
+	}
+	if executionScheduleId := d.Get("execution_schedule_id").(int); executionScheduleId != 0 {
+		imageBuild["executeSchedule"] = map[string]interface{}{
+			"id": executionScheduleId,
+		}
+	} else {
+		imageBuild["executeSchedule"] = nil
+	}
+	return imageBuild
+}
+
+// runImageBuild triggers a build of the image and optionally waits for it to
+// complete, the executions that existed before the build was triggered being
+// ignored
+func runImageBuild(ctx context.Context, client *apiClient, id int64, wait bool, timeout time.Duration) error {
+	var previousId int64
+	if wait {
+		previous, err := getLatestImageBuildExecution(client, id, "")
+		if err != nil {
+			return err
+		}
+		if previous != nil {
+			previousId = previous.ID
+		}
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("%s/%d/run", imageBuildsPath, id),
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	if !wait {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"complete"},
+		Refresh: func() (interface{}, string, error) {
+			execution, err := getLatestImageBuildExecution(client, id, "")
+			if err != nil {
+				return "", "", err
+			}
+			if execution == nil || execution.ID <= previousId {
+				return "", "pending", nil
+			}
+			switch execution.Status {
+			case "complete":
+				return execution, execution.Status, nil
+			case "failed":
+				return execution, execution.Status, fmt.Errorf("image build failed: %s", execution.StatusMessage)
+			}
+			// queued, running, ...
+			return execution, "pending", nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   30 * time.Second,
+		Delay:        30 * time.Second,
+		PollInterval: 1 * time.Minute,
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// getLatestImageBuildExecution returns the most recent execution of an image
+// build, optionally only among the executions with the given status
+func getLatestImageBuildExecution(client *apiClient, id int64, status string) (*ImageBuildExecution, error) {
+	queryParams := map[string]string{
+		"max":       "1",
+		"sort":      "id",
+		"direction": "desc",
+	}
+	if status != "" {
+		queryParams["status"] = status
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%d/executions", imageBuildsPath, id),
+		QueryParams: queryParams,
+		Result:      &ListImageBuildExecutionsResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	executions := resp.Result.(*ListImageBuildExecutionsResult).Executions
+	if len(executions) == 0 {
+		return nil, nil
+	}
+	return &executions[0], nil
+}
+
+type ImageBuildResult struct {
+	Success    bool              `json:"success"`
+	Message    string            `json:"msg"`
+	Errors     map[string]string `json:"errors"`
+	ImageBuild *ImageBuild       `json:"imageBuild"`
+}
+
+type ImageBuild struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        struct {
+		Code string `json:"code"`
+	} `json:"type"`
+	Site struct {
+		ID int64 `json:"id"`
+	} `json:"site"`
+	Zone struct {
+		ID int64 `json:"id"`
+	} `json:"zone"`
+	Config struct {
+		PlanId         int64 `json:"planId"`
+		SourceImage    int64 `json:"sourceImage"`
+		NetworkId      int64 `json:"networkId"`
+		ResourcePoolId int64 `json:"resourcePoolId"`
+	} `json:"config"`
+	BootScript struct {
+		ID int64 `json:"id"`
+	} `json:"bootScript"`
+	PreseedScript struct {
+		ID int64 `json:"id"`
+	} `json:"preseedScript"`
+	SshUsername     string `json:"sshUsername"`
+	StorageProvider struct {
+		ID int64 `json:"id"`
+	} `json:"storageProvider"`
+	BuildOutputName   string `json:"buildOutputName"`
+	ConversionFormats string `json:"conversionFormats"`
+	IsCloudInit       bool   `json:"isCloudInit"`
+	KeepResults       int64  `json:"keepResults"`
+	ExecuteSchedule   struct {
+		ID int64 `json:"id"`
+	} `json:"executeSchedule"`
+}
+
+type ListImageBuildExecutionsResult struct {
+	Executions []ImageBuildExecution `json:"executions"`
+}
+
+type ImageBuildExecution struct {
+	ID            int64  `json:"id"`
+	Status        string `json:"status"`
+	StatusMessage string `json:"statusMessage"`
+	VirtualImages []struct {
+		ID int64 `json:"id"`
+	} `json:"virtualImages"`
+}
@@ -0,0 +1,284 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// instanceActionTargetStatus is the status of the instance once an action
+// interrupting it completes
+var instanceActionTargetStatus = map[string]string{
+	"start":   "running",
+	"stop":    "stopped",
+	"restart": "running",
+	"suspend": "suspended",
+}
+
+// instanceRestartStatuses are the statuses an instance goes through once a
+// restart begins, the instance still being running until then
+var instanceRestartStatuses = []string{"restarting", "stopping", "stopped", "starting"}
+
+// instanceStatuses are the statuses an instance goes through while an action
+// runs
+var instanceStatuses = []string{"pending", "provisioning", "starting", "stopping", "restarting", "suspending", "resuming", "running", "stopped", "suspended", "warning"}
+
+func resourceInstanceAction() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus instance action resource that runs a day-2 operation, such as a restart or a workflow, against an instance when the resource is created or replaced. The actions interrupting the instance must be allowed explicitly so an unintended plan does not bounce an instance.",
+		CreateContext: resourceInstanceActionCreate,
+		ReadContext:   resourceInstanceActionRead,
+		UpdateContext: resourceInstanceActionUpdate,
+		DeleteContext: resourceInstanceActionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the instance action",
+				Computed:    true,
+			},
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the instance the action runs against",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Description:  "The action to run against the instance (start, stop, restart, suspend, lock, unlock, run_workflow)",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"start", "stop", "restart", "suspend", "lock", "unlock", "run_workflow"}, false),
+			},
+			"workflow_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the operational workflow to execute, required by the run_workflow action",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"custom_options": {
+				Type:        schema.TypeMap,
+				Description: "Custom options to pass to the workflow",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "A map of arbitrary values that, when changed, will cause the action to run again",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allow_disruptive": {
+				Type:        schema.TypeBool,
+				Description: "Whether the stop, restart and suspend actions, which interrupt the instance, are allowed to run. The plan fails when such an action is about to run without it",
+				Optional:    true,
+				Default:     false,
+			},
+			"confirm_instance_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the instance the action is meant for, the action fails without running when the instance has another name",
+				Optional:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the instance",
+				Computed:    true,
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "Whether the instance is locked",
+				Computed:    true,
+			},
+		},
+		CustomizeDiff: resourceInstanceActionCustomizeDiff,
+	}
+}
+
+// resourceInstanceActionCustomizeDiff checks the action about to run, the
+// resource has no id when it is created or replaced
+func resourceInstanceActionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	action := d.Get("action").(string)
+	if _, ok := instanceActionTargetStatus[action]; ok && action != "start" && !d.Get("allow_disruptive").(bool) {
+		return fmt.Errorf("the %s action interrupts the instance, set allow_disruptive to true to run it", action)
+	}
+	workflowId, ok := d.GetOk("workflow_id")
+	if action == "run_workflow" && !ok && d.NewValueKnown("workflow_id") {
+		return fmt.Errorf("workflow_id is required by the run_workflow action")
+	}
+	if action != "run_workflow" && ok && workflowId.(int) != 0 {
+		return fmt.Errorf("workflow_id is only used by the run_workflow action")
+	}
+	return nil
+}
+
+func resourceInstanceActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	instanceId := int64(d.Get("instance_id").(int))
+	action := d.Get("action").(string)
+
+	if name := d.Get("confirm_instance_name").(string); name != "" {
+		resp, err := client.GetInstance(instanceId, &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		instance := resp.Result.(*morpheus.GetInstanceResult).Instance
+		if instance.Name != name {
+			return diag.Errorf("the instance %d is named %q but the action is meant for %q, the %s action was not run", instanceId, instance.Name, name, action)
+		}
+	}
+
+	req := &morpheus.Request{}
+	var resp *morpheus.Response
+	var err error
+	switch action {
+	case "start":
+		resp, err = client.StartInstance(instanceId, req)
+	case "stop":
+		resp, err = client.StopInstance(instanceId, req)
+	case "restart":
+		resp, err = client.RestartInstance(instanceId, req)
+	case "suspend":
+		resp, err = client.SuspendInstance(instanceId, req)
+	case "lock":
+		resp, err = client.LockInstance(instanceId, req)
+	case "unlock":
+		resp, err = client.UnlockInstance(instanceId, req)
+	case "run_workflow":
+		customOptions := make(map[string]interface{})
+		for key, value := range d.Get("custom_options").(map[string]interface{}) {
+			customOptions[key] = value.(string)
+		}
+		path := fmt.Sprintf("%s/%d/workflow", morpheus.InstancesPath, instanceId)
+		if _, err := executeWorkflow(ctx, client, path, d.Get("workflow_id").(int), customOptions, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error executing workflow: %s", err)
+		}
+	}
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	if resp != nil {
+		log.Printf("API RESPONSE: %s", resp)
+	}
+
+	if action == "restart" {
+		if err := waitForInstanceStatus(ctx, client, instanceId, instanceRestartStatuses, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error running the %s action: %s", action, err)
+		}
+	}
+	if target, ok := instanceActionTargetStatus[action]; ok {
+		if err := waitForInstanceStatus(ctx, client, instanceId, []string{target}, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error running the %s action: %s", action, err)
+		}
+	}
+
+	d.SetId(id.UniqueId())
+
+	resourceInstanceActionRead(ctx, d, meta)
+	return diags
+}
+
+func resourceInstanceActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.GetInstance(int64(d.Get("instance_id").(int)), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			// The action itself still happened so it should not be run
+			// again against a deleted instance.
+			log.Printf("API 404: %s - %s", resp, err)
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	instance := resp.Result.(*morpheus.GetInstanceResult).Instance
+	if instance != nil {
+		d.Set("status", instance.Status)
+		d.Set("locked", instance.Locked)
+	} else {
+		return diag.Errorf("read operation: instance not found in response data") // should not happen
+	}
+
+	return diags
+}
+
+func resourceInstanceActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only the guards can change without running the action again
+	return resourceInstanceActionRead(ctx, d, meta)
+}
+
+func resourceInstanceActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// An instance action cannot be undone, only remove it from state
+	d.SetId("")
+	return diags
+}
+
+// waitForInstanceStatus waits for an instance to reach one of the target
+// statuses once an action interrupting it was requested
+func waitForInstanceStatus(ctx context.Context, client *apiClient, instanceId int64, targets []string, timeout time.Duration) error {
+	var pending []string
+	for _, status := range instanceStatuses {
+		if !slices.Contains(targets, status) {
+			pending = append(pending, status)
+		}
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  targets,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetInstance(instanceId, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			instance := resp.Result.(*morpheus.GetInstanceResult).Instance
+			if instance.Status == "failed" {
+				return instance, instance.Status, fmt.Errorf("the instance failed")
+			}
+			return instance, instance.Status, nil
+		},
+		// the instance is polled from the start, the transition of a
+		// restart being missed otherwise
+		Timeout:      timeout,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+
+	// Wait, catching any errors
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
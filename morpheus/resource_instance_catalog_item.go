@@ -3,13 +3,13 @@ package morpheus
 import (
 	"context"
 	"encoding/json"
-	"os"
 	"strings"
 
 	"log"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -73,12 +73,11 @@ func resourceInstanceCatalogItem() *schema.Resource {
 					return strings.TrimSuffix(val.(string), "\n")
 				},
 			},
-			"config": {
-				Type:             schema.TypeString,
-				Description:      "The instance config associated with the instance catalog item",
-				Required:         true,
-				DiffSuppressFunc: suppressEquivalentJsonDiffs,
-			},
+			"config": jsonStringSchema(&schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The instance config associated with the instance catalog item",
+				Required:    true,
+			}),
 			"option_type_ids": {
 				Type:        schema.TypeList,
 				Description: "The list of option type ids associated with the instance catalog item",
@@ -96,6 +95,8 @@ func resourceInstanceCatalogItem() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"option_type_ids"},
 			},
+			"option_type":         catalogItemOptionTypeSchema(),
+			"form_field_override": catalogItemFormFieldOverrideSchema(),
 			"image_name": {
 				Type:        schema.TypeString,
 				Description: "The file name of the instance catalog item logo image",
@@ -103,30 +104,58 @@ func resourceInstanceCatalogItem() *schema.Resource {
 				Computed:    true,
 			},
 			"image_path": {
+				Type:             schema.TypeString,
+				Description:      "The file path of the instance catalog item logo image including the file name",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressImportedLogoPath("image_name"),
+			},
+			"image_content": catalogItemLogoContentSchema("The content of the instance catalog item logo image, base64 encoded as returned by filebase64() or raw, instead of the file path", "image_name", "image_path", "image_content"),
+			"dark_logo_image_name": {
 				Type:        schema.TypeString,
-				Description: "The file path of the instance catalog item logo image including the file name",
+				Description: "The file name of the instance catalog item dark mode logo image",
 				Optional:    true,
 				Computed:    true,
 			},
+			"dark_logo_image_path": {
+				Type:             schema.TypeString,
+				Description:      "The file path of the instance catalog item dark mode logo image including the file name",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressImportedLogoPath("dark_logo_image_name"),
+			},
+			"dark_logo_image_content":  catalogItemLogoContentSchema("The content of the instance catalog item dark mode logo image, base64 encoded as returned by filebase64() or raw, instead of the file path", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content"),
+			"image_checksum":           logoChecksumSchema("The checksum of the uploaded instance catalog item logo image, a change of the content of the image uploads it again"),
+			"dark_logo_image_checksum": logoChecksumSchema("The checksum of the uploaded instance catalog item dark mode logo image, a change of the content of the image uploads it again"),
 			"visibility": {
 				Type:         schema.TypeString,
 				Description:  "The visibility of the instance catalog item (public or private)",
 				Required:     true,
 				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the instance catalog item is created in, the tenant_id argument of the provider or the tenant of the user when not set. The option types and forms referenced by the catalog item must be visible to the tenant"),
 		},
+		CustomizeDiff: customdiff.All(
+			catalogItemReferencesCustomizeDiff("option_type_ids", "form_id"),
+			logoChecksumCustomizeDiff("image_checksum", "image_name", "image_path", "image_content"),
+			logoChecksumCustomizeDiff("dark_logo_image_checksum", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content"),
+			tenantIdCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	ownedOptionTypeIds, _, err := syncCatalogItemOptionTypes(ctx, meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	catalogItem := make(map[string]interface{})
 
 	catalogItem["name"] = d.Get("name").(string)
@@ -135,7 +164,7 @@ func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 	catalogItem["enabled"] = d.Get("enabled").(bool)
 	catalogItem["featured"] = d.Get("featured").(bool)
 	catalogItem["type"] = "instance"
-	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	catalogItem["optionTypes"] = catalogItemOptionTypeIds(d, ownedOptionTypeIds)
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -154,6 +183,7 @@ func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	catalogItem["labels"] = labelsPayload
 
 	if d.Get("form_id").(int) > 0 {
@@ -161,6 +191,7 @@ func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 		catalogItem["form"] = map[string]interface{}{
 			"id": d.Get("form_id").(int),
 		}
+		catalogItem["formConfig"] = catalogItemFormConfigPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -168,9 +199,12 @@ func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 			"catalogItemType": catalogItem,
 		},
 	}
-	resp, err := client.CreateCatalogItem(req)
+	resp, err := catalogItemApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
+		if err := deleteCatalogItemOptionTypes(ctx, meta, d, ownedOptionTypeIds); err != nil {
+			log.Printf("unable to delete the option types of the catalog item: %s", err)
+		}
 		return diag.FromErr(err)
 	}
 	log.Printf("API RESPONSE: %s", resp)
@@ -178,20 +212,27 @@ func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 	result := resp.Result.(*morpheus.CreateCatalogItemResult)
 	catalogItemResult := result.CatalogItem
 
-	if d.Get("image_path") != "" && d.Get("image_name") != "" {
-		data, err := os.ReadFile(d.Get("image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	var filePayloads []*morpheus.FilePayload
 
-		var filePayloads []*morpheus.FilePayload
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
-		response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
+	logo, err := catalogItemLogoFile(d, "logo", "image_name", "image_path", "image_content")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if logo != nil {
+		filePayloads = append(filePayloads, logo)
+	}
+	darkLogo, err := catalogItemLogoFile(d, "darkLogo", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if darkLogo != nil {
+		filePayloads = append(filePayloads, darkLogo)
+	}
+
+	if len(filePayloads) > 0 {
+		response, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+			return catalogItemApi.updateLogo(meta, d, catalogItemResult.ID, filePayloads)
+		})
 		if err != nil {
 			log.Printf("API FAILURE: %s - %s", response, err)
 			return diag.FromErr(err)
@@ -207,7 +248,7 @@ func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceInstanceCatalogItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -218,9 +259,9 @@ func resourceInstanceCatalogItemRead(ctx context.Context, d *schema.ResourceData
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindCatalogItemByName(name)
+		resp, err = catalogItemApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetCatalogItem(toInt64(id), &morpheus.Request{})
+		resp, err = catalogItemApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Catalog Item cannot be read without name or id")
 	}
@@ -237,6 +278,8 @@ func resourceInstanceCatalogItemRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 	// store resource data
 	result := resp.Result.(*morpheus.GetCatalogItemResult)
 	catalogItem := result.CatalogItem
@@ -248,17 +291,13 @@ func resourceInstanceCatalogItemRead(ctx context.Context, d *schema.ResourceData
 	d.Set("enabled", catalogItem.Enabled)
 	d.Set("featured", catalogItem.Featured)
 	// option types
-	var optionTypes []int64
-	if catalogItem.OptionTypes != nil {
-		// iterate over the array of tasks
-		for i := 0; i < len(catalogItem.OptionTypes); i++ {
-			option := catalogItem.OptionTypes[i].(map[string]interface{})
-			optionID := int64(option["id"].(float64))
-			optionTypes = append(optionTypes, optionID)
-		}
+	optionTypes, err := readCatalogItemOptionTypes(meta, d, catalogItemAttachedOptionTypeIds(catalogItem))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	d.Set("option_type_ids", optionTypes)
 	d.Set("form_id", catalogItem.Form.ID)
+	d.Set("form_field_override", parseCatalogItemFormConfig(catalogItem.FormConfig))
 	d.Set("content", catalogItem.Content)
 	configJson, _ := json.Marshal(catalogItem.Config.(map[string]interface{}))
 	d.Set("config", string(configJson))
@@ -267,13 +306,22 @@ func resourceInstanceCatalogItemRead(ctx context.Context, d *schema.ResourceData
 	imagePath := strings.Split(catalogItem.ImagePath, "/")
 	opt := strings.Replace(imagePath[len(imagePath)-1], "_original", "", 1)
 	d.Set("image_name", opt)
+	readLogoChecksum(client, d, "image_checksum", catalogItem.ImagePath)
+	darkImagePath := strings.Split(catalogItem.DarkImagePath, "/")
+	darkOpt := strings.Replace(darkImagePath[len(darkImagePath)-1], "_original", "", 1)
+	d.Set("dark_logo_image_name", darkOpt)
+	readLogoChecksum(client, d, "dark_logo_image_checksum", catalogItem.DarkImagePath)
 	return diags
 }
 
 func resourceInstanceCatalogItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 
+	ownedOptionTypeIds, removedOptionTypeIds, err := syncCatalogItemOptionTypes(ctx, meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	catalogItem := make(map[string]interface{})
 
 	catalogItem["name"] = d.Get("name").(string)
@@ -282,7 +330,7 @@ func resourceInstanceCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 	catalogItem["enabled"] = d.Get("enabled").(bool)
 	catalogItem["featured"] = d.Get("featured").(bool)
 	catalogItem["type"] = "instance"
-	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	catalogItem["optionTypes"] = catalogItemOptionTypeIds(d, ownedOptionTypeIds)
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -301,6 +349,7 @@ func resourceInstanceCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	catalogItem["labels"] = labelsPayload
 
 	if d.Get("form_id").(int) > 0 {
@@ -308,6 +357,7 @@ func resourceInstanceCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 		catalogItem["form"] = map[string]interface{}{
 			"id": d.Get("form_id").(int),
 		}
+		catalogItem["formConfig"] = catalogItemFormConfigPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -316,29 +366,47 @@ func resourceInstanceCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 		},
 	}
 
-	resp, err := client.UpdateCatalogItem(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return catalogItemApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
 	}
 	log.Printf("API RESPONSE: %s", resp)
+
+	// the option types of the removed blocks are no longer used by the catalog item
+	if err := deleteCatalogItemOptionTypes(ctx, meta, d, removedOptionTypeIds); err != nil {
+		return diag.FromErr(err)
+	}
 	result := resp.Result.(*morpheus.UpdateCatalogItemResult)
 	catalogItemResult := result.CatalogItem
 
-	if d.HasChange("image_name") || d.HasChange("image_path") {
-		data, err := os.ReadFile(d.Get("image_path").(string))
+	var filePayloads []*morpheus.FilePayload
+
+	if d.HasChange("image_name") || d.HasChange("image_path") || d.HasChange("image_content") || d.HasChange("image_checksum") {
+		logo, err := catalogItemLogoFile(d, "logo", "image_name", "image_path", "image_content")
 		if err != nil {
 			return diag.FromErr(err)
 		}
-
-		var filePayloads []*morpheus.FilePayload
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("image_name").(string),
-			FileContent:   data,
+		if logo != nil {
+			filePayloads = append(filePayloads, logo)
+		}
+	}
+	if d.HasChange("dark_logo_image_path") || d.HasChange("dark_logo_image_name") || d.HasChange("dark_logo_image_content") || d.HasChange("dark_logo_image_checksum") {
+		darkLogo, err := catalogItemLogoFile(d, "darkLogo", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content")
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		filePayloads = append(filePayloads, filePayload)
-		if _, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{}); err != nil {
+		if darkLogo != nil {
+			filePayloads = append(filePayloads, darkLogo)
+		}
+	}
+
+	if len(filePayloads) > 0 {
+		if _, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+			return catalogItemApi.updateLogo(meta, d, catalogItemResult.ID, filePayloads)
+		}); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -350,14 +418,14 @@ func resourceInstanceCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceInstanceCatalogItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteCatalogItem(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return catalogItemApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -368,6 +436,9 @@ func resourceInstanceCatalogItemDelete(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	if err := deleteCatalogItemOptionTypes(ctx, meta, d, ownedCatalogItemOptionTypeIds(d)); err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId("")
 	return diags
 }
@@ -186,7 +186,7 @@ func resourceInstanceLayout() *schema.Resource {
 }
 
 func resourceInstanceLayoutCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -220,6 +220,7 @@ func resourceInstanceLayoutCreate(ctx context.Context, d *schema.ResourceData, m
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	instanceLayout["labels"] = labelsPayload
 
 	// priceSets
@@ -258,7 +259,7 @@ func resourceInstanceLayoutCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceInstanceLayoutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -290,6 +291,7 @@ func resourceInstanceLayoutRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	//log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var instanceLayout InstanceLayoutPayload
@@ -387,7 +389,7 @@ func resourceInstanceLayoutRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceInstanceLayoutUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	instanceLayout := make(map[string]interface{})
@@ -419,6 +421,7 @@ func resourceInstanceLayoutUpdate(ctx context.Context, d *schema.ResourceData, m
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	instanceLayout["labels"] = labelsPayload
 
 	// priceSets
@@ -440,7 +443,9 @@ func resourceInstanceLayoutUpdate(ctx context.Context, d *schema.ResourceData, m
 		},
 	}
 
-	resp, err := client.UpdateInstanceLayout(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateInstanceLayout(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -455,14 +460,16 @@ func resourceInstanceLayoutUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceInstanceLayoutDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteInstanceLayout(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteInstanceLayout(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
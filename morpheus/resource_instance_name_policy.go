@@ -56,12 +56,13 @@ func resourceInstanceNamePolicy() *schema.Resource {
 			"auto_resolve_conflicts": {
 				Type:        schema.TypeBool,
 				Description: "Whether to automatically resolve naming conflicts",
-				Required:    true,
+				Optional:    true,
+				Default:     false,
 			},
 			"scope": {
 				Type:         schema.TypeString,
-				Description:  "The filter or scope that the policy is applied to (global, group, cloud, user, role)",
-				ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role"}, false),
+				Description:  "The filter or scope that the policy is applied to (global, group, cloud, user, role, tenant)",
+				ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role", "tenant"}, false),
 				Required:     true,
 				ForceNew:     true,
 			},
@@ -70,35 +71,42 @@ func resourceInstanceNamePolicy() *schema.Resource {
 				Description:   "The id of the group associated with the group scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "user_id", "role_id"},
+				ConflictsWith: []string{"cloud_id", "user_id", "role_id", "tenant_id"},
 			},
 			"cloud_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the cloud associated with the cloud scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"group_id", "user_id", "role_id"},
+				ConflictsWith: []string{"group_id", "user_id", "role_id", "tenant_id"},
 			},
 			"user_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the user associated with the user scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "group_id", "role_id"},
+				ConflictsWith: []string{"cloud_id", "group_id", "role_id", "tenant_id"},
 			},
 			"role_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the role associated with the role scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id", "tenant_id"},
 			},
 			"apply_to_each_user": {
 				Type:          schema.TypeBool,
-				Description:   "Whether to assign the policy at the individual user level to all users assigned the associated role",
+				Description:   "Whether to assign the policy at the individual user level to all users assigned the associated role or belonging to the associated tenant",
 				Optional:      true,
 				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
 			},
+			"tenant_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the tenant associated with the tenant scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id", "role_id"},
+			},
 			"tenant_ids": {
 				Type:        schema.TypeList,
 				Description: "A list of tenant IDs to assign the policy to",
@@ -114,7 +122,7 @@ func resourceInstanceNamePolicy() *schema.Resource {
 }
 
 func resourceInstanceNamePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -168,6 +176,13 @@ func resourceInstanceNamePolicyCreate(ctx context.Context, d *schema.ResourceDat
 		policy["role"] = map[string]interface{}{
 			"id": d.Get("role_id").(int),
 		}
+	case "tenant":
+		policy["refId"] = d.Get("tenant_id").(int)
+		policy["refType"] = "Account"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["account"] = map[string]interface{}{
+			"id": d.Get("tenant_id").(int),
+		}
 	}
 
 	req := &morpheus.Request{
@@ -192,7 +207,7 @@ func resourceInstanceNamePolicyCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceInstanceNamePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -222,6 +237,7 @@ func resourceInstanceNamePolicyRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -232,7 +248,7 @@ func resourceInstanceNamePolicyRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("description", instanceNamePolicy.Description)
 	d.Set("enabled", instanceNamePolicy.Enabled)
 	d.Set("enforcement_type", instanceNamePolicy.Config.NamingType)
-	d.Set("auto_resolve_conflicts", instanceNamePolicy.Config.NamingConflict)
+	d.Set("auto_resolve_conflicts", instanceNamePolicy.Config.NamingConflict == "on")
 	d.Set("naming_pattern", instanceNamePolicy.Config.NamingPattern)
 	switch instanceNamePolicy.RefType {
 	case "ComputeSite":
@@ -248,6 +264,10 @@ func resourceInstanceNamePolicyRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("scope", "role")
 		d.Set("role_id", instanceNamePolicy.Role.ID)
 		d.Set("apply_to_each_user", instanceNamePolicy.EachUser)
+	case "Account":
+		d.Set("scope", "tenant")
+		d.Set("tenant_id", instanceNamePolicy.RefID)
+		d.Set("apply_to_each_user", instanceNamePolicy.EachUser)
 	default:
 		d.Set("scope", "global")
 	}
@@ -265,7 +285,7 @@ func resourceInstanceNamePolicyRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceInstanceNamePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -317,6 +337,13 @@ func resourceInstanceNamePolicyUpdate(ctx context.Context, d *schema.ResourceDat
 		policy["role"] = map[string]interface{}{
 			"id": d.Get("role_id").(int),
 		}
+	case "tenant":
+		policy["refId"] = d.Get("tenant_id").(int)
+		policy["refType"] = "Account"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["account"] = map[string]interface{}{
+			"id": d.Get("tenant_id").(int),
+		}
 	}
 
 	req := &morpheus.Request{
@@ -324,7 +351,9 @@ func resourceInstanceNamePolicyUpdate(ctx context.Context, d *schema.ResourceDat
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -340,14 +369,16 @@ func resourceInstanceNamePolicyUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceInstanceNamePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
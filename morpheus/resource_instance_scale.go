@@ -0,0 +1,464 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceInstanceScale() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus instance scale resource for managing the horizontal scaling thresholds of an instance, or of a layout for the instances provisioned with it. Destroying the resource disables automatic scaling on the instance or layout.",
+		CreateContext: resourceInstanceScaleCreate,
+		ReadContext:   resourceInstanceScaleRead,
+		UpdateContext: resourceInstanceScaleUpdate,
+		DeleteContext: resourceInstanceScaleDelete,
+		CustomizeDiff: resourceInstanceScaleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the instance or layout",
+				Computed:    true,
+			},
+			"instance_id": {
+				Type:         schema.TypeInt,
+				Description:  "The id of the instance to configure scaling for",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "layout_id"},
+			},
+			"layout_id": {
+				Type:         schema.TypeInt,
+				Description:  "The id of the layout to configure scaling for, the instances provisioned with the layout using its thresholds",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "layout_id"},
+			},
+			"scale_threshold_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the shared scale threshold attached to the instance or layout, its settings are applied to every threshold attribute not set in the configuration and updating it updates the instances and layouts it is attached to",
+				Optional:    true,
+			},
+			"auto_upscale": {
+				Type:        schema.TypeBool,
+				Description: "Whether to scale up the number of nodes",
+				Optional:    true,
+				Computed:    true,
+			},
+			"auto_downscale": {
+				Type:        schema.TypeBool,
+				Description: "Whether to scale down the number of nodes",
+				Optional:    true,
+				Computed:    true,
+			},
+			"min_count": {
+				Type:        schema.TypeInt,
+				Description: "The minimum number of nodes to scale down to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"max_count": {
+				Type:        schema.TypeInt,
+				Description: "The maximum number of nodes to scale up to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"scale_increment": {
+				Type:        schema.TypeInt,
+				Description: "The number of nodes added or removed by each scaling operation",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enable_cpu_threshold": {
+				Type:        schema.TypeBool,
+				Description: "Whether scaling operations based upon cpu usage is enabled or not",
+				Optional:    true,
+				Computed:    true,
+			},
+			"min_cpu_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The minimum cpu percentage for scaling",
+				Optional:    true,
+				Computed:    true,
+			},
+			"max_cpu_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The maximum cpu percentage for scaling",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enable_memory_threshold": {
+				Type:        schema.TypeBool,
+				Description: "Whether scaling operations based upon memory usage is enabled or not",
+				Optional:    true,
+				Computed:    true,
+			},
+			"min_memory_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The minimum memory percentage for scaling",
+				Optional:    true,
+				Computed:    true,
+			},
+			"max_memory_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The maximum memory percentage for scaling",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enable_disk_threshold": {
+				Type:        schema.TypeBool,
+				Description: "Whether scaling operations based upon disk usage is enabled or not",
+				Optional:    true,
+				Computed:    true,
+			},
+			"min_disk_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The minimum disk percentage for scaling",
+				Optional:    true,
+				Computed:    true,
+			},
+			"max_disk_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The maximum disk percentage for scaling",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInstanceScaleImport,
+		},
+	}
+}
+
+// instanceScaleAttributes maps the threshold attributes to their api field names
+var instanceScaleAttributes = map[string]string{
+	"auto_upscale":            "autoUp",
+	"auto_downscale":          "autoDown",
+	"min_count":               "minCount",
+	"max_count":               "maxCount",
+	"scale_increment":         "scaleIncrement",
+	"enable_cpu_threshold":    "cpuEnabled",
+	"min_cpu_percentage":      "minCpu",
+	"max_cpu_percentage":      "maxCpu",
+	"enable_memory_threshold": "memoryEnabled",
+	"min_memory_percentage":   "minMemory",
+	"max_memory_percentage":   "maxMemory",
+	"enable_disk_threshold":   "diskEnabled",
+	"min_disk_percentage":     "minDisk",
+	"max_disk_percentage":     "maxDisk",
+}
+
+func resourceInstanceScaleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	id := int64(d.Get("instance_id").(int))
+	if layoutId, ok := d.GetOk("layout_id"); ok {
+		id = int64(layoutId.(int))
+	}
+	if err := updateInstanceThreshold(ctx, client, d, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(id))
+	return resourceInstanceScaleRead(ctx, d, meta)
+}
+
+func resourceInstanceScaleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	var resp *morpheus.Response
+	var err error
+	if _, ok := d.GetOk("layout_id"); ok {
+		resp, err = client.Execute(&morpheus.Request{
+			Method: "GET",
+			Path:   fmt.Sprintf("%s/%d", morpheus.InstanceLayoutsPath, toInt64(id)),
+			Result: &InstanceLayoutThresholdResult{},
+		})
+	} else {
+		resp, err = client.Execute(&morpheus.Request{
+			Method: "GET",
+			Path:   fmt.Sprintf("%s/%d/threshold", morpheus.InstancesPath, toInt64(id)),
+			Result: &InstanceThresholdResult{},
+		})
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	var threshold *morpheus.ScaleThreshold
+	switch result := resp.Result.(type) {
+	case *InstanceThresholdResult:
+		threshold = result.InstanceThreshold
+		d.Set("instance_id", toInt64(id))
+	case *InstanceLayoutThresholdResult:
+		if result.InstanceTypeLayout == nil {
+			return diag.Errorf("read operation: layout not found in response data") // should not happen
+		}
+		if !result.InstanceTypeLayout.HasAutoScale {
+			log.Printf("Scaling disabled on layout %s, forcing recreation of resource", id)
+			d.SetId("")
+			return diags
+		}
+		threshold = result.InstanceTypeLayout.ScaleThreshold
+		d.Set("layout_id", toInt64(id))
+	}
+	if threshold != nil {
+		d.Set("auto_upscale", threshold.AutoUp)
+		d.Set("auto_downscale", threshold.AutoDown)
+		d.Set("min_count", threshold.MinCount)
+		d.Set("max_count", threshold.MaxCount)
+		d.Set("scale_increment", threshold.ScaleIncrement)
+		d.Set("enable_cpu_threshold", threshold.CpuEnabled)
+		d.Set("min_cpu_percentage", threshold.MinCpu)
+		d.Set("max_cpu_percentage", threshold.MaxCpu)
+		d.Set("enable_memory_threshold", threshold.MemoryEnabled)
+		d.Set("min_memory_percentage", threshold.MinMemory)
+		d.Set("max_memory_percentage", threshold.MaxMemory)
+		d.Set("enable_disk_threshold", threshold.DiskEnabled)
+		d.Set("min_disk_percentage", threshold.MinDisk)
+		d.Set("max_disk_percentage", threshold.MaxDisk)
+	} else {
+		return diag.Errorf("read operation: scale threshold not found in response data") // should not happen
+	}
+
+	return diags
+}
+
+func resourceInstanceScaleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	if err := updateInstanceThreshold(ctx, client, d, toInt64(d.Id())); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceInstanceScaleRead(ctx, d, meta)
+}
+
+func resourceInstanceScaleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	// The threshold belongs to the instance and cannot be removed,
+	// turn off automatic scaling instead
+	req := &morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("%s/%d/threshold", morpheus.InstancesPath, toInt64(id)),
+		Body: map[string]interface{}{
+			"instanceThreshold": map[string]interface{}{
+				"autoUp":   false,
+				"autoDown": false,
+			},
+		},
+		Result: &InstanceThresholdResult{},
+	}
+	if _, ok := d.GetOk("layout_id"); ok {
+		req = &morpheus.Request{
+			Method: "PUT",
+			Path:   fmt.Sprintf("%s/%d", morpheus.InstanceLayoutsPath, toInt64(id)),
+			Body: map[string]interface{}{
+				"instanceTypeLayout": map[string]interface{}{
+					"hasAutoScale": false,
+				},
+			},
+			Result: &InstanceLayoutThresholdResult{},
+		}
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// resourceInstanceScaleImport imports the thresholds of an instance using its
+// id, or the thresholds of a layout using the layout:<layout_id> format
+func resourceInstanceScaleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if layoutId, ok := strings.CutPrefix(d.Id(), "layout:"); ok {
+		if _, err := strconv.Atoi(layoutId); err != nil {
+			return nil, fmt.Errorf("invalid layout id %s: %s", layoutId, err)
+		}
+		d.Set("layout_id", toInt64(layoutId))
+		d.SetId(layoutId)
+		return []*schema.ResourceData{d}, nil
+	}
+	d.Set("instance_id", toInt64(d.Id()))
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateInstanceThreshold saves the scaling thresholds of an instance or layout, starting
+// from the referenced scale threshold and applying the configured attributes on top
+func updateInstanceThreshold(ctx context.Context, client *apiClient, d *schema.ResourceData, id int64) error {
+	threshold := make(map[string]interface{})
+
+	if scaleThresholdId, ok := d.GetOk("scale_threshold_id"); ok {
+		settings, err := scaleThresholdSettings(client, scaleThresholdId.(int))
+		if err != nil {
+			return err
+		}
+		for attribute, value := range settings {
+			threshold[instanceScaleAttributes[attribute]] = value
+		}
+	}
+
+	config := d.GetRawConfig()
+	for attribute, field := range instanceScaleAttributes {
+		if !config.GetAttr(attribute).IsNull() {
+			threshold[field] = d.Get(attribute)
+		}
+	}
+
+	req := &morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("%s/%d/threshold", morpheus.InstancesPath, id),
+		Body: map[string]interface{}{
+			"instanceThreshold": threshold,
+		},
+		Result: &InstanceThresholdResult{},
+	}
+	if _, ok := d.GetOk("layout_id"); ok {
+		// the layout enables scaling for the instances provisioned with it
+		req = &morpheus.Request{
+			Method: "PUT",
+			Path:   fmt.Sprintf("%s/%d", morpheus.InstanceLayoutsPath, id),
+			Body: map[string]interface{}{
+				"instanceTypeLayout": map[string]interface{}{
+					"hasAutoScale":   true,
+					"scaleThreshold": threshold,
+				},
+			},
+			Result: &InstanceLayoutThresholdResult{},
+		}
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	switch result := resp.Result.(type) {
+	case *InstanceThresholdResult:
+		if !result.Success && result.Message != "" {
+			return fmt.Errorf("error updating instance threshold: %s", result.Message)
+		}
+	case *InstanceLayoutThresholdResult:
+		if !result.Success && result.Message != "" {
+			return fmt.Errorf("error updating layout threshold: %s", result.Message)
+		}
+	}
+	return nil
+}
+
+// scaleThresholdSettings returns the settings of a scale threshold, keyed by
+// the threshold attributes of the instance scale resource
+func scaleThresholdSettings(client *apiClient, scaleThresholdId int) (map[string]interface{}, error) {
+	resp, err := client.GetScaleThreshold(int64(scaleThresholdId), &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	scaleThreshold := resp.Result.(*morpheus.GetScaleThresholdResult).ScaleThreshold
+	if scaleThreshold == nil {
+		return nil, fmt.Errorf("scale threshold %d not found", scaleThresholdId)
+	}
+	return map[string]interface{}{
+		"auto_upscale":            scaleThreshold.AutoUp,
+		"auto_downscale":          scaleThreshold.AutoDown,
+		"min_count":               int(scaleThreshold.MinCount),
+		"max_count":               int(scaleThreshold.MaxCount),
+		"scale_increment":         int(scaleThreshold.ScaleIncrement),
+		"enable_cpu_threshold":    scaleThreshold.CpuEnabled,
+		"min_cpu_percentage":      scaleThreshold.MinCpu,
+		"max_cpu_percentage":      scaleThreshold.MaxCpu,
+		"enable_memory_threshold": scaleThreshold.MemoryEnabled,
+		"min_memory_percentage":   scaleThreshold.MinMemory,
+		"max_memory_percentage":   scaleThreshold.MaxMemory,
+		"enable_disk_threshold":   scaleThreshold.DiskEnabled,
+		"min_disk_percentage":     scaleThreshold.MinDisk,
+		"max_disk_percentage":     scaleThreshold.MaxDisk,
+	}, nil
+}
+
+// resourceInstanceScaleCustomizeDiff plans the settings of the shared scale
+// threshold attached with scale_threshold_id for the threshold attributes not
+// set in the configuration, so updating the shared threshold propagates its
+// settings to every instance and layout it is attached to
+func resourceInstanceScaleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	scaleThresholdId, ok := d.GetOk("scale_threshold_id")
+	if !ok || d.Id() == "" {
+		return nil
+	}
+	client, ok := metaClient(meta)
+	if !ok {
+		return nil
+	}
+	settings, err := scaleThresholdSettings(client, scaleThresholdId.(int))
+	if err != nil {
+		return err
+	}
+	config := d.GetRawConfig()
+	for attribute, value := range settings {
+		if config.GetAttr(attribute).IsNull() && d.Get(attribute) != value {
+			if err := d.SetNew(attribute, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type InstanceThresholdResult struct {
+	Success           bool                     `json:"success"`
+	Message           string                   `json:"msg"`
+	InstanceThreshold *morpheus.ScaleThreshold `json:"instanceThreshold"`
+}
+
+type InstanceLayoutThresholdResult struct {
+	Success            bool   `json:"success"`
+	Message            string `json:"msg"`
+	InstanceTypeLayout *struct {
+		ID             int64                    `json:"id"`
+		HasAutoScale   bool                     `json:"hasAutoScale"`
+		ScaleThreshold *morpheus.ScaleThreshold `json:"scaleThreshold"`
+	} `json:"instanceTypeLayout"`
+}
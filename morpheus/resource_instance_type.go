@@ -69,6 +69,7 @@ func resourceInstanceType() *schema.Resource {
 				Description: "The file path of the instance type logo image including the file name",
 				Optional:    true,
 			},
+			"image_checksum": logoChecksumSchema("The checksum of the uploaded instance type logo image, a change of the content of the image uploads it again"),
 			"environment_prefix": {
 				Type:        schema.TypeString,
 				Description: "The prefix used for instance environment variables",
@@ -169,6 +170,7 @@ func resourceInstanceType() *schema.Resource {
 				Computed: true,
 			},
 		},
+		CustomizeDiff: logoChecksumCustomizeDiff("image_checksum", "image_name", "image_path", ""),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -176,7 +178,7 @@ func resourceInstanceType() *schema.Resource {
 }
 
 func resourceInstanceTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -189,6 +191,7 @@ func resourceInstanceTypeCreate(ctx context.Context, d *schema.ResourceData, met
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	// priceSets
 	var priceSets []map[string]interface{}
@@ -246,7 +249,9 @@ func resourceInstanceTypeCreate(ctx context.Context, d *schema.ResourceData, met
 			FileContent:   data,
 		}
 		filePayloads = append(filePayloads, filePayload)
-		response, err := client.UpdateInstanceTypeLogo(instanceType.ID, filePayloads, &morpheus.Request{})
+		response, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+			return client.UpdateInstanceTypeLogo(instanceType.ID, filePayloads, &morpheus.Request{})
+		})
 		if err != nil {
 			log.Printf("API FAILURE: %s - %s", response, err)
 			return diag.FromErr(err)
@@ -262,7 +267,7 @@ func resourceInstanceTypeCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceInstanceTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -294,6 +299,7 @@ func resourceInstanceTypeRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	//log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var instanceTypePayload InstanceTypePayload
@@ -309,6 +315,7 @@ func resourceInstanceTypeRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("category", instanceTypePayload.InstanceType.Category)
 	d.Set("visibility", instanceTypePayload.InstanceType.Visibility)
 	d.Set("environment_prefix", instanceTypePayload.InstanceType.EnvironmentPrefix)
+	readLogoChecksum(client, d, "image_checksum", instanceTypePayload.InstanceType.ImagePath)
 	d.Set("enable_settings", instanceTypePayload.InstanceType.HasSettings)
 	d.Set("enable_scaling", instanceTypePayload.InstanceType.HasAutoscale)
 	d.Set("enable_deployments", instanceTypePayload.InstanceType.HasDeployment)
@@ -361,7 +368,7 @@ func resourceInstanceTypeRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceInstanceTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	name := d.Get("name").(string)
@@ -372,6 +379,7 @@ func resourceInstanceTypeUpdate(ctx context.Context, d *schema.ResourceData, met
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	// priceSets
 	var priceSets []map[string]interface{}
@@ -406,7 +414,9 @@ func resourceInstanceTypeUpdate(ctx context.Context, d *schema.ResourceData, met
 		},
 	}
 
-	resp, err := client.UpdateInstanceType(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateInstanceType(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -415,7 +425,7 @@ func resourceInstanceTypeUpdate(ctx context.Context, d *schema.ResourceData, met
 	result := resp.Result.(*morpheus.UpdateInstanceTypeResult)
 	instanceType := result.InstanceType
 
-	if d.HasChange("image_name") || d.HasChange("image_path") {
+	if d.HasChange("image_name") || d.HasChange("image_path") || d.HasChange("image_checksum") {
 		data, err := os.ReadFile(d.Get("image_path").(string))
 		if err != nil {
 			return diag.FromErr(err)
@@ -428,7 +438,9 @@ func resourceInstanceTypeUpdate(ctx context.Context, d *schema.ResourceData, met
 			FileContent:   data,
 		}
 		filePayloads = append(filePayloads, filePayload)
-		if _, err := client.UpdateInstanceTypeLogo(instanceType.ID, filePayloads, &morpheus.Request{}); err != nil {
+		if _, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+			return client.UpdateInstanceTypeLogo(instanceType.ID, filePayloads, &morpheus.Request{})
+		}); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -440,14 +452,16 @@ func resourceInstanceTypeUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceInstanceTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteInstanceType(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteInstanceType(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
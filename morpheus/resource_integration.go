@@ -0,0 +1,357 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a generic Morpheus integration resource for integration types that do not have a dedicated resource yet. The integration settings are passed through as is, so consult the API documentation of the integration type for the supported config keys.",
+		CreateContext: resourceIntegrationCreate,
+		ReadContext:   resourceIntegrationRead,
+		UpdateContext: resourceIntegrationUpdate,
+		DeleteContext: resourceIntegrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the integration",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the integration",
+				Required:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The code of the integration type (e.g. servicenow, bluecat, cherwell)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the integration is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The url of the service the integration connects to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"config": {
+				Type:             schema.TypeMap,
+				Description:      "The type specific settings of the integration, values holding JSON objects or arrays are sent decoded. The settings the appliance adds are read but not planned for removal, and the settings holding secrets, such as passwords and tokens, are not read back",
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressUnconfiguredMapKeys,
+			},
+			"credential": {
+				Type:        schema.TypeList,
+				Description: "The credentials used to authenticate to the service, a local username and password are used when credential_id is not set",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the credential store entry used for authentication",
+							Optional:    true,
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Description: "The username used for authentication",
+							Optional:    true,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Description: "The password used for authentication",
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the integration",
+				Computed:    true,
+			},
+			"force_delete": forceDeleteSchema(),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": integrationPayload(d),
+		},
+	}
+
+	resp, err := client.CreateIntegration(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateIntegrationResult)
+	if !result.Success {
+		return diag.Errorf("error creating integration: %s", result.Message)
+	}
+	integration := result.Integration
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(integration.ID))
+
+	return resourceIntegrationRead(ctx, d, meta)
+}
+
+func resourceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindIntegrationByName(name)
+	} else if id != "" {
+		resp, err = client.GetIntegration(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Integration cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetIntegrationResult)
+	integration := result.Integration
+	if integration == nil {
+		return diag.Errorf("read operation: integration not found in response data") // should not happen
+	}
+	d.SetId(int64ToString(integration.ID))
+	d.Set("name", integration.Name)
+	d.Set("enabled", integration.Enabled)
+	if integration.IntegrationType.Code != "" {
+		d.Set("type", integration.IntegrationType.Code)
+	} else {
+		d.Set("type", integration.Type)
+	}
+	if integration.ServiceUrl != "" {
+		d.Set("url", integration.ServiceUrl)
+	} else {
+		d.Set("url", integration.URL)
+	}
+	d.Set("status", integration.Status)
+
+	// the settings holding secrets are masked by the api, keep the
+	// configured values
+	var config map[string]interface{}
+	if data, ok := resp.JsonData.(map[string]interface{}); ok {
+		if integrationData, ok := data["integration"].(map[string]interface{}); ok {
+			config, _ = integrationData["config"].(map[string]interface{})
+		}
+	}
+	configured := d.Get("config").(map[string]interface{})
+	stateConfig := make(map[string]interface{})
+	for key, apiValue := range config {
+		if integrationSecretConfigKey.MatchString(key) {
+			continue
+		}
+		value, _ := configured[key].(string)
+		stateConfig[key] = integrationConfigString(apiValue, value)
+	}
+	for key, value := range configured {
+		if _, ok := config[key]; ok && integrationSecretConfigKey.MatchString(key) {
+			stateConfig[key] = value
+		}
+	}
+	d.Set("config", stateConfig)
+
+	credential := map[string]interface{}{
+		"credential_id": integration.Credential.ID,
+		"username":      "",
+		"password":      "",
+	}
+	if integration.Credential.ID == 0 {
+		credential["username"] = integration.ServiceUsername
+	}
+	// the password is not returned by the api, keep the configured value
+	if credentials, ok := d.Get("credential").([]interface{}); ok && len(credentials) > 0 && credentials[0] != nil {
+		credential["password"] = credentials[0].(map[string]interface{})["password"]
+	}
+	if integration.Credential.ID != 0 || integration.ServiceUsername != "" {
+		d.Set("credential", []interface{}{credential})
+	} else {
+		d.Set("credential", []interface{}{})
+	}
+
+	return diags
+}
+
+func resourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": integrationPayload(d),
+		},
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateIntegration(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.UpdateIntegrationResult)
+	if !result.Success {
+		return diag.Errorf("error updating integration: %s", result.Message)
+	}
+	integration := result.Integration
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(integration.ID))
+	return resourceIntegrationRead(ctx, d, meta)
+}
+
+func resourceIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req, err := dependencyDeleteRequest(d, "integration", func() ([]string, error) {
+		return integrationDependents(meta, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteIntegration(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// integrationSecretConfigKey matches the config keys of the integrations
+// holding secrets, which are masked by the api
+var integrationSecretConfigKey = regexp.MustCompile(`(?i)password|secret|token|apikey|privatekey`)
+
+func integrationPayload(d *schema.ResourceData) map[string]interface{} {
+	integration := map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"type":    d.Get("type").(string),
+		"enabled": d.Get("enabled").(bool),
+	}
+	if url, ok := d.GetOk("url"); ok {
+		integration["serviceUrl"] = url.(string)
+	}
+
+	config := make(map[string]interface{})
+	for key, value := range d.Get("config").(map[string]interface{}) {
+		var decoded interface{}
+		// JSON objects and arrays are sent decoded, anything else as is
+		if err := json.Unmarshal([]byte(value.(string)), &decoded); err == nil {
+			switch decoded.(type) {
+			case map[string]interface{}, []interface{}:
+				config[key] = decoded
+				continue
+			}
+		}
+		config[key] = value.(string)
+	}
+	integration["config"] = config
+
+	credential := map[string]interface{}{
+		"type": "local",
+	}
+	if credentials, ok := d.GetOk("credential"); ok && credentials.([]interface{})[0] != nil {
+		credentialConfig := credentials.([]interface{})[0].(map[string]interface{})
+		if credentialConfig["credential_id"].(int) != 0 {
+			credential["type"] = "username-password"
+			credential["id"] = credentialConfig["credential_id"].(int)
+		} else {
+			integration["serviceUsername"] = credentialConfig["username"].(string)
+			integration["servicePassword"] = credentialConfig["password"].(string)
+		}
+	}
+	integration["credential"] = credential
+
+	return integration
+}
+
+// integrationConfigString converts a config value returned by the api back
+// to the string stored in state, keeping the configured value when equivalent
+func integrationConfigString(apiValue interface{}, configured string) string {
+	var value string
+	switch v := apiValue.(type) {
+	case string:
+		value = v
+	case map[string]interface{}, []interface{}:
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(configured), &decoded); err == nil {
+			a, _ := json.Marshal(decoded)
+			b, _ := json.Marshal(v)
+			if string(a) == string(b) {
+				return configured
+			}
+		}
+		encoded, _ := json.Marshal(v)
+		value = string(encoded)
+	case nil:
+		value = ""
+	default:
+		value = fmt.Sprint(v)
+	}
+	return value
+}
@@ -9,11 +9,12 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceIPv4IPPool() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus IPv4 ip pool resource",
+		Description:   "Provides a Morpheus IPv4 ip pool resource, the ip pools managed by Morpheus for the environments without an external IPAM. The ip pool is associated with a network with the pool_id attribute of the morpheus_network and morpheus_cloud_network resources.",
 		CreateContext: resourceIPv4IPPoolCreate,
 		ReadContext:   resourceIPv4IPPoolRead,
 		UpdateContext: resourceIPv4IPPoolUpdate,
@@ -49,6 +50,47 @@ func resourceIPv4IPPool() *schema.Resource {
 					},
 				},
 			},
+			"netmask": {
+				Type:         schema.TypeString,
+				Description:  "The netmask of the ip addresses assigned from the IPv4 IP address pool (255.255.255.0, etc.)",
+				ValidateFunc: validation.IsIPv4Address,
+				Optional:     true,
+			},
+			"gateway": {
+				Type:         schema.TypeString,
+				Description:  "The gateway of the ip addresses assigned from the IPv4 IP address pool",
+				ValidateFunc: validation.IsIPv4Address,
+				Optional:     true,
+			},
+			"dns_servers": {
+				Type:        schema.TypeList,
+				Description: "The DNS servers of the ip addresses assigned from the IPv4 IP address pool",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+			"dns_domain": {
+				Type:        schema.TypeString,
+				Description: "The DNS domain of the ip addresses assigned from the IPv4 IP address pool",
+				Optional:    true,
+			},
+			"dns_search_path": {
+				Type:        schema.TypeString,
+				Description: "The DNS search path of the ip addresses assigned from the IPv4 IP address pool",
+				Optional:    true,
+			},
+			"ip_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of ip addresses of the IPv4 IP address pool",
+				Computed:    true,
+			},
+			"free_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of ip addresses of the IPv4 IP address pool not assigned",
+				Computed:    true,
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -57,18 +99,14 @@ func resourceIPv4IPPool() *schema.Resource {
 }
 
 func resourceIPv4IPPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
-			"networkPool": map[string]interface{}{
-				"name":     d.Get("name").(string),
-				"type":     "morpheus",
-				"ipRanges": parseIPPoolRanges(d.Get("ip_range").([]interface{})),
-			},
+			"networkPool": ipv4IPPoolPayload(d),
 		},
 	}
 	resp, err := client.CreateNetworkPool(req)
@@ -87,7 +125,7 @@ func resourceIPv4IPPoolCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIPv4IPPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -119,12 +157,23 @@ func resourceIPv4IPPoolRead(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetNetworkPoolResult)
 	pool := result.NetworkPool
+	if pool == nil {
+		return diag.Errorf("Pool not found in response data.") // should not happen
+	}
 	d.SetId(int64ToString(pool.ID))
 	d.Set("name", pool.Name)
+	d.Set("netmask", pool.Netmask)
+	d.Set("gateway", pool.Gateway)
+	d.Set("dns_servers", pool.DnsServers)
+	d.Set("dns_domain", pool.DnsDomain)
+	d.Set("dns_search_path", pool.DnsSearchPath)
+	d.Set("ip_count", pool.IpCount)
+	d.Set("free_count", pool.FreeCount)
 	var ipRanges []map[string]interface{}
 	var unsortedRanges []IPRange
 	if pool.IpRanges != nil {
@@ -151,18 +200,16 @@ func resourceIPv4IPPoolRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceIPv4IPPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
-			"networkPool": map[string]interface{}{
-				"name":     d.Get("name").(string),
-				"type":     "morpheus",
-				"ipRanges": parseIPPoolRanges(d.Get("ip_range").([]interface{})),
-			},
+			"networkPool": ipv4IPPoolPayload(d),
 		},
 	}
-	resp, err := client.UpdateNetworkPool(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateNetworkPool(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -177,14 +224,16 @@ func resourceIPv4IPPoolUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIPv4IPPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteNetworkPool(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteNetworkPool(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -199,6 +248,25 @@ func resourceIPv4IPPoolDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// ipv4IPPoolPayload builds the network pool of the payload of a Morpheus
+// IPv4 ip pool
+func ipv4IPPoolPayload(d *schema.ResourceData) map[string]interface{} {
+	dnsServers := make([]string, 0)
+	for _, dnsServer := range d.Get("dns_servers").([]interface{}) {
+		dnsServers = append(dnsServers, dnsServer.(string))
+	}
+	return map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"type":          "morpheus",
+		"ipRanges":      parseIPPoolRanges(d.Get("ip_range").([]interface{})),
+		"netmask":       d.Get("netmask").(string),
+		"gateway":       d.Get("gateway").(string),
+		"dnsServers":    dnsServers,
+		"dnsDomain":     d.Get("dns_domain").(string),
+		"dnsSearchPath": d.Get("dns_search_path").(string),
+	}
+}
+
 func parseIPPoolRanges(variables []interface{}) []map[string]interface{} {
 	var poolRanges []map[string]interface{}
 	// iterate over the array of poolRanges
@@ -81,22 +81,29 @@ func resourceJavaScriptTask() *schema.Resource {
 				Optional:    true,
 				Default:     10,
 			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
 			"allow_custom_config": {
 				Type:        schema.TypeBool,
 				Description: "Custom configuration data to pass during the execution of the javascript script",
 				Optional:    true,
 				Default:     false,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the javascript task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceJavaScriptTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -113,6 +120,7 @@ func resourceJavaScriptTaskCreate(ctx context.Context, d *schema.ResourceData, m
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -128,10 +136,11 @@ func resourceJavaScriptTaskCreate(ctx context.Context, d *schema.ResourceData, m
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -149,8 +158,6 @@ func resourceJavaScriptTaskCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceJavaScriptTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -161,9 +168,9 @@ func resourceJavaScriptTaskRead(ctx context.Context, d *schema.ResourceData, met
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -181,6 +188,8 @@ func resourceJavaScriptTaskRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -194,11 +203,11 @@ func resourceJavaScriptTaskRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("retry_count", javascriptTask.RetryCount)
 	d.Set("retry_delay_seconds", javascriptTask.RetryDelaySeconds)
 	d.Set("allow_custom_config", javascriptTask.AllowCustomConfig)
+	d.Set("visibility", javascriptTask.Visibility)
 	return diags
 }
 
 func resourceJavaScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 	taskOptions := make(map[string]interface{})
@@ -213,6 +222,7 @@ func resourceJavaScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, m
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -228,11 +238,14 @@ func resourceJavaScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, m
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
 
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -247,14 +260,14 @@ func resourceJavaScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceJavaScriptTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -71,7 +71,7 @@ func resourceKeyPair() *schema.Resource {
 	}
 }
 func resourceKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -105,7 +105,7 @@ func resourceKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceKeyPairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -126,6 +126,7 @@ func resourceKeyPairRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	setResourceAuditAttributes(d, resp)
 	var keyPair *morpheus.KeyPair
 	if id != 0 {
 		result := resp.Result.(*morpheus.GetKeyPairResult) //read KeyPair from response, retireving an KeyPair via ID returns a pointer of type KeyPair, find by name return an pointer to an Array of KeyPair
@@ -147,7 +148,7 @@ func resourceKeyPairRead(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourceKeyPairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -155,7 +156,9 @@ func resourceKeyPairDelete(ctx context.Context, d *schema.ResourceData, meta int
 	id := toInt64(d.Get("id").(string))
 
 	req := &morpheus.Request{}
-	resp, err := client.DeleteKeyPair(id, req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteKeyPair(id, req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
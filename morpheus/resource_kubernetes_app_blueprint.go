@@ -90,7 +90,7 @@ func resourceKubernetesAppBlueprint() *schema.Resource {
 }
 
 func resourceKubernetesAppBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -132,12 +132,7 @@ func resourceKubernetesAppBlueprintCreate(ctx context.Context, d *schema.Resourc
 		specConfig["specs"] = spec_templates
 	case "repository":
 		kubernetesConfig["configType"] = "git"
-		kubernetesGitConfig := make(map[string]interface{})
-		kubernetesGitConfig["integrationId"] = d.Get("integration_id")
-		kubernetesGitConfig["repoId"] = d.Get("repository_id")
-		kubernetesGitConfig["branch"] = d.Get("version_ref").(string)
-		kubernetesGitConfig["path"] = d.Get("working_path").(string)
-		kubernetesConfig["git"] = kubernetesGitConfig
+		kubernetesConfig["git"] = appBlueprintGitPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -169,7 +164,7 @@ func resourceKubernetesAppBlueprintCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKubernetesAppBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -201,6 +196,7 @@ func resourceKubernetesAppBlueprintRead(ctx context.Context, d *schema.ResourceD
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var kubernetesBlueprint KubernetesAppBlueprint
@@ -218,10 +214,7 @@ func resourceKubernetesAppBlueprintRead(ctx context.Context, d *schema.ResourceD
 		d.Set("blueprint_content", kubernetesBlueprint.Blueprint.Config.Kubernetes)
 	case "git":
 		d.Set("source_type", "repository")
-		d.Set("working_path", kubernetesBlueprint.Blueprint.Config.Kubernetes.Git.Path)
-		d.Set("integration_id", kubernetesBlueprint.Blueprint.Config.Kubernetes.Git.IntegrationId)
-		d.Set("repository_id", kubernetesBlueprint.Blueprint.Config.Kubernetes.Git.RepoId)
-		d.Set("version_ref", kubernetesBlueprint.Blueprint.Config.Kubernetes.Git.Branch)
+		setAppBlueprintGit(d, kubernetesBlueprint.Blueprint.Config.Kubernetes.Git)
 	case "spec":
 		d.Set("source_type", "spec")
 		// spec templates
@@ -240,7 +233,7 @@ func resourceKubernetesAppBlueprintRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceKubernetesAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	blueprint_type := "kubernetes"
@@ -279,12 +272,7 @@ func resourceKubernetesAppBlueprintUpdate(ctx context.Context, d *schema.Resourc
 		specConfig["specs"] = spec_templates
 	case "repository":
 		kubernetesConfig["configType"] = "git"
-		kubernetesGitConfig := make(map[string]interface{})
-		kubernetesGitConfig["integrationId"] = d.Get("integration_id")
-		kubernetesGitConfig["repoId"] = d.Get("repository_id")
-		kubernetesGitConfig["branch"] = d.Get("version_ref").(string)
-		kubernetesGitConfig["path"] = d.Get("working_path").(string)
-		kubernetesConfig["git"] = kubernetesGitConfig
+		kubernetesConfig["git"] = appBlueprintGitPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -299,7 +287,9 @@ func resourceKubernetesAppBlueprintUpdate(ctx context.Context, d *schema.Resourc
 		},
 	}
 	log.Printf("API REQUEST: %s", req)
-	resp, err := client.UpdateBlueprint(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBlueprint(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -314,14 +304,16 @@ func resourceKubernetesAppBlueprintUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKubernetesAppBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteBlueprint(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteBlueprint(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -347,13 +339,8 @@ type KubernetesAppBlueprint struct {
 			Name        string `json:"name"`
 			Description string `json:"description"`
 			Kubernetes  struct {
-				Configtype string `json:"configType"`
-				Git        struct {
-					Path          string `json:"path"`
-					RepoId        int    `json:"repoId"`
-					IntegrationId int    `json:"integrationId"`
-					Branch        string `json:"branch"`
-				} `json:"git"`
+				Configtype string          `json:"configType"`
+				Git        appBlueprintGit `json:"git"`
 			} `json:"kubernetes"`
 			Config struct {
 				Specs []struct {
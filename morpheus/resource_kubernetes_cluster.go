@@ -0,0 +1,526 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKubernetesCluster() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus Kubernetes Service (MKS) cluster resource for any cloud type supported by the cluster layout",
+		CreateContext: resourceKubernetesClusterCreate,
+		ReadContext:   resourceKubernetesClusterRead,
+		UpdateContext: resourceKubernetesClusterUpdate,
+		DeleteContext: resourceKubernetesClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the kubernetes cluster",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the kubernetes cluster",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the kubernetes cluster",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the group associated with the kubernetes cluster",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud associated with the kubernetes cluster",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cluster_layout_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cluster layout to provision the kubernetes cluster from",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"plan_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the service plan of the master nodes",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"worker_plan_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the service plan of the worker nodes, the plan of the master nodes is used when not set",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"worker_count": {
+				Type:         schema.TypeInt,
+				Description:  "The number of worker nodes of the kubernetes cluster",
+				Optional:     true,
+				Default:      minimumMKSWorkerNodes,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"network_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the network the nodes are attached to",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"resource_pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the resource pool to provision the nodes to",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"pod_cidr": {
+				Type:        schema.TypeString,
+				Description: "The cluster pod cidr",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "172.20.0.0/16",
+			},
+			"service_cidr": {
+				Type:        schema.TypeString,
+				Description: "The cluster service cidr",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "172.30.0.0/16",
+			},
+			"wait_for_ready": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait on create until the kubernetes cluster is provisioned and ready, the wait is bounded by the create timeout. The api_url and kubeconfig attributes are only known once the cluster is ready",
+				Optional:    true,
+				Default:     true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the kubernetes cluster",
+				Computed:    true,
+			},
+			"kubernetes_version": {
+				Type:        schema.TypeString,
+				Description: "The kubernetes version of the cluster",
+				Computed:    true,
+			},
+			"api_url": {
+				Type:        schema.TypeString,
+				Description: "The url of the kubernetes API of the cluster",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Description: "The kubeconfig to access the kubernetes API of the cluster",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKubernetesClusterImport,
+		},
+	}
+}
+
+func resourceKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	workerPlanId := d.Get("worker_plan_id").(int)
+	if workerPlanId == 0 {
+		workerPlanId = d.Get("plan_id").(int)
+	}
+
+	serverPayload := map[string]interface{}{
+		"config": map[string]interface{}{
+			"podCidr":        d.Get("pod_cidr").(string),
+			"serviceCidr":    d.Get("service_cidr").(string),
+			"resourcePoolId": d.Get("resource_pool_id").(int),
+			"nodeCount":      d.Get("worker_count").(int),
+		},
+		"nodeCount": d.Get("worker_count").(int),
+		"plan": map[string]interface{}{
+			"id": d.Get("plan_id").(int),
+		},
+	}
+	workerPayload := map[string]interface{}{
+		"config": map[string]interface{}{
+			"resourcePoolId": d.Get("resource_pool_id").(int),
+		},
+		"server": map[string]interface{}{
+			"plan": map[string]interface{}{
+				"id": workerPlanId,
+			},
+		},
+	}
+	if networkId := d.Get("network_id").(int); networkId != 0 {
+		// the master nodes expect the network-<id> form, the worker nodes the plain id
+		serverPayload["networkInterfaces"] = []map[string]interface{}{
+			{"network": map[string]interface{}{"id": fmt.Sprintf("network-%d", networkId)}},
+		}
+		workerPayload["networkInterfaces"] = []map[string]interface{}{
+			{"network": map[string]interface{}{"id": networkId}},
+		}
+	}
+
+	clusterPayload := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"type":        "kubernetes-cluster",
+		"group": map[string]interface{}{
+			"id": d.Get("group_id").(int),
+		},
+		"cloud": map[string]interface{}{
+			"id": d.Get("cloud_id").(int),
+		},
+		"layout": map[string]interface{}{
+			"id": d.Get("cluster_layout_id").(int),
+		},
+		"server": serverPayload,
+		"worker": workerPayload,
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"cluster": clusterPayload,
+		},
+	}
+
+	resp, err := client.CreateCluster(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateClusterResult)
+	if !result.Success {
+		return diag.Errorf("error creating kubernetes cluster: %s", result.Message)
+	}
+	cluster := result.Cluster
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(cluster.ID))
+
+	if d.Get("wait_for_ready").(bool) {
+		if err := waitForCluster(ctx, client, cluster.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error creating kubernetes cluster: %s", err)
+		}
+	}
+
+	return resourceKubernetesClusterRead(ctx, d, meta)
+}
+
+func resourceKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetCluster(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetClusterResult)
+	cluster := result.Cluster
+	if cluster == nil {
+		return diag.Errorf("read operation: kubernetes cluster not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(cluster.ID))
+	d.Set("name", cluster.Name)
+	d.Set("description", cluster.Description)
+	d.Set("group_id", cluster.Site.Id)
+	d.Set("cloud_id", cluster.Zone.Id)
+	d.Set("cluster_layout_id", cluster.Layout.Id)
+	d.Set("status", cluster.Status)
+	d.Set("kubernetes_version", cluster.ServiceVersion)
+
+	workers, err := getClusterWorkers(client, cluster.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	workers = filterOutClusterWorkersByStatus(workers, statusDeprovisioning)
+	if len(workers) > 0 {
+		d.Set("worker_count", len(workers))
+		d.Set("worker_plan_id", workers[0].Plan.ID)
+	}
+
+	// the api config is only available once the cluster is provisioned
+	if cluster.Status != statusOk && cluster.Status != statusRunning && cluster.Status != statusWarning {
+		d.Set("api_url", cluster.ServiceUrl)
+		return diags
+	}
+	apiConfigResp, err := client.GetClusterApiConfig(cluster.ID, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", apiConfigResp, err)
+		return diag.FromErr(err)
+	}
+	apiConfig := apiConfigResp.Result.(*morpheus.GetClusterApiConfigResult)
+	apiUrl := apiConfig.ServiceUrl
+	if apiUrl == "" {
+		apiUrl = cluster.ServiceUrl
+	}
+	d.Set("api_url", apiUrl)
+	d.Set("kubeconfig", kubernetesClusterKubeconfig(cluster.Name, apiUrl, apiConfig))
+
+	return diags
+}
+
+func resourceKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	clusterId := toInt64(d.Id())
+
+	if d.HasChange("worker_count") {
+		o, n := d.GetChange("worker_count")
+		countDelta := n.(int) - o.(int)
+		if countDelta > 0 {
+			if err := addClusterWorkers(ctx, meta, clusterId, countDelta, d.Get("worker_plan_id").(int), d); err != nil {
+				return diag.Errorf("error adding kubernetes cluster worker node(s): %s", err)
+			}
+		} else if countDelta < 0 {
+			if err := doClusterWorkerDelete(ctx, client, clusterId, countDelta); err != nil {
+				return diag.Errorf("error deleting kubernetes cluster worker node(s): %s", err)
+			}
+		}
+	}
+
+	if d.HasChanges("name", "description") {
+		req := &morpheus.Request{
+			Body: map[string]interface{}{
+				"cluster": map[string]interface{}{
+					"name":        d.Get("name").(string),
+					"description": d.Get("description").(string),
+				},
+			},
+		}
+		resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+			return client.UpdateCluster(clusterId, req)
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+
+	return resourceKubernetesClusterRead(ctx, d, meta)
+}
+
+func resourceKubernetesClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	if err := deleteCluster(ctx, client, toInt64(id), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error deleting kubernetes cluster: %s", err)
+	}
+
+	d.SetId("")
+	return diags
+}
+
+// deleteCluster deletes a cluster along with its nodes and waits for its removal
+func deleteCluster(ctx context.Context, client *apiClient, id int64, timeout time.Duration) error {
+	req := &morpheus.Request{
+		QueryParams: map[string]string{
+			"removeInstances": "on",
+			"removeResources": "on",
+		},
+	}
+	if USE_FORCE {
+		req.QueryParams["force"] = "true"
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCluster(id, req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return err
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusRemoving, statusPendingRemoval, statusStopping, statusPending, statusWarning, statusDeprovisioning},
+		Target:  []string{statusRemoved},
+		Refresh: func() (interface{}, string, error) {
+			clusterDetails, err := client.GetCluster(id, &morpheus.Request{})
+			if clusterDetails != nil && clusterDetails.StatusCode == 404 {
+				return "", statusRemoved, nil
+			}
+			if err != nil {
+				return "", "", err
+			}
+			result := clusterDetails.Result.(*morpheus.GetClusterResult)
+			return result, result.Cluster.Status, nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   1 * time.Minute,
+		Delay:        1 * time.Minute,
+		PollInterval: 30 * time.Second,
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// waitForCluster waits for a newly created cluster to be provisioned
+func waitForCluster(ctx context.Context, client *apiClient, id int64, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusProvisioning, statusStarting, statusPending, statusSyncing},
+		Target:  []string{statusOk, statusRunning, statusWarning},
+		Refresh: func() (interface{}, string, error) {
+			clusterDetails, err := client.GetCluster(id, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			result := clusterDetails.Result.(*morpheus.GetClusterResult)
+			cluster := result.Cluster
+			switch cluster.Status {
+			case statusFailed, statusDenied, statusCancelled:
+				return result, cluster.Status, fmt.Errorf("cluster is %s: %s", cluster.Status, cluster.StatusMessage)
+			}
+			return result, cluster.Status, nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   1 * time.Minute,
+		Delay:        1 * time.Minute,
+		PollInterval: 30 * time.Second,
+	}
+
+	// Wait, catching any errors
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// addClusterWorkers adds nodeCount worker nodes using the given plan to the
+// cluster and waits for them to be provisioned
+func addClusterWorkers(ctx context.Context, meta interface{}, clusterId int64, nodeCount int, planId int, d *schema.ResourceData) error {
+	client := meta.(*providerMeta).client
+	workers, err := getClusterWorkers(client, clusterId)
+	if err != nil {
+		return err
+	}
+	if len(workers) == 0 {
+		return fmt.Errorf("the cluster has no worker node to copy the server type from")
+	}
+	desiredWorkerCount := len(filterClusterWorkersByStatus(workers, statusProvisioned)) + nodeCount
+
+	serverPayload := map[string]interface{}{
+		"config": map[string]interface{}{
+			"resourcePoolId": d.Get("resource_pool_id").(int),
+		},
+		"serverType": map[string]interface{}{
+			"id": workers[0].ComputeServerType.ID,
+		},
+		"cloud": map[string]interface{}{
+			"id": d.Get("cloud_id").(int),
+		},
+		"plan": map[string]interface{}{
+			"id": planId,
+		},
+		"nodeCount": nodeCount,
+	}
+	if networkId := d.Get("network_id").(int); networkId != 0 {
+		serverPayload["networkInterfaces"] = []map[string]interface{}{
+			{"network": map[string]interface{}{"id": fmt.Sprintf("network-%d", networkId)}},
+		}
+	}
+
+	resp, err := client.AddClusterWorker(clusterId, &morpheus.Request{
+		Body: map[string]interface{}{
+			"server": clusterWorkerPayload(meta, serverPayload),
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return waitForClusterWorkers(ctx, client, clusterId, desiredWorkerCount)
+}
+
+// kubernetesClusterKubeconfig returns the kubeconfig of the cluster, built
+// from the api token when the appliance does not return one
+func kubernetesClusterKubeconfig(name string, apiUrl string, apiConfig *morpheus.GetClusterApiConfigResult) string {
+	if strings.Contains(apiConfig.ServiceAccess, "apiVersion:") {
+		return apiConfig.ServiceAccess
+	}
+	if apiUrl == "" || apiConfig.ServiceToken == "" {
+		return ""
+	}
+	cluster := fmt.Sprintf("    server: %s\n", apiUrl)
+	if apiConfig.ServiceCert != "" {
+		cluster += fmt.Sprintf("    certificate-authority-data: %s\n", base64.StdEncoding.EncodeToString([]byte(apiConfig.ServiceCert)))
+	} else {
+		cluster += "    insecure-skip-tls-verify: true\n"
+	}
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+%[2]s
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: %[1]s
+current-context: %[1]s
+users:
+- name: %[1]s
+  user:
+    token: %[3]s
+`, name, strings.TrimSuffix(cluster, "\n"), apiConfig.ServiceToken)
+}
+
+// resourceKubernetesClusterImport sets the defaults of the attributes not returned by the api on import
+func resourceKubernetesClusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("wait_for_ready", true)
+	d.Set("pod_cidr", "172.20.0.0/16")
+	d.Set("service_cidr", "172.30.0.0/16")
+	return []*schema.ResourceData{d}, nil
+}
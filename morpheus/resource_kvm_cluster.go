@@ -0,0 +1,45 @@
+package morpheus
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKvmCluster() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus KVM cluster resource for provisioning a Morpheus managed cluster of KVM hosts",
+		CreateContext: resourceKvmClusterCreate,
+		ReadContext:   resourceKvmClusterRead,
+		UpdateContext: resourceKvmClusterUpdate,
+		DeleteContext: resourceKvmClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: hostClusterSchema("kvm cluster"),
+		Importer: &schema.ResourceImporter{
+			StateContext: hostClusterImport,
+		},
+	}
+}
+
+func resourceKvmClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return hostClusterCreate(ctx, d, meta, "kvm-cluster", "kvm cluster")
+}
+
+func resourceKvmClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return hostClusterRead(ctx, d, meta, "kvm cluster")
+}
+
+func resourceKvmClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return hostClusterUpdate(ctx, d, meta, "kvm cluster")
+}
+
+func resourceKvmClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return hostClusterDelete(ctx, d, meta, "kvm cluster")
+}
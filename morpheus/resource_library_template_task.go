@@ -2,7 +2,10 @@ package morpheus
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"log"
+	"strings"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -63,11 +66,48 @@ func resourceLibraryTemplateTask() *schema.Resource {
 			},
 			"execute_target": {
 				Type:         schema.TypeString,
-				Description:  "The target for the library template",
-				ValidateFunc: validation.StringInSlice([]string{"resource"}, false),
+				Description:  "The target for the library template (resource or remote)",
+				ValidateFunc: validation.StringInSlice([]string{"resource", "remote"}, false),
 				Optional:     true,
 				Computed:     true,
 			},
+			"remote_target_host": {
+				Type:        schema.TypeString,
+				Description: "The hostname or ip address of the remote target",
+				Optional:    true,
+			},
+			"remote_target_port": {
+				Type:        schema.TypeString,
+				Description: "The port used to connect to the remote target",
+				Optional:    true,
+			},
+			"remote_target_username": {
+				Type:        schema.TypeString,
+				Description: "The username of the user account used to authenticate to the remote target",
+				Optional:    true,
+			},
+			"remote_target_password": {
+				Type:        schema.TypeString,
+				Description: "The password of the user account used to authenticate to the remote target",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					return strings.EqualFold(old, hex.EncodeToString(h.Sum(nil)))
+				},
+			},
+			"remote_target_credential_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store entry used to authenticate to the remote target",
+				Optional:      true,
+				ConflictsWith: []string{"remote_target_password"},
+			},
+			"remote_target_key_pair_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the key pair used to authenticate to the remote target over SSH",
+				Optional:    true,
+			},
 			"retryable": {
 				Type:        schema.TypeBool,
 				Description: "Whether to retry the library task if there is a failure",
@@ -99,16 +139,16 @@ func resourceLibraryTemplateTask() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the library template task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceLibraryTemplateTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -129,12 +169,33 @@ func resourceLibraryTemplateTaskCreate(ctx context.Context, d *schema.ResourceDa
 		taskOptions["containerTemplate"] = d.Get("file_template")
 	}
 
+	taskOptions["host"] = d.Get("remote_target_host")
+	taskOptions["port"] = d.Get("remote_target_port")
+	taskOptions["username"] = d.Get("remote_target_username")
+	if d.Get("remote_target_password") != "" {
+		taskOptions["password"] = d.Get("remote_target_password")
+	}
+	if d.Get("remote_target_key_pair_id").(int) != 0 {
+		taskOptions["sshKey"] = d.Get("remote_target_key_pair_id").(int)
+	} else {
+		taskOptions["sshKey"] = nil
+	}
+
+	credential := make(map[string]interface{})
+	if d.Get("remote_target_credential_id").(int) != 0 {
+		credential["type"] = "username-password"
+		credential["id"] = d.Get("remote_target_credential_id").(int)
+	} else {
+		credential["type"] = "local"
+	}
+
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
 		for _, s := range attr.(*schema.Set).List() {
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -144,6 +205,7 @@ func resourceLibraryTemplateTaskCreate(ctx context.Context, d *schema.ResourceDa
 				"labels":            labelsPayload,
 				"taskType":          taskType,
 				"taskOptions":       taskOptions,
+				"credential":        credential,
 				"resultType":        d.Get("result_type"),
 				"executeTarget":     d.Get("execute_target").(string),
 				"visibility":        d.Get("visibility"),
@@ -154,7 +216,7 @@ func resourceLibraryTemplateTaskCreate(ctx context.Context, d *schema.ResourceDa
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -171,8 +233,6 @@ func resourceLibraryTemplateTaskCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceLibraryTemplateTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -183,9 +243,9 @@ func resourceLibraryTemplateTaskRead(ctx context.Context, d *schema.ResourceData
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -203,6 +263,8 @@ func resourceLibraryTemplateTaskRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -216,6 +278,12 @@ func resourceLibraryTemplateTaskRead(ctx context.Context, d *schema.ResourceData
 	d.Set("file_template", libraryTemplateTask.TaskOptions.ContainerTemplate)
 	d.Set("file_template_id", libraryTemplateTask.TaskOptions.ContainerTemplateId)
 	d.Set("execute_target", libraryTemplateTask.ExecuteTarget)
+	d.Set("remote_target_host", libraryTemplateTask.TaskOptions.Host)
+	d.Set("remote_target_port", libraryTemplateTask.TaskOptions.Port)
+	d.Set("remote_target_username", libraryTemplateTask.TaskOptions.Username)
+	d.Set("remote_target_password", libraryTemplateTask.TaskOptions.PasswordHash)
+	d.Set("remote_target_credential_id", libraryTemplateTask.Credential.ID)
+	d.Set("remote_target_key_pair_id", stringToInt64(libraryTemplateTask.TaskOptions.SshKey))
 	d.Set("retryable", libraryTemplateTask.Retryable)
 	d.Set("retry_count", libraryTemplateTask.RetryCount)
 	d.Set("retry_delay_seconds", libraryTemplateTask.RetryDelaySeconds)
@@ -225,7 +293,6 @@ func resourceLibraryTemplateTaskRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceLibraryTemplateTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -244,12 +311,33 @@ func resourceLibraryTemplateTaskUpdate(ctx context.Context, d *schema.ResourceDa
 		taskOptions["containerTemplate"] = d.Get("file_template")
 	}
 
+	taskOptions["host"] = d.Get("remote_target_host")
+	taskOptions["port"] = d.Get("remote_target_port")
+	taskOptions["username"] = d.Get("remote_target_username")
+	if d.Get("remote_target_password") != "" {
+		taskOptions["password"] = d.Get("remote_target_password")
+	}
+	if d.Get("remote_target_key_pair_id").(int) != 0 {
+		taskOptions["sshKey"] = d.Get("remote_target_key_pair_id").(int)
+	} else {
+		taskOptions["sshKey"] = nil
+	}
+
+	credential := make(map[string]interface{})
+	if d.Get("remote_target_credential_id").(int) != 0 {
+		credential["type"] = "username-password"
+		credential["id"] = d.Get("remote_target_credential_id").(int)
+	} else {
+		credential["type"] = "local"
+	}
+
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
 		for _, s := range attr.(*schema.Set).List() {
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -259,6 +347,7 @@ func resourceLibraryTemplateTaskUpdate(ctx context.Context, d *schema.ResourceDa
 				"labels":            labelsPayload,
 				"taskType":          taskType,
 				"taskOptions":       taskOptions,
+				"credential":        credential,
 				"resultType":        d.Get("result_type"),
 				"executeTarget":     d.Get("execute_target").(string),
 				"visibility":        d.Get("visibility"),
@@ -270,7 +359,9 @@ func resourceLibraryTemplateTaskUpdate(ctx context.Context, d *schema.ResourceDa
 		},
 	}
 
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -285,14 +376,14 @@ func resourceLibraryTemplateTaskUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceLibraryTemplateTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
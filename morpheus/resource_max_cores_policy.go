@@ -48,8 +48,8 @@ func resourceMaxCoresPolicy() *schema.Resource {
 			},
 			"scope": {
 				Type:         schema.TypeString,
-				Description:  "The filter or scope that the policy is applied to (global, group, cloud, user, role)",
-				ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role"}, false),
+				Description:  "The filter or scope that the policy is applied to (global, group, cloud, user, role, tenant)",
+				ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role", "tenant"}, false),
 				Required:     true,
 				ForceNew:     true,
 			},
@@ -58,35 +58,42 @@ func resourceMaxCoresPolicy() *schema.Resource {
 				Description:   "The id of the group associated with the group scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "user_id", "role_id"},
+				ConflictsWith: []string{"cloud_id", "user_id", "role_id", "tenant_id"},
 			},
 			"cloud_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the cloud associated with the cloud scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"group_id", "user_id", "role_id"},
+				ConflictsWith: []string{"group_id", "user_id", "role_id", "tenant_id"},
 			},
 			"user_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the user associated with the user scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "group_id", "role_id"},
+				ConflictsWith: []string{"cloud_id", "group_id", "role_id", "tenant_id"},
 			},
 			"role_id": {
 				Type:          schema.TypeInt,
 				Description:   "The id of the role associated with the role scoped filter",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id", "tenant_id"},
 			},
 			"apply_to_each_user": {
 				Type:          schema.TypeBool,
-				Description:   "Whether to assign the policy at the individual user level to all users assigned the associated role",
+				Description:   "Whether to assign the policy at the individual user level to all users assigned the associated role or belonging to the associated tenant",
 				Optional:      true,
 				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
 			},
+			"tenant_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the tenant associated with the tenant scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id", "role_id"},
+			},
 			"tenant_ids": {
 				Type:        schema.TypeList,
 				Description: "A list of tenant IDs to assign the policy to",
@@ -102,7 +109,7 @@ func resourceMaxCoresPolicy() *schema.Resource {
 }
 
 func resourceMaxCoresPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -148,6 +155,13 @@ func resourceMaxCoresPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 		policy["role"] = map[string]interface{}{
 			"id": d.Get("role_id").(int),
 		}
+	case "tenant":
+		policy["refId"] = d.Get("tenant_id").(int)
+		policy["refType"] = "Account"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["account"] = map[string]interface{}{
+			"id": d.Get("tenant_id").(int),
+		}
 	}
 
 	req := &morpheus.Request{
@@ -172,7 +186,7 @@ func resourceMaxCoresPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceMaxCoresPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -202,6 +216,7 @@ func resourceMaxCoresPolicyRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -227,6 +242,10 @@ func resourceMaxCoresPolicyRead(ctx context.Context, d *schema.ResourceData, met
 		d.Set("scope", "role")
 		d.Set("role_id", maxCoresPolicy.Role.ID)
 		d.Set("apply_to_each_user", maxCoresPolicy.EachUser)
+	case "Account":
+		d.Set("scope", "tenant")
+		d.Set("tenant_id", maxCoresPolicy.RefID)
+		d.Set("apply_to_each_user", maxCoresPolicy.EachUser)
 	default:
 		d.Set("scope", "global")
 	}
@@ -244,7 +263,7 @@ func resourceMaxCoresPolicyRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceMaxCoresPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -288,6 +307,13 @@ func resourceMaxCoresPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 		policy["role"] = map[string]interface{}{
 			"id": d.Get("role_id").(int),
 		}
+	case "tenant":
+		policy["refId"] = d.Get("tenant_id").(int)
+		policy["refType"] = "Account"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["account"] = map[string]interface{}{
+			"id": d.Get("tenant_id").(int),
+		}
 	}
 
 	req := &morpheus.Request{
@@ -295,7 +321,9 @@ func resourceMaxCoresPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -311,14 +339,16 @@ func resourceMaxCoresPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceMaxCoresPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
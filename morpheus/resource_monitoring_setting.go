@@ -126,7 +126,7 @@ func resourceMonitoringSetting() *schema.Resource {
 }
 
 func resourceMonitoringSettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -211,7 +211,9 @@ func resourceMonitoringSettingCreate(ctx context.Context, d *schema.ResourceData
 	jsonRequest, _ := json.Marshal(req.Body)
 	log.Printf("API JSON REQUEST: %s", string(jsonRequest))
 
-	resp, err := client.UpdateMonitoringSettings(req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateMonitoringSettings(req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -228,7 +230,7 @@ func resourceMonitoringSettingCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceMonitoringSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -250,6 +252,7 @@ func resourceMonitoringSettingRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetMonitoringSettingsResult)
@@ -273,7 +276,7 @@ func resourceMonitoringSettingRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceMonitoringSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	monitoringSettings := make(map[string]interface{})
 
@@ -353,7 +356,9 @@ func resourceMonitoringSettingUpdate(ctx context.Context, d *schema.ResourceData
 	jsonRequest, _ := json.Marshal(req.Body)
 	log.Printf("API JSON REQUEST: %s", string(jsonRequest))
 
-	resp, err := client.UpdateMonitoringSettings(req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateMonitoringSettings(req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -0,0 +1,388 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceMorpheusAppBlueprint() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus app blueprint resource for native Morpheus app blueprints made of tiers of instances",
+		CreateContext: resourceMorpheusAppBlueprintCreate,
+		ReadContext:   resourceMorpheusAppBlueprintRead,
+		UpdateContext: resourceMorpheusAppBlueprintUpdate,
+		DeleteContext: resourceMorpheusAppBlueprintDelete,
+		CustomizeDiff: morpheusAppBlueprintTiersCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the morpheus app blueprint",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the morpheus app blueprint",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the morpheus app blueprint",
+				Optional:    true,
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Description: "The category of the morpheus app blueprint",
+				Optional:    true,
+			},
+			"tier": {
+				Type:        schema.TypeList,
+				Description: "The tiers of the morpheus app blueprint",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the tier (Web, App, Database, etc.)",
+							Required:    true,
+						},
+						"boot_order": {
+							Type:        schema.TypeInt,
+							Description: "The boot order of the tier, the tiers with a lower boot order are provisioned first",
+							Optional:    true,
+							Default:     0,
+						},
+						"linked_tiers": {
+							Type:        schema.TypeList,
+							Description: "The names of the tiers the instances of the tier connect to",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"instance": {
+							Type:        schema.TypeList,
+							Description: "The instances of the tier",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of the instance, the name of the app and the tier are used when not set",
+										Optional:    true,
+									},
+									"instance_type_code": {
+										Type:        schema.TypeString,
+										Description: "The code of the instance type of the instance",
+										Required:    true,
+									},
+									"layout_id": {
+										Type:        schema.TypeInt,
+										Description: "The ID of the layout of the instance",
+										Required:    true,
+									},
+									"plan_id": {
+										Type:        schema.TypeInt,
+										Description: "The ID of the service plan of the instance",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// morpheusAppBlueprintTiersCustomizeDiff checks at plan time that the tier
+// names are unique and that the linked tiers are tiers of the blueprint
+func morpheusAppBlueprintTiersCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tier") {
+		return nil
+	}
+	tiers := d.Get("tier").([]interface{})
+	names := make(map[string]bool)
+	for _, item := range tiers {
+		tier := item.(map[string]interface{})
+		name := tier["name"].(string)
+		if names[name] {
+			return fmt.Errorf("the tier %s is defined more than once", name)
+		}
+		names[name] = true
+	}
+	for _, item := range tiers {
+		tier := item.(map[string]interface{})
+		for _, linkedTier := range tier["linked_tiers"].([]interface{}) {
+			if linkedTier, ok := linkedTier.(string); ok && !names[linkedTier] {
+				return fmt.Errorf("the tier %s is linked to the tier %s which is not defined", tier["name"], linkedTier)
+			}
+		}
+	}
+	return nil
+}
+
+func morpheusAppBlueprintPayload(d *schema.ResourceData) map[string]interface{} {
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+	category := d.Get("category").(string)
+
+	config := make(map[string]interface{})
+	config["name"] = name
+	config["description"] = description
+	config["category"] = category
+	config["type"] = "morpheus"
+
+	tiers := make(map[string]interface{})
+	for i, item := range d.Get("tier").([]interface{}) {
+		tier := item.(map[string]interface{})
+		var instances []map[string]interface{}
+		for _, instanceItem := range tier["instance"].([]interface{}) {
+			instance := instanceItem.(map[string]interface{})
+			instanceConfig := map[string]interface{}{
+				"type": instance["instance_type_code"].(string),
+				"layout": map[string]interface{}{
+					"id": instance["layout_id"].(int),
+				},
+			}
+			if instance["name"].(string) != "" {
+				instanceConfig["name"] = instance["name"].(string)
+			}
+			instances = append(instances, map[string]interface{}{
+				"instance": instanceConfig,
+				"plan": map[string]interface{}{
+					"id": instance["plan_id"].(int),
+				},
+			})
+		}
+		linkedTiers := make([]string, 0)
+		for _, linkedTier := range tier["linked_tiers"].([]interface{}) {
+			linkedTiers = append(linkedTiers, linkedTier.(string))
+		}
+		tiers[tier["name"].(string)] = map[string]interface{}{
+			"tierIndex":   i,
+			"bootOrder":   tier["boot_order"].(int),
+			"linkedTiers": linkedTiers,
+			"instances":   instances,
+		}
+	}
+	config["tiers"] = tiers
+
+	return map[string]interface{}{
+		"blueprint": map[string]interface{}{
+			"name":        name,
+			"type":        "morpheus",
+			"description": description,
+			"category":    category,
+			"config":      config,
+		},
+	}
+}
+
+func resourceMorpheusAppBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: morpheusAppBlueprintPayload(d),
+	}
+
+	resp, err := client.CreateBlueprint(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateBlueprintResult)
+	blueprint := result.Blueprint
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(blueprint.ID))
+
+	resourceMorpheusAppBlueprintRead(ctx, d, meta)
+	return diags
+}
+
+func resourceMorpheusAppBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindBlueprintByName(name)
+	} else if id != "" {
+		resp, err = client.GetBlueprint(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Blueprint cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	var morpheusBlueprint MorpheusAppBlueprint
+	if err := json.Unmarshal(resp.Body, &morpheusBlueprint); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(intToString(morpheusBlueprint.Blueprint.ID))
+	d.Set("name", morpheusBlueprint.Blueprint.Name)
+	d.Set("description", morpheusBlueprint.Blueprint.Description)
+	d.Set("category", morpheusBlueprint.Blueprint.Category)
+
+	// the tiers are keyed by name, keep the order they were defined in
+	tierNames := make([]string, 0, len(morpheusBlueprint.Blueprint.Config.Tiers))
+	for tierName := range morpheusBlueprint.Blueprint.Config.Tiers {
+		tierNames = append(tierNames, tierName)
+	}
+	sort.Slice(tierNames, func(i, j int) bool {
+		a, b := morpheusBlueprint.Blueprint.Config.Tiers[tierNames[i]], morpheusBlueprint.Blueprint.Config.Tiers[tierNames[j]]
+		if a.TierIndex != b.TierIndex {
+			return a.TierIndex < b.TierIndex
+		}
+		return tierNames[i] < tierNames[j]
+	})
+	var tiers []map[string]interface{}
+	for _, tierName := range tierNames {
+		tier := morpheusBlueprint.Blueprint.Config.Tiers[tierName]
+		var instances []map[string]interface{}
+		for _, instance := range tier.Instances {
+			instances = append(instances, map[string]interface{}{
+				"name":               instance.Instance.Name,
+				"instance_type_code": instance.Instance.Type,
+				"layout_id":          instance.Instance.Layout.ID,
+				"plan_id":            instance.Plan.ID,
+			})
+		}
+		tiers = append(tiers, map[string]interface{}{
+			"name":         tierName,
+			"boot_order":   tier.BootOrder,
+			"linked_tiers": tier.LinkedTiers,
+			"instance":     instances,
+		})
+	}
+	d.Set("tier", tiers)
+
+	return diags
+}
+
+func resourceMorpheusAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: morpheusAppBlueprintPayload(d),
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBlueprint(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateBlueprintResult)
+	blueprint := result.Blueprint
+	// Successfully updated resource, now set id
+	d.SetId(int64ToString(blueprint.ID))
+	return resourceMorpheusAppBlueprintRead(ctx, d, meta)
+}
+
+func resourceMorpheusAppBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteBlueprint(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+type MorpheusAppBlueprint struct {
+	Blueprint struct {
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Type        string `json:"type"`
+		Description string `json:"description"`
+		Category    string `json:"category"`
+		Config      struct {
+			Name        string `json:"name"`
+			Description string `json:"description"`
+			Type        string `json:"type"`
+			Category    string `json:"category"`
+			Tiers       map[string]struct {
+				TierIndex   int      `json:"tierIndex"`
+				BootOrder   int      `json:"bootOrder"`
+				LinkedTiers []string `json:"linkedTiers"`
+				Instances   []struct {
+					Instance struct {
+						Type   string `json:"type"`
+						Name   string `json:"name"`
+						Layout struct {
+							ID int `json:"id"`
+						} `json:"layout"`
+					} `json:"instance"`
+					Plan struct {
+						ID int `json:"id"`
+					} `json:"plan"`
+				} `json:"instances"`
+			} `json:"tiers"`
+		} `json:"config"`
+		Visibility string `json:"visibility"`
+		Owner      struct {
+			ID       int    `json:"id"`
+			Username string `json:"username"`
+		} `json:"owner"`
+		Tenant struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"tenant"`
+	} `json:"blueprint"`
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceMVMInstance() *schema.Resource {
@@ -98,6 +99,7 @@ func resourceMVMInstance() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"locked": instanceLockedSchema(),
 			"labels": {
 				Type:        schema.TypeList,
 				Description: "The list of labels to add to the instance",
@@ -134,6 +136,23 @@ func resourceMVMInstance() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"workflow_id"},
 			},
+			"provision_workflow_id": {
+				Description: "The ID of an operational workflow to execute against the instance once it has been provisioned, the apply waits for the workflow to succeed",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"teardown_workflow_id": {
+				Description:   "The ID of an operational workflow to execute against the instance before it is deleted, the destroy waits for the workflow to succeed (`teardown_workflow_name` can be used alternatively, only one is needed)",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"teardown_workflow_name"},
+			},
+			"teardown_workflow_name": {
+				Description:   "The name of an operational workflow to execute against the instance before it is deleted, the destroy waits for the workflow to succeed (`teardown_workflow_id` can be used alternatively, only one is needed)",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"teardown_workflow_id"},
+			},
 			"create_user": {
 				Description: "Whether to create a user account on the instance that is associated with the provisioning user account",
 				Type:        schema.TypeBool,
@@ -279,16 +298,18 @@ func resourceMVMInstance() *schema.Resource {
 						},
 						*/
 						"ip_address": {
-							Description: "The IP address to assign to the instance",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
+							Description:  "The IP address to assign to the instance, required by the static ip mode and checked against the cidr of the network",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.Any(validation.IsIPAddress, validation.StringIsEmpty),
 						},
 						"ip_mode": {
-							Description: "The IP address assignment mode",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
+							Description:  "The IP address assignment mode (dhcp, pool for the next address of the ip pool of the network, static for the ip_address)",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"dhcp", "pool", "static"}, false),
 						},
 						"network_interface_type_id": {
 							Description: "The id of the network interface type",
@@ -303,7 +324,10 @@ func resourceMVMInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"connection_info": instanceConnectionInfoSchema(),
+			"containers":      instanceContainersSchema(),
 		},
+		CustomizeDiff: interfacesCustomizeDiff("network_interface"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -311,7 +335,7 @@ func resourceMVMInstance() *schema.Resource {
 }
 
 func resourceMVMInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -470,9 +494,7 @@ func resourceMVMInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	// Labels
-	if d.Get("labels") != nil {
-		payload["labels"] = d.Get("labels")
-	}
+	payload["labels"] = managedLabelsPayload(d, meta)
 
 	// Provisioning Workflow ID
 	if d.Get("workflow_id") != nil {
@@ -537,6 +559,10 @@ func resourceMVMInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	// Successfully created resource, now set id
 	d.SetId(int64ToString(instance.ID))
+	if err := setInstanceLock(ctx, client, d, true); err != nil {
+		return diag.FromErr(err)
+	}
+
 	resourceMVMInstanceRead(ctx, d, meta)
 
 	// Fail the instance deployment if the
@@ -544,11 +570,23 @@ func resourceMVMInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 	if instanceStatus == "failed" {
 		return diag.Errorf("error creating instance: failed to create server")
 	}
+
+	// Run the provision workflow and wait for it to succeed,
+	// unless the instance did not provision
+	if workflowId, ok := d.GetOk("provision_workflow_id"); ok {
+		if instanceStatus == "denied" || instanceStatus == "cancelled" {
+			return diag.Errorf("error executing provision workflow: instance is %s", instanceStatus)
+		}
+		path := fmt.Sprintf("%s/%d/workflow", morpheus.InstancesPath, instance.ID)
+		if _, err := executeWorkflow(ctx, client, path, workflowId.(int), nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error executing provision workflow: %s", err)
+		}
+	}
 	return diags
 }
 
 func resourceMVMInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -578,6 +616,7 @@ func resourceMVMInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetInstanceResult)
@@ -596,6 +635,7 @@ func resourceMVMInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("plan_id", instance.Plan.ID)
 	d.Set("environment", instance.Environment)
 	d.Set("labels", instance.Labels)
+	d.Set("locked", instance.Locked)
 
 	var evars []map[string]interface{}
 	evarMap := make(map[string]string, len(instance.EnvironmentVariables))
@@ -654,7 +694,9 @@ func resourceMVMInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	d.Set("custom_options", instance.Config["customOptions"])
 	d.Set("domain_id", instance.NetworkDomain.Id)
-	d.Set("primary_ip_address", instance.ConnectionInfo[0].Ip)
+	if len(instance.ConnectionInfo) > 0 {
+		d.Set("primary_ip_address", instance.ConnectionInfo[0].Ip)
+	}
 
 	var volumes []map[string]interface{}
 	// iterate over the array of volumes
@@ -662,13 +704,13 @@ func resourceMVMInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 		row := make(map[string]interface{})
 		volume := instance.Volumes[i]
 		row["uuid"] = volume.Uuid
-		row["root"] = volume.RootVolume.(bool)
+		row["root"] = jsonBoolValue(volume.RootVolume)
 		row["name"] = volume.Name
 		if volume.Size != nil {
-			row["size"] = volume.Size.(float64)
+			row["size"] = jsonFloat64Value(volume.Size)
 		}
 		if volume.StorageType != nil {
-			row["storage_type"] = volume.StorageType.(float64)
+			row["storage_type"] = jsonFloat64Value(volume.StorageType)
 		}
 		if volume.DatastoreId != nil {
 			datastoreId, errConv := convertToInt(volume.DatastoreId)
@@ -723,15 +765,18 @@ func resourceMVMInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 		networkInterfaces = append(networkInterfaces, row)
 	}
 	d.Set("network_interface", networkInterfaces)
+	setInstanceConnectionAttributes(client, d, instance)
 
 	return diags
 }
 
-// convertToInt converts a value to an int, supporting both int and string types.
+// convertToInt converts a value to an int, supporting int, decoded JSON number and string types.
 func convertToInt(value interface{}) (int, error) {
 	switch v := value.(type) {
 	case int:
 		return v, nil
+	case float64:
+		return int(v), nil
 	case string:
 		return strconv.Atoi(v)
 	default:
@@ -740,9 +785,13 @@ func convertToInt(value interface{}) (int, error) {
 }
 
 func resourceMVMInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
+	if err := setInstanceLock(ctx, client, d, false); err != nil {
+		return diag.FromErr(err)
+	}
+
 	instanceGetResp, err := client.GetInstance(toInt64(id), &morpheus.Request{})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", instanceGetResp, err)
@@ -803,7 +852,7 @@ func resourceMVMInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if d.HasChanges("labels") {
-		instancePayload["labels"] = d.Get("labels")
+		instancePayload["labels"] = managedLabelsPayload(d, meta)
 		baseInstanceConfigUpdate = true
 	}
 
@@ -812,7 +861,9 @@ func resourceMVMInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 			"instance": instancePayload,
 		}
 		req := &morpheus.Request{Body: payload}
-		resp, err := client.UpdateInstance(toInt64(id), req)
+		resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+			return client.UpdateInstance(toInt64(id), req)
+		})
 		if err != nil {
 			log.Printf("API FAILURE: %s - %s", resp, err)
 			return diag.FromErr(err)
@@ -881,23 +932,37 @@ func resourceMVMInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	// Successfully updated resource, now set id
 	d.SetId(int64ToString(instance.ID))
+	if err := setInstanceLock(ctx, client, d, true); err != nil {
+		return diag.FromErr(err)
+	}
 	return resourceMVMInstanceRead(ctx, d, meta)
 }
 
 func resourceMVMInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	if diags := checkInstanceUnlocked(d); diags.HasError() {
+		return diags
+	}
+
 	id := d.Id()
+
+	// Run the teardown workflow and wait for it to succeed before deleting the instance
+	if err := executeTeardownWorkflow(ctx, client, d, toInt64(id)); err != nil {
+		return diag.Errorf("error executing teardown workflow: %s", err)
+	}
 	req := &morpheus.Request{
 		QueryParams: map[string]string{},
 	}
 	if USE_FORCE {
 		req.QueryParams["force"] = "true"
 	}
-	resp, err := client.DeleteInstance(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteInstance(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -8,6 +8,7 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNestedWorkflowTask() *schema.Resource {
@@ -72,22 +73,29 @@ func resourceNestedWorkflowTask() *schema.Resource {
 				Optional:    true,
 				Default:     10,
 			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
 			"allow_custom_config": {
 				Type:        schema.TypeBool,
 				Description: "Custom configuration data to pass during the execution of the shell script",
 				Optional:    true,
 				Computed:    true,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the nested workflow task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceNestedWorkflowTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -108,6 +116,7 @@ func resourceNestedWorkflowTaskCreate(ctx context.Context, d *schema.ResourceDat
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -122,10 +131,11 @@ func resourceNestedWorkflowTaskCreate(ctx context.Context, d *schema.ResourceDat
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -143,8 +153,6 @@ func resourceNestedWorkflowTaskCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNestedWorkflowTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -155,9 +163,9 @@ func resourceNestedWorkflowTaskRead(ctx context.Context, d *schema.ResourceData,
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -175,6 +183,8 @@ func resourceNestedWorkflowTaskRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -190,11 +200,11 @@ func resourceNestedWorkflowTaskRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("retry_count", nestedWorkflowTask.RetryCount)
 	d.Set("retry_delay_seconds", nestedWorkflowTask.RetryDelaySeconds)
 	d.Set("allow_custom_config", nestedWorkflowTask.AllowCustomConfig)
+	d.Set("visibility", nestedWorkflowTask.Visibility)
 	return diags
 }
 
 func resourceNestedWorkflowTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -213,6 +223,7 @@ func resourceNestedWorkflowTaskUpdate(ctx context.Context, d *schema.ResourceDat
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -227,10 +238,13 @@ func resourceNestedWorkflowTaskUpdate(ctx context.Context, d *schema.ResourceDat
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -245,14 +259,14 @@ func resourceNestedWorkflowTaskUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNestedWorkflowTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -0,0 +1,519 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetwork() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network resource, creating a network in a cloud supporting it, such as a vSphere port group, an Amazon or Azure subnet or an OpenStack network. The settings specific to the type of network are set with the config attribute, the networks discovered by the cloud sync are managed with the morpheus_cloud_network resource.",
+		CreateContext: resourceNetworkCreate,
+		ReadContext:   resourceNetworkRead,
+		UpdateContext: resourceNetworkUpdate,
+		DeleteContext: resourceNetworkDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the network",
+				Required:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of the network",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the network",
+				Optional:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud the network is created in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "The code of the network type, depending on the type of the cloud (amazonSubnet, azureSubnet, vmwareDistributedPortGroup, etc.)",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"resource_pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud resource pool the network is created in, such as the VPC of an Amazon subnet or the virtual network of an Azure subnet",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Description: "The availability zone of the network, for the clouds with availability zones",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"vlan_id": {
+				Type:         schema.TypeInt,
+				Description:  "The VLAN ID of the network",
+				ValidateFunc: validation.IntBetween(0, 4094),
+				Optional:     true,
+				Computed:     true,
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Description:  "The CIDR of the network",
+				ValidateFunc: validation.IsCIDR,
+				Optional:     true,
+				Computed:     true,
+			},
+			"gateway": {
+				Type:         schema.TypeString,
+				Description:  "The gateway of the network",
+				ValidateFunc: validation.IsIPAddress,
+				Optional:     true,
+				Computed:     true,
+			},
+			"dns_primary": {
+				Type:         schema.TypeString,
+				Description:  "The primary DNS server of the network",
+				ValidateFunc: validation.IsIPAddress,
+				Optional:     true,
+				Computed:     true,
+			},
+			"dns_secondary": {
+				Type:         schema.TypeString,
+				Description:  "The secondary DNS server of the network",
+				ValidateFunc: validation.IsIPAddress,
+				Optional:     true,
+				Computed:     true,
+			},
+			"dhcp_server": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network has a DHCP server, the instances being assigned their ip address by DHCP rather than from the ip pool",
+				Optional:    true,
+				Default:     false,
+			},
+			"allow_static_override": {
+				Type:        schema.TypeBool,
+				Description: "Whether a static ip address can be set on the instances provisioned on the network",
+				Optional:    true,
+				Default:     false,
+			},
+			"pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the ip pool the ip addresses of the instances are assigned from",
+				Optional:    true,
+			},
+			"domain_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the network domain of the instances provisioned on the network",
+				Optional:    true,
+			},
+			"appliance_url_proxy_bypass": {
+				Type:        schema.TypeBool,
+				Description: "Whether the instances provisioned on the network bypass the proxy to reach the appliance url",
+				Optional:    true,
+				Default:     true,
+			},
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Description: "The comma separated list of the hosts the instances provisioned on the network reach without the proxy",
+				Optional:    true,
+			},
+			"scan_network": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network is scanned for the hosts and ip addresses in use",
+				Optional:    true,
+				Default:     false,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network is active, the inactive networks are not available for provisioning",
+				Optional:    true,
+				Default:     true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Determines whether the network is visible in sub-tenants or not (private, public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Default:      "private",
+			},
+			"group_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all groups access to the network",
+				Optional:    true,
+				Default:     false,
+			},
+			"group_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids to grant access to the network",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of tenant ids to grant access to the network",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "The settings specific to the type of network, passed through as is",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the network in the cloud",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the network",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	network := networkPayload(d)
+	network["zone"] = map[string]interface{}{
+		"id": d.Get("cloud_id").(int),
+	}
+	network["type"] = map[string]interface{}{
+		"code": d.Get("type").(string),
+	}
+	if resourcePoolId, ok := d.GetOk("resource_pool_id"); ok {
+		network["zonePool"] = map[string]interface{}{
+			"id": resourcePoolId.(int),
+		}
+	}
+	if availabilityZone, ok := d.GetOk("availability_zone"); ok {
+		network["availabilityZone"] = availabilityZone.(string)
+	}
+	network["resourcePermissions"] = networkResourcePermissions(d)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   morpheus.NetworksPath,
+		Body: map[string]interface{}{
+			"network":           network,
+			"tenantPermissions": networkTenantPermissions(d),
+		},
+		Result: &LocalGetNetworkResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*LocalGetNetworkResult)
+	if result.Network == nil {
+		return diag.Errorf("Network not found in response data.") // should not happen
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.Network.ID))
+
+	resourceNetworkRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := getLocalNetwork(client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	network := resp.Result.(*LocalGetNetworkResult).Network
+	if network == nil {
+		return diag.Errorf("Network not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(network.ID))
+	d.Set("name", network.Name)
+	d.Set("display_name", network.DisplayName)
+	d.Set("description", network.Description)
+	d.Set("cloud_id", network.Zone.ID)
+	d.Set("type", network.Type.Code)
+	if resourcePoolId := jsonRefIdValue(network.ZonePool); resourcePoolId != 0 {
+		d.Set("resource_pool_id", resourcePoolId)
+	}
+	d.Set("availability_zone", network.AvailabilityZone)
+	d.Set("vlan_id", jsonInt64Value(network.VlanId))
+	d.Set("cidr", network.Cidr)
+	d.Set("gateway", network.Gateway)
+	d.Set("dns_primary", network.DnsPrimary)
+	d.Set("dns_secondary", network.DnsSecondary)
+	d.Set("dhcp_server", network.DhcpServer)
+	d.Set("allow_static_override", network.AllowStaticOverride)
+	d.Set("pool_id", jsonRefIdValue(network.Pool))
+	d.Set("domain_id", jsonRefIdValue(network.NetworkDomain))
+	d.Set("appliance_url_proxy_bypass", network.ApplianceUrlProxyBypass)
+	d.Set("no_proxy", network.NoProxy)
+	d.Set("scan_network", network.ScanNetwork)
+	d.Set("active", network.Active)
+	d.Set("visibility", network.Visibility)
+	d.Set("external_id", network.ExternalId)
+	d.Set("status", network.Status)
+	setNetworkPermissions(d, network)
+
+	// only the settings of the configuration are read back, the appliance
+	// adding its own settings to the config of the network
+	config := make(map[string]interface{})
+	for key := range d.Get("config").(map[string]interface{}) {
+		if value, ok := network.Config[key]; ok {
+			config[key] = jsonStringValue(value)
+		}
+	}
+	d.Set("config", config)
+
+	return diags
+}
+
+func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	network := networkPayload(d)
+	network["resourcePermissions"] = networkResourcePermissions(d)
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method: "PUT",
+			Path:   fmt.Sprintf("%s/%s", morpheus.NetworksPath, d.Id()),
+			Body: map[string]interface{}{
+				"network":           network,
+				"tenantPermissions": networkTenantPermissions(d),
+			},
+			Result: &LocalGetNetworkResult{},
+		})
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceNetworkRead(ctx, d, meta)
+}
+
+func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteNetwork(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// networkPayload builds the settings of the network that can be updated
+func networkPayload(d *schema.ResourceData) map[string]interface{} {
+	network := map[string]interface{}{
+		"name":                    d.Get("name").(string),
+		"description":             d.Get("description").(string),
+		"dhcpServer":              d.Get("dhcp_server").(bool),
+		"allowStaticOverride":     d.Get("allow_static_override").(bool),
+		"applianceUrlProxyBypass": d.Get("appliance_url_proxy_bypass").(bool),
+		"noProxy":                 d.Get("no_proxy").(string),
+		"scanNetwork":             d.Get("scan_network").(bool),
+		"active":                  d.Get("active").(bool),
+		"visibility":              d.Get("visibility").(string),
+		"config":                  d.Get("config").(map[string]interface{}),
+	}
+	if displayName, ok := d.GetOk("display_name"); ok {
+		network["displayName"] = displayName.(string)
+	}
+	if vlanId, ok := d.GetOk("vlan_id"); ok {
+		network["vlanId"] = vlanId.(int)
+	}
+	for attribute, field := range map[string]string{"cidr": "cidr", "gateway": "gateway", "dns_primary": "dnsPrimary", "dns_secondary": "dnsSecondary"} {
+		if value, ok := d.GetOk(attribute); ok {
+			network[field] = value.(string)
+		}
+	}
+	// the references are cleared with a null id
+	network["pool"] = map[string]interface{}{
+		"id": nil,
+	}
+	if poolId, ok := d.GetOk("pool_id"); ok {
+		network["pool"] = map[string]interface{}{
+			"id": poolId.(int),
+		}
+	}
+	network["networkDomain"] = map[string]interface{}{
+		"id": nil,
+	}
+	if domainId, ok := d.GetOk("domain_id"); ok {
+		network["networkDomain"] = map[string]interface{}{
+			"id": domainId.(int),
+		}
+	}
+	return network
+}
+
+// networkResourcePermissions builds the group permissions of a network
+func networkResourcePermissions(d *schema.ResourceData) map[string]interface{} {
+	sites := make([]map[string]interface{}, 0)
+	for _, groupId := range d.Get("group_access_ids").(*schema.Set).List() {
+		sites = append(sites, map[string]interface{}{
+			"id": groupId.(int),
+		})
+	}
+	return map[string]interface{}{
+		"all":   d.Get("group_access_all").(bool),
+		"sites": sites,
+	}
+}
+
+// networkTenantPermissions builds the tenant permissions of a network
+func networkTenantPermissions(d *schema.ResourceData) TenantPermission {
+	var tenantPerm TenantPermission
+	for _, tenantId := range d.Get("tenant_ids").(*schema.Set).List() {
+		tenantPerm.Accounts = append(tenantPerm.Accounts, tenantId.(int))
+	}
+	return tenantPerm
+}
+
+// setNetworkPermissions stores the group and tenant permissions of a network
+func setNetworkPermissions(d *schema.ResourceData, network *LocalNetwork) {
+	var groupIds []int64
+	for _, site := range network.ResourcePermission.Sites {
+		groupIds = append(groupIds, site.ID)
+	}
+	d.Set("group_access_all", jsonBoolValue(network.ResourcePermission.All))
+	d.Set("group_access_ids", groupIds)
+
+	var tenantIds []int64
+	for _, tenant := range network.Tenants {
+		tenantIds = append(tenantIds, tenant.ID)
+	}
+	d.Set("tenant_ids", tenantIds)
+}
+
+// getLocalNetwork gets a network, the sdk typing the references of the
+// network to its ip pool and domain as strings while the appliance
+// returns them as objects
+func getLocalNetwork(client *apiClient, id string) (*morpheus.Response, error) {
+	return client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%s", morpheus.NetworksPath, id),
+		Result: &LocalGetNetworkResult{},
+	})
+}
+
+type LocalNetwork struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+	Zone        struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"zone"`
+	Type struct {
+		ID   int64  `json:"id"`
+		Code string `json:"code"`
+		Name string `json:"name"`
+	} `json:"type"`
+	ZonePool                interface{}            `json:"zonePool"`
+	AvailabilityZone        string                 `json:"availabilityZone"`
+	VlanId                  interface{}            `json:"vlanId"`
+	Cidr                    string                 `json:"cidr"`
+	Gateway                 string                 `json:"gateway"`
+	DnsPrimary              string                 `json:"dnsPrimary"`
+	DnsSecondary            string                 `json:"dnsSecondary"`
+	DhcpServer              bool                   `json:"dhcpServer"`
+	AllowStaticOverride     bool                   `json:"allowStaticOverride"`
+	Pool                    interface{}            `json:"pool"`
+	NetworkDomain           interface{}            `json:"networkDomain"`
+	ApplianceUrlProxyBypass bool                   `json:"applianceUrlProxyBypass"`
+	NoProxy                 string                 `json:"noProxy"`
+	ScanNetwork             bool                   `json:"scanNetwork"`
+	Active                  bool                   `json:"active"`
+	Visibility              string                 `json:"visibility"`
+	ExternalId              string                 `json:"externalId"`
+	Status                  string                 `json:"status"`
+	Config                  map[string]interface{} `json:"config"`
+	Tenants                 []struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"tenants"`
+	ResourcePermission struct {
+		All   interface{} `json:"all"`
+		Sites []struct {
+			ID      int64  `json:"id"`
+			Name    string `json:"name"`
+			Default bool   `json:"default"`
+		} `json:"sites"`
+	} `json:"resourcePermission"`
+}
+
+type LocalListNetworksResult struct {
+	Networks []LocalNetwork `json:"networks"`
+}
+
+type LocalGetNetworkResult struct {
+	Network *LocalNetwork `json:"network"`
+}
+
+type LocalUpdateNetworkResult struct {
+	Success bool          `json:"success"`
+	Message string        `json:"msg"`
+	Network *LocalNetwork `json:"network"`
+}
@@ -0,0 +1,171 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetworkFloatingIP() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network floating ip resource, allocating a floating ip, such as an OpenStack floating ip or an AWS elastic ip, in a cloud. The floating ip is released on destroy.",
+		CreateContext: resourceNetworkFloatingIPCreate,
+		ReadContext:   resourceNetworkFloatingIPRead,
+		DeleteContext: resourceNetworkFloatingIPDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the floating ip",
+				Computed:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud the floating ip is allocated in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the floating ip pool, such as the external network of an OpenStack cloud, the floating ip is allocated from, the default pool of the cloud when not set",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Description: "The floating ip address allocated",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the floating ip",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the floating ip (free, assigned, ...)",
+				Computed:    true,
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the floating ip in the cloud",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetworkFloatingIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	floatingIP := map[string]interface{}{
+		"cloud": map[string]interface{}{
+			"id": d.Get("cloud_id").(int),
+		},
+	}
+	if poolId, ok := d.GetOk("pool_id"); ok {
+		floatingIP["ipPool"] = map[string]interface{}{
+			"id": poolId.(int),
+		}
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   networkFloatingIPsPath,
+		Body: map[string]interface{}{
+			"networkFloatingIp": floatingIP,
+		},
+		Result: &UpdateNetworkFloatingIPResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*UpdateNetworkFloatingIPResult)
+	if !result.Success && result.Message != "" {
+		return diag.Errorf("error allocating floating ip: %s", result.Message)
+	}
+	if result.NetworkFloatingIP == nil {
+		return diag.Errorf("Floating ip not found in response data.") // should not happen
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.NetworkFloatingIP.ID))
+
+	return resourceNetworkFloatingIPRead(ctx, d, meta)
+}
+
+func resourceNetworkFloatingIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%s", networkFloatingIPsPath, d.Id()),
+		Result: &GetNetworkFloatingIPResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	floatingIP := resp.Result.(*GetNetworkFloatingIPResult).NetworkFloatingIP
+	if floatingIP == nil {
+		return diag.Errorf("Floating ip not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(floatingIP.ID))
+	d.Set("cloud_id", floatingIP.Cloud.ID)
+	d.Set("ip_address", floatingIP.IpAddress)
+	d.Set("name", floatingIP.Name)
+	d.Set("status", floatingIP.IpStatus)
+	d.Set("external_id", floatingIP.ExternalId)
+
+	return diags
+}
+
+func resourceNetworkFloatingIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method: "PUT",
+			Path:   fmt.Sprintf("%s/%s/release", networkFloatingIPsPath, d.Id()),
+			Result: &UpdateNetworkFloatingIPResult{},
+		})
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
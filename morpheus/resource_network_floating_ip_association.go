@@ -0,0 +1,152 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetworkFloatingIPAssociation() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network floating ip association resource, associating a floating ip with the server of an instance to give it a public ip address. The floating ip is disassociated on destroy.",
+		CreateContext: resourceNetworkFloatingIPAssociationCreate,
+		ReadContext:   resourceNetworkFloatingIPAssociationRead,
+		DeleteContext: resourceNetworkFloatingIPAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the floating ip association, the ID of the floating ip",
+				Computed:    true,
+			},
+			"floating_ip_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the floating ip to associate",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"server_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the server the floating ip is associated with, such as the server of an instance container",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Description: "The floating ip address associated with the server",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetworkFloatingIPAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	floatingIPId := d.Get("floating_ip_id").(int)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method: "PUT",
+			Path:   fmt.Sprintf("%s/%d/associate", networkFloatingIPsPath, floatingIPId),
+			Body: map[string]interface{}{
+				"networkFloatingIp": map[string]interface{}{
+					"server": map[string]interface{}{
+						"id": d.Get("server_id").(int),
+					},
+				},
+			},
+			Result: &UpdateNetworkFloatingIPResult{},
+		})
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*UpdateNetworkFloatingIPResult)
+	if !result.Success && result.Message != "" {
+		return diag.Errorf("error associating floating ip: %s", result.Message)
+	}
+	// Successfully created resource, now set id
+	d.SetId(intToString(floatingIPId))
+
+	return resourceNetworkFloatingIPAssociationRead(ctx, d, meta)
+}
+
+func resourceNetworkFloatingIPAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%s", networkFloatingIPsPath, d.Id()),
+		Result: &GetNetworkFloatingIPResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	floatingIP := resp.Result.(*GetNetworkFloatingIPResult).NetworkFloatingIP
+	if floatingIP == nil {
+		return diag.Errorf("Floating ip not found in response data.") // should not happen
+	}
+	if floatingIP.Server.ID == 0 {
+		// the floating ip has been disassociated outside of terraform
+		log.Printf("Floating ip %d is not associated, forcing recreation of resource", floatingIP.ID)
+		d.SetId("")
+		return diags
+	}
+	d.SetId(int64ToString(floatingIP.ID))
+	d.Set("floating_ip_id", floatingIP.ID)
+	d.Set("server_id", floatingIP.Server.ID)
+	d.Set("ip_address", floatingIP.IpAddress)
+
+	return diags
+}
+
+func resourceNetworkFloatingIPAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method: "PUT",
+			Path:   fmt.Sprintf("%s/%s/disassociate", networkFloatingIPsPath, d.Id()),
+			Result: &UpdateNetworkFloatingIPResult{},
+		})
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
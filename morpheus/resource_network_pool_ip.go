@@ -0,0 +1,324 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkPoolIP() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network pool ip resource, reserving an ip address of a Morpheus ip pool for a host, either a given ip address or the next free ip address of the ranges of the pool, so the static ip address of an instance can be known before it is provisioned.",
+		CreateContext: resourceNetworkPoolIPCreate,
+		ReadContext:   resourceNetworkPoolIPRead,
+		UpdateContext: resourceNetworkPoolIPUpdate,
+		DeleteContext: resourceNetworkPoolIPDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network pool ip",
+				Computed:    true,
+			},
+			"pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the ip pool the ip address is reserved in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "The ip address reserved, the next free ip address of the ranges of the pool when not set",
+				ValidateFunc: validation.IsIPv4Address,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Description: "The hostname the ip address is reserved for",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the reservation",
+				Optional:    true,
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Description: "The fully qualified domain name of the host the ip address is reserved for",
+				Computed:    true,
+			},
+			"ip_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the ip address (reserved, assigned)",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetworkPoolIPImport,
+		},
+	}
+}
+
+func resourceNetworkPoolIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	poolId := int64(d.Get("pool_id").(int))
+
+	// the next free ip address is computed from the reserved ones, the
+	// reservations of a pool are serialized for the parallel creates not to
+	// pick the same address
+	unlock := lockNetworkPool(meta, poolId)
+	defer unlock()
+
+	ipAddress := d.Get("ip_address").(string)
+	if ipAddress == "" {
+		var err error
+		ipAddress, err = networkPoolNextFreeIP(client, poolId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("%s/%d/ips", morpheus.NetworkPoolsPath, poolId),
+		Body: map[string]interface{}{
+			"networkPoolIp": map[string]interface{}{
+				"ipAddress":   ipAddress,
+				"hostname":    d.Get("hostname").(string),
+				"description": d.Get("description").(string),
+			},
+		},
+		Result: &LocalNetworkPoolIPResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	networkPoolIP := resp.Result.(*LocalNetworkPoolIPResult).NetworkPoolIP
+	if networkPoolIP == nil {
+		return diag.Errorf("Network pool ip not found in response data.") // should not happen
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(networkPoolIP.ID))
+
+	resourceNetworkPoolIPRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkPoolIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%d/ips/%s", morpheus.NetworkPoolsPath, d.Get("pool_id").(int), d.Id()),
+		Result: &LocalNetworkPoolIPResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	networkPoolIP := resp.Result.(*LocalNetworkPoolIPResult).NetworkPoolIP
+	if networkPoolIP == nil {
+		return diag.Errorf("Network pool ip not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(networkPoolIP.ID))
+	d.Set("ip_address", networkPoolIP.IpAddress)
+	d.Set("hostname", networkPoolIP.Hostname)
+	d.Set("description", networkPoolIP.Description)
+	d.Set("fqdn", networkPoolIP.Fqdn)
+	d.Set("ip_type", networkPoolIP.IpType)
+
+	return diags
+}
+
+func resourceNetworkPoolIPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method: "PUT",
+			Path:   fmt.Sprintf("%s/%d/ips/%s", morpheus.NetworkPoolsPath, d.Get("pool_id").(int), d.Id()),
+			Body: map[string]interface{}{
+				"networkPoolIp": map[string]interface{}{
+					"hostname":    d.Get("hostname").(string),
+					"description": d.Get("description").(string),
+				},
+			},
+			Result: &LocalNetworkPoolIPResult{},
+		})
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceNetworkPoolIPRead(ctx, d, meta)
+}
+
+func resourceNetworkPoolIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	poolId := int64(d.Get("pool_id").(int))
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteNetworkPoolIPAddress(poolId, toInt64(d.Id()), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// resourceNetworkPoolIPImport imports a network pool ip using
+// the <pool_id>:<network_pool_ip_id> format
+func resourceNetworkPoolIPImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importData := strings.SplitN(d.Id(), ":", 2)
+	if len(importData) != 2 || importData[0] == "" || importData[1] == "" {
+		return nil, fmt.Errorf("unexpected format of import id (%s), expected <pool_id>:<network_pool_ip_id>", d.Id())
+	}
+	poolId, err := strconv.Atoi(importData[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid pool id %s: %s", importData[0], err)
+	}
+	if _, err := strconv.Atoi(importData[1]); err != nil {
+		return nil, fmt.Errorf("invalid network pool ip id %s: %s", importData[1], err)
+	}
+	d.Set("pool_id", poolId)
+	d.SetId(importData[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// lockNetworkPool locks the mutex of a network pool, returning the function
+// unlocking it. Only the reservations of a provider instance are serialized,
+// another client can still reserve the computed address meanwhile.
+func lockNetworkPool(meta interface{}, poolId int64) func() {
+	value, _ := meta.(*providerMeta).networkPoolLocks.LoadOrStore(poolId, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// networkPoolNextFreeIP returns the lowest ip address of the ranges of
+// a pool that is neither reserved nor assigned
+func networkPoolNextFreeIP(client *apiClient, poolId int64) (string, error) {
+	resp, err := client.GetNetworkPool(poolId, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return "", err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	pool := resp.Result.(*morpheus.GetNetworkPoolResult).NetworkPool
+	if pool == nil {
+		return "", fmt.Errorf("pool not found in response data") // should not happen
+	}
+
+	// the ip addresses of the pool are listed a page at a time
+	used := make(map[netip.Addr]bool)
+	for offset := 0; ; offset += networkPoolIPsPageSize {
+		resp, err := client.Execute(&morpheus.Request{
+			Method: "GET",
+			Path:   fmt.Sprintf("%s/%d/ips", morpheus.NetworkPoolsPath, poolId),
+			QueryParams: map[string]string{
+				"max":    strconv.Itoa(networkPoolIPsPageSize),
+				"offset": strconv.Itoa(offset),
+			},
+			Result: &LocalListNetworkPoolIPsResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return "", err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		networkPoolIPs := resp.Result.(*LocalListNetworkPoolIPsResult).NetworkPoolIPs
+		for _, networkPoolIP := range networkPoolIPs {
+			if addr, err := netip.ParseAddr(networkPoolIP.IpAddress); err == nil {
+				used[addr] = true
+			}
+		}
+		if len(networkPoolIPs) < networkPoolIPsPageSize {
+			break
+		}
+	}
+
+	ipRanges := pool.IpRanges
+	sort.Slice(ipRanges, func(i, j int) bool { return ipRanges[i].ID < ipRanges[j].ID })
+	for _, ipRange := range ipRanges {
+		start, err := netip.ParseAddr(ipRange.StartAddress)
+		if err != nil {
+			continue
+		}
+		end, err := netip.ParseAddr(ipRange.EndAddress)
+		if err != nil {
+			continue
+		}
+		for addr := start; addr.IsValid() && addr.Compare(end) <= 0; addr = addr.Next() {
+			if !used[addr] {
+				return addr.String(), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no free ip address left in pool %s", pool.Name)
+}
+
+const networkPoolIPsPageSize = 1000
+
+type LocalNetworkPoolIP struct {
+	ID          int64  `json:"id"`
+	IpType      string `json:"ipType"`
+	IpAddress   string `json:"ipAddress"`
+	Hostname    string `json:"hostname"`
+	Fqdn        string `json:"fqdn"`
+	Description string `json:"description"`
+}
+
+type LocalListNetworkPoolIPsResult struct {
+	NetworkPoolIPs []LocalNetworkPoolIP `json:"networkPoolIps"`
+}
+
+type LocalNetworkPoolIPResult struct {
+	NetworkPoolIP *LocalNetworkPoolIP `json:"networkPoolIp"`
+}
@@ -201,7 +201,7 @@ func resourceNodeType() *schema.Resource {
 }
 
 func resourceNodeTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -247,6 +247,7 @@ func resourceNodeTypeCreate(ctx context.Context, d *schema.ResourceData, meta in
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	containerType["labels"] = labelsPayload
 
 	req := &morpheus.Request{
@@ -272,7 +273,7 @@ func resourceNodeTypeCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceNodeTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -304,6 +305,7 @@ func resourceNodeTypeRead(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var nodeType NodeTypePayload
@@ -362,7 +364,7 @@ func resourceNodeTypeRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceNodeTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	name := d.Get("name").(string)
@@ -404,7 +406,9 @@ func resourceNodeTypeUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		},
 	}
 
-	resp, err := client.UpdateNodeType(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateNodeType(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -419,14 +423,16 @@ func resourceNodeTypeUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceNodeTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteNodeType(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteNodeType(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -120,16 +120,17 @@ func resourceNumberOptionType() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"force_delete": forceDeleteSchema(),
+			"tenant_id":    tenantIdSchema("The id of the tenant the number option type is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceNumberOptionTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -141,6 +142,7 @@ func resourceNumberOptionTypeCreate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"optionType": map[string]interface{}{
@@ -164,7 +166,7 @@ func resourceNumberOptionTypeCreate(ctx context.Context, d *schema.ResourceData,
 			},
 		},
 	}
-	resp, err := client.CreateOptionType(req)
+	resp, err := optionTypeApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -181,7 +183,6 @@ func resourceNumberOptionTypeCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNumberOptionTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -192,9 +193,9 @@ func resourceNumberOptionTypeRead(ctx context.Context, d *schema.ResourceData, m
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindOptionTypeByName(name)
+		resp, err = optionTypeApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetOptionType(toInt64(id), &morpheus.Request{})
+		resp, err = optionTypeApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("OptionType cannot be read without name or id")
 	}
@@ -211,6 +212,8 @@ func resourceNumberOptionTypeRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetOptionTypeResult)
@@ -242,7 +245,6 @@ func resourceNumberOptionTypeRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceNumberOptionTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -252,6 +254,7 @@ func resourceNumberOptionTypeUpdate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"optionType": map[string]interface{}{
@@ -275,7 +278,9 @@ func resourceNumberOptionTypeUpdate(ctx context.Context, d *schema.ResourceData,
 			},
 		},
 	}
-	resp, err := client.UpdateOptionType(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return optionTypeApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -290,14 +295,19 @@ func resourceNumberOptionTypeUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNumberOptionTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteOptionType(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "option type", func() ([]string, error) {
+		return optionTypeDependents(meta, d, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return optionTypeApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -2,6 +2,7 @@ package morpheus
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
@@ -72,22 +73,40 @@ func resourceOperationalWorkflow() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			"task_failure": {
+				Type:        schema.TypeList,
+				Description: "The handling of the failure of the tasks of the operational workflow, the settings apply to every occurrence of the task in task_ids",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: workflowTaskFailureSchema(map[string]*schema.Schema{
+						"task_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of a task of the task_ids",
+							Required:    true,
+						},
+					}),
+				},
+			},
+			"tenant_id": tenantIdSchema("The id of the tenant the operational workflow is created in, the tenant_id argument of the provider or the tenant of the user when not set. The tasks of the workflow must be visible to the tenant"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceOperationalWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	// tasks
+	failureConfigs, err := operationalWorkflowTaskFailureConfigs(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	var tasks []map[string]interface{}
 	if d.Get("task_ids") != nil {
 		taskList := d.Get("task_ids").([]interface{})
@@ -96,6 +115,9 @@ func resourceOperationalWorkflowCreate(ctx context.Context, d *schema.ResourceDa
 			row := make(map[string]interface{})
 			row["taskId"] = taskList[i]
 			row["taskPhase"] = "operation"
+			if taskconfig, ok := failureConfigs[taskList[i].(int)]; ok {
+				workflowTaskFailurePayload(row, taskconfig)
+			}
 			tasks = append(tasks, row)
 		}
 	}
@@ -106,6 +128,7 @@ func resourceOperationalWorkflowCreate(ctx context.Context, d *schema.ResourceDa
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -123,7 +146,7 @@ func resourceOperationalWorkflowCreate(ctx context.Context, d *schema.ResourceDa
 		},
 	}
 
-	resp, err := client.CreateTaskSet(req)
+	resp, err := taskSetApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -140,8 +163,6 @@ func resourceOperationalWorkflowCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceOperationalWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -152,9 +173,9 @@ func resourceOperationalWorkflowRead(ctx context.Context, d *schema.ResourceData
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskSetByName(name)
+		resp, err = taskSetApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTaskSet(toInt64(id), &morpheus.Request{})
+		resp, err = taskSetApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("TaskSet cannot be read without name or id")
 	}
@@ -171,6 +192,8 @@ func resourceOperationalWorkflowRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskSetResult)
@@ -185,13 +208,14 @@ func resourceOperationalWorkflowRead(ctx context.Context, d *schema.ResourceData
 		if workflow.OptionTypes != nil {
 			// iterate over the array of tasks
 			for i := 0; i < len(workflow.OptionTypes); i++ {
-				option := workflow.OptionTypes[i].(map[string]interface{})
-				optionID := int64(option["id"].(float64))
-				optionTypes = append(optionTypes, optionID)
+				if option, ok := workflow.OptionTypes[i].(map[string]interface{}); ok {
+					optionTypes = append(optionTypes, jsonInt64Value(option["id"]))
+				}
 			}
 		}
 		d.Set("option_types", optionTypes)
 		d.Set("task_ids", workflow.Tasks)
+		d.Set("task_failure", operationalWorkflowTaskFailures(d, workflow, workflowTaskFailureSettings(resp)))
 		d.Set("visibility", workflow.Visibility)
 		d.Set("allow_custom_config", workflow.AllowCustomConfig)
 		d.Set("platform", workflow.Platform)
@@ -203,12 +227,15 @@ func resourceOperationalWorkflowRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceOperationalWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 
 	// tasks
+	failureConfigs, err := operationalWorkflowTaskFailureConfigs(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	var tasks []map[string]interface{}
 	if d.Get("task_ids") != nil {
 		taskList := d.Get("task_ids").([]interface{})
@@ -217,6 +244,9 @@ func resourceOperationalWorkflowUpdate(ctx context.Context, d *schema.ResourceDa
 			row := make(map[string]interface{})
 			row["taskId"] = taskList[i]
 			row["taskPhase"] = "operation"
+			if taskconfig, ok := failureConfigs[taskList[i].(int)]; ok {
+				workflowTaskFailurePayload(row, taskconfig)
+			}
 			tasks = append(tasks, row)
 		}
 	}
@@ -227,6 +257,7 @@ func resourceOperationalWorkflowUpdate(ctx context.Context, d *schema.ResourceDa
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -243,7 +274,9 @@ func resourceOperationalWorkflowUpdate(ctx context.Context, d *schema.ResourceDa
 			},
 		},
 	}
-	resp, err := client.UpdateTaskSet(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskSetApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -258,14 +291,14 @@ func resourceOperationalWorkflowUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceOperationalWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTaskSet(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskSetApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -279,3 +312,67 @@ func resourceOperationalWorkflowDelete(ctx context.Context, d *schema.ResourceDa
 	d.SetId("")
 	return diags
 }
+
+// operationalWorkflowTaskFailureConfigs returns the task_failure blocks keyed
+// by the id of their task, the task must be one of the task_ids
+func operationalWorkflowTaskFailureConfigs(d *schema.ResourceData) (map[int]map[string]interface{}, error) {
+	taskIds := make(map[int]bool)
+	for _, taskId := range d.Get("task_ids").([]interface{}) {
+		taskIds[taskId.(int)] = true
+	}
+	configs := make(map[int]map[string]interface{})
+	for _, item := range d.Get("task_failure").([]interface{}) {
+		taskConfig := item.(map[string]interface{})
+		taskId := taskConfig["task_id"].(int)
+		if !taskIds[taskId] {
+			return nil, fmt.Errorf("the task_failure block of the task %d does not match any of the task_ids", taskId)
+		}
+		if _, ok := configs[taskId]; ok {
+			return nil, fmt.Errorf("the task %d has more than one task_failure block", taskId)
+		}
+		configs[taskId] = taskConfig
+	}
+	return configs, nil
+}
+
+// operationalWorkflowTaskFailures returns the task_failure blocks of the tasks
+// configured with one and of the tasks whose failure handling is not the
+// default one
+func operationalWorkflowTaskFailures(d *schema.ResourceData, workflow *morpheus.TaskSet, settings map[int64]map[string]interface{}) []map[string]interface{} {
+	configured := make(map[int]bool)
+	var order []int
+	for _, item := range d.Get("task_failure").([]interface{}) {
+		taskId := item.(map[string]interface{})["task_id"].(int)
+		configured[taskId] = true
+		order = append(order, taskId)
+	}
+
+	taskFailures := make(map[int]map[string]interface{})
+	for _, task := range workflow.TaskSetTasks {
+		taskId := int(task.Task.ID)
+		setting, ok := settings[task.ID]
+		if !ok || taskFailures[taskId] != nil {
+			continue
+		}
+		isDefault := !setting["continue_on_failure"].(bool) && !setting["allow_retry"].(bool) && setting["failure_workflow_id"].(int) == 0
+		if isDefault && !configured[taskId] {
+			continue
+		}
+		taskFailure := map[string]interface{}{"task_id": taskId}
+		for key, value := range setting {
+			taskFailure[key] = value
+		}
+		taskFailures[taskId] = taskFailure
+		if !configured[taskId] {
+			order = append(order, taskId)
+		}
+	}
+
+	var result []map[string]interface{}
+	for _, taskId := range order {
+		if taskFailure, ok := taskFailures[taskId]; ok {
+			result = append(result, taskFailure)
+		}
+	}
+	return result
+}
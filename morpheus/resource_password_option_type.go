@@ -126,16 +126,17 @@ func resourcePasswordOptionType() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"force_delete": forceDeleteSchema(),
+			"tenant_id":    tenantIdSchema("The id of the tenant the password option type is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourcePasswordOptionTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -147,6 +148,7 @@ func resourcePasswordOptionTypeCreate(ctx context.Context, d *schema.ResourceDat
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"optionType": map[string]interface{}{
@@ -171,7 +173,7 @@ func resourcePasswordOptionTypeCreate(ctx context.Context, d *schema.ResourceDat
 			},
 		},
 	}
-	resp, err := client.CreateOptionType(req)
+	resp, err := optionTypeApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -188,7 +190,6 @@ func resourcePasswordOptionTypeCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourcePasswordOptionTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -199,9 +200,9 @@ func resourcePasswordOptionTypeRead(ctx context.Context, d *schema.ResourceData,
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindOptionTypeByName(name)
+		resp, err = optionTypeApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetOptionType(toInt64(id), &morpheus.Request{})
+		resp, err = optionTypeApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("OptionType cannot be read without name or id")
 	}
@@ -218,6 +219,8 @@ func resourcePasswordOptionTypeRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetOptionTypeResult)
@@ -250,7 +253,6 @@ func resourcePasswordOptionTypeRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourcePasswordOptionTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -260,6 +262,7 @@ func resourcePasswordOptionTypeUpdate(ctx context.Context, d *schema.ResourceDat
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"optionType": map[string]interface{}{
@@ -283,7 +286,9 @@ func resourcePasswordOptionTypeUpdate(ctx context.Context, d *schema.ResourceDat
 				"verifyPattern":         d.Get("verify_pattern").(string)},
 		},
 	}
-	resp, err := client.UpdateOptionType(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return optionTypeApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -298,14 +303,19 @@ func resourcePasswordOptionTypeUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourcePasswordOptionTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteOptionType(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "option type", func() ([]string, error) {
+		return optionTypeDependents(meta, d, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return optionTypeApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -0,0 +1,274 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePersonalAccessToken() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus personal access token resource, regenerating the API access token of a user for an API client. The token is regenerated when the keepers change and when it expires, a token expiring within the renewal window or cleared outside of terraform being removed from the state on refresh so the next apply issues a new one. A token regenerated outside of terraform is not detected, the appliance does not return its value.",
+		CreateContext: resourcePersonalAccessTokenCreate,
+		ReadContext:   resourcePersonalAccessTokenRead,
+		DeleteContext: resourcePersonalAccessTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the personal access token, the ID of the user and the API client separated by a colon",
+				Computed:    true,
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the user the token is issued to, the user the provider is authenticated as when not set",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"client_id": {
+				Type:         schema.TypeString,
+				Description:  "The API client the token is issued for (morph-api, morph-cli, etc.). Regenerating the token of the client and user the provider is authenticated with ends the session of the provider.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"renewal_hours": {
+				Type:         schema.TypeInt,
+				Description:  "The number of hours before the expiration of the token from which it is regenerated, lower than the lifetime of the tokens",
+				Optional:     true,
+				Default:      0,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"keepers": keepersSchema("Arbitrary values that, when changed, regenerate the token", true),
+			"access_token": {
+				Type:        schema.TypeString,
+				Description: "The API access token",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"refresh_token": {
+				Type:        schema.TypeString,
+				Description: "The refresh token of the API access token, when returned by the appliance",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Description: "The expiration date of the token, in RFC 3339 format, empty when the token does not expire",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourcePersonalAccessTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	userId := d.Get("user_id").(int)
+	if userId == 0 {
+		resp, err := client.Whoami()
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		result := resp.Result.(*morpheus.WhoamiResult)
+		if result.User == nil {
+			return diag.Errorf("Authenticated user not found in response data.") // should not happen
+		}
+		userId = int(result.User.ID)
+	}
+	clientId := d.Get("client_id").(string)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   "/api/user-settings/regenerate-access-token",
+		QueryParams: map[string]string{
+			"clientId": clientId,
+			"userId":   strconv.Itoa(userId),
+		},
+		Body:   map[string]interface{}{},
+		Result: &RegenerateAccessTokenResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*RegenerateAccessTokenResult)
+	accessToken := result.Token
+	if accessToken == "" {
+		accessToken = result.AccessToken
+	}
+	if accessToken == "" {
+		return diag.Errorf("Access token not found in response data.") // should not happen
+	}
+
+	// Successfully created resource, now set id
+	d.SetId(fmt.Sprintf("%d:%s", userId, clientId))
+	d.Set("user_id", userId)
+	d.Set("access_token", accessToken)
+	d.Set("refresh_token", result.RefreshToken)
+
+	// a renewal window longer than the lifetime of the tokens removes the
+	// regenerated token from the state as soon as it is read
+	diags = resourcePersonalAccessTokenRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	if d.Id() == "" {
+		if expiration := d.Get("expiration").(string); expiration != "" {
+			return diag.Errorf("the regenerated token expires at %s, within the renewal window of %d hours: renewal_hours must be lower than the lifetime of the tokens", expiration, d.Get("renewal_hours").(int))
+		}
+		return diag.Errorf("the regenerated token of the API client %s was not found", clientId)
+	}
+	return diags
+}
+
+func resourcePersonalAccessTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	userId := d.Get("user_id").(int)
+	clientId := d.Get("client_id").(string)
+
+	expiration, found, resp, err := userAccessTokenExpiration(client, int64(userId), clientId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// the token is gone when it was cleared outside of terraform, or when it
+	// enters its renewal window. The appliance does not return the value of
+	// the token, a token regenerated outside of terraform is not detected.
+	if !found {
+		log.Printf("Personal access token %s not found, forcing recreation of resource", d.Id())
+		d.SetId("")
+		return diags
+	}
+	d.Set("expiration", expiration)
+	if accessTokenRenewalDue(expiration, d.Get("renewal_hours").(int)) {
+		log.Printf("Personal access token %s expires at %s, forcing recreation of resource", d.Id(), expiration)
+		d.SetId("")
+	}
+	return diags
+}
+
+// userAccessTokenExpiration returns the expiration date of the API access
+// token of a user for an API client, in RFC 3339 format and empty when the
+// token does not expire. found is false when the user has no token for the
+// client.
+func userAccessTokenExpiration(client *apiClient, userId int64, clientId string) (expiration string, found bool, resp *morpheus.Response, err error) {
+	resp, err = client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   "/api/user-settings",
+		QueryParams: map[string]string{
+			"userId": strconv.FormatInt(userId, 10),
+		},
+		Result: &UserSettingsResult{},
+	})
+	if err != nil {
+		return "", false, resp, err
+	}
+	result := resp.Result.(*UserSettingsResult)
+	for _, accessToken := range result.User.AccessTokens {
+		if accessToken.ClientId != clientId {
+			continue
+		}
+		if accessToken.Expiration == "" {
+			return "", true, resp, nil
+		}
+		t, err := time.Parse(time.RFC3339, accessToken.Expiration)
+		if err != nil {
+			return "", true, resp, err
+		}
+		return t.UTC().Format(time.RFC3339), true, resp, nil
+	}
+	return "", false, resp, nil
+}
+
+// accessTokenRenewalDue reports whether a token expiring at the given date,
+// in RFC 3339 format, is within its renewal window. A token without an
+// expiration date is never renewed.
+func accessTokenRenewalDue(expiration string, renewalHours int) bool {
+	if expiration == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, expiration)
+	if err != nil {
+		return false
+	}
+	renewal := time.Duration(renewalHours) * time.Hour
+	return time.Now().Add(renewal).After(t)
+}
+
+func resourcePersonalAccessTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method: "DELETE",
+			Path:   "/api/user-settings/clear-access-token",
+			QueryParams: map[string]string{
+				"clientId": d.Get("client_id").(string),
+				"userId":   strconv.Itoa(d.Get("user_id").(int)),
+			},
+		})
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+type RegenerateAccessTokenResult struct {
+	Success      bool   `json:"success"`
+	Token        string `json:"token"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type UserSettingsResult struct {
+	User struct {
+		ID           int64 `json:"id"`
+		AccessTokens []struct {
+			ID         int64  `json:"id"`
+			ClientId   string `json:"clientId"`
+			Username   string `json:"username"`
+			Expiration string `json:"expiration"`
+		} `json:"accessTokens"`
+	} `json:"user"`
+}
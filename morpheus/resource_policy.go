@@ -0,0 +1,363 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a generic Morpheus policy resource for policy types that do not have a dedicated resource yet. The policy settings are passed through as is, so consult the API documentation of the policy type for the supported config keys.",
+		CreateContext: resourcePolicyCreate,
+		ReadContext:   resourcePolicyRead,
+		UpdateContext: resourcePolicyUpdate,
+		DeleteContext: resourcePolicyDelete,
+		CustomizeDiff: resourcePolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the policy",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the policy",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the policy",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the policy is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"policy_type": {
+				Type:        schema.TypeString,
+				Description: "The code of the policy type (e.g. maxVms, provisionApproval, networkQuota)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"config": jsonStringSchema(&schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The type specific settings of the policy in JSON format",
+				Optional:    true,
+				Default:     "{}",
+			}),
+			"scope": {
+				Type:        schema.TypeList,
+				Description: "The filter or scope that the policy is applied to, the policy is global when not set",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "The type of the scope (global, group, cloud, user, role)",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role"}, false),
+						},
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The id of the group, cloud, user or role the policy is applied to, required unless the scope type is global",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"apply_to_each_user": {
+							Type:        schema.TypeBool,
+							Description: "Whether to apply the policy to each user of the role individually",
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeList,
+				Description: "A list of tenant IDs to assign the policy to",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// resourcePolicyCustomizeDiff ensures the id of the group, cloud, user or
+// role a policy is applied to is set, the policy would not be scoped otherwise
+func resourcePolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	scopes := d.Get("scope").([]interface{})
+	if len(scopes) == 0 || scopes[0] == nil || !d.NewValueKnown("scope.0.id") {
+		return nil
+	}
+	scope := scopes[0].(map[string]interface{})
+	if scopeType := scope["type"].(string); scopeType != "global" && scope["id"].(int) == 0 {
+		return fmt.Errorf("scope.0.id is required when the scope type is %s", scopeType)
+	}
+	return nil
+}
+
+func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	policy, err := policyPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"policy": policy,
+		},
+	}
+
+	resp, err := client.CreatePolicy(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreatePolicyResult)
+	policyResult := result.Policy
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(policyResult.ID))
+
+	return resourcePolicyRead(ctx, d, meta)
+}
+
+func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindPolicyByName(name)
+	} else if id != "" {
+		resp, err = client.GetPolicy(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Policy cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetPolicyResult)
+	policy := result.Policy
+	if policy == nil {
+		return diag.Errorf("read operation: policy not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(policy.ID))
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("enabled", policy.Enabled)
+	d.Set("policy_type", policy.PolicyType.Code)
+
+	// only the configured keys are tracked, the appliance adds
+	// defaults for the settings of the policy type
+	var config map[string]interface{}
+	if data, ok := resp.JsonData.(map[string]interface{}); ok {
+		if policyData, ok := data["policy"].(map[string]interface{}); ok {
+			config, _ = policyData["config"].(map[string]interface{})
+		}
+	}
+	// the whole config is stored after an import
+	var configured map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("config").(string)), &configured); err == nil {
+		stateConfig := make(map[string]interface{})
+		for key := range configured {
+			if value, ok := config[key]; ok {
+				stateConfig[key] = value
+			}
+		}
+		config = stateConfig
+	}
+	if config == nil {
+		config = make(map[string]interface{})
+	}
+	configJson, err := json.Marshal(config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("config", string(configJson))
+
+	scope := map[string]interface{}{}
+	switch policy.RefType {
+	case "ComputeSite":
+		scope["type"] = "group"
+		scope["id"] = policy.Site.ID
+	case "ComputeZone":
+		scope["type"] = "cloud"
+		scope["id"] = policy.Zone.ID
+	case "User":
+		scope["type"] = "user"
+		scope["id"] = policy.User.ID
+	case "Role":
+		scope["type"] = "role"
+		scope["id"] = policy.Role.ID
+		scope["apply_to_each_user"] = policy.EachUser
+	}
+	if len(scope) > 0 {
+		d.Set("scope", []interface{}{scope})
+	} else if scopes := d.Get("scope").([]interface{}); len(scopes) > 0 {
+		// an explicit global scope
+		d.Set("scope", []interface{}{map[string]interface{}{"type": "global"}})
+	} else {
+		d.Set("scope", nil)
+	}
+
+	var tenantIds []int64
+	for _, account := range policy.Accounts {
+		tenantIds = append(tenantIds, account.ID)
+	}
+	d.Set("tenant_ids", tenantIds)
+
+	return diags
+}
+
+func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	policy, err := policyPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"policy": policy,
+		},
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdatePolicyResult)
+	policyResult := result.Policy
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(policyResult.ID))
+	return resourcePolicyRead(ctx, d, meta)
+}
+
+func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func policyPayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	policy := make(map[string]interface{})
+
+	policy["name"] = d.Get("name").(string)
+	policy["description"] = d.Get("description").(string)
+	policy["enabled"] = d.Get("enabled").(bool)
+	policy["policyType"] = map[string]interface{}{
+		"code": d.Get("policy_type").(string),
+	}
+	policy["accounts"] = d.Get("tenant_ids")
+
+	config := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(d.Get("config").(string)), &config); err != nil {
+		return nil, err
+	}
+	policy["config"] = config
+
+	if scopes := d.Get("scope").([]interface{}); len(scopes) > 0 && scopes[0] != nil {
+		scope := scopes[0].(map[string]interface{})
+		refId := scope["id"].(int)
+		switch scope["type"].(string) {
+		case "group":
+			policy["refId"] = refId
+			policy["refType"] = "ComputeSite"
+			policy["site"] = map[string]interface{}{
+				"id": refId,
+			}
+		case "cloud":
+			policy["refId"] = refId
+			policy["refType"] = "ComputeZone"
+			policy["zone"] = map[string]interface{}{
+				"id": refId,
+			}
+		case "user":
+			policy["refId"] = refId
+			policy["refType"] = "User"
+			policy["user"] = map[string]interface{}{
+				"id": refId,
+			}
+		case "role":
+			policy["refId"] = refId
+			policy["refType"] = "Role"
+			policy["eachUser"] = scope["apply_to_each_user"].(bool)
+			policy["role"] = map[string]interface{}{
+				"id": refId,
+			}
+		}
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,312 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var powerScheduleDays = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+var powerScheduleTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$|^24:00$`)
+
+func resourcePowerSchedule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus power schedule resource, powering the instances it is assigned to on and off on a weekly schedule. The schedule is assigned to the instances of a group or cloud with the morpheus_power_schedule_policy resource.",
+		CreateContext: resourcePowerScheduleCreate,
+		ReadContext:   resourcePowerScheduleRead,
+		UpdateContext: resourcePowerScheduleUpdate,
+		DeleteContext: resourcePowerScheduleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the power schedule",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the power schedule",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the power schedule",
+				Optional:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the power schedule is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"schedule_type": {
+				Type:         schema.TypeString,
+				Description:  "Whether the instances are powered on during the windows and off outside of them (power_on), or powered off during the windows and on outside of them (power_off)",
+				ValidateFunc: validation.StringInSlice([]string{"power_on", "power_off"}, false),
+				Optional:     true,
+				Default:      "power_on",
+			},
+			"time_zone": {
+				Type:        schema.TypeString,
+				Description: "The time zone of the windows of the power schedule (America/Denver, UTC, etc.)",
+				Required:    true,
+			},
+			"window": {
+				Type:        schema.TypeSet,
+				Description: "The weekly windows of the power schedule, a single window per day of the week",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day": {
+							Type:         schema.TypeString,
+							Description:  "The day of the week of the window (sunday, monday, tuesday, wednesday, thursday, friday, saturday)",
+							ValidateFunc: validation.StringInSlice(powerScheduleDays, false),
+							Required:     true,
+						},
+						"start": {
+							Type:         schema.TypeString,
+							Description:  "The start time of the window, in HH:MM format",
+							ValidateFunc: validation.StringMatch(powerScheduleTimeRegexp, "must be in HH:MM format"),
+							Required:     true,
+						},
+						"end": {
+							Type:         schema.TypeString,
+							Description:  "The end time of the window, in HH:MM format, 24:00 for the end of the day",
+							ValidateFunc: validation.StringMatch(powerScheduleTimeRegexp, "must be in HH:MM format"),
+							Required:     true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourcePowerScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	schedule, err := powerSchedulePayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"schedule": schedule,
+		},
+	}
+	resp, err := client.CreatePowerSchedule(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreatePowerScheduleResult)
+	powerScheduleResult := result.PowerSchedule
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(powerScheduleResult.ID))
+
+	resourcePowerScheduleRead(ctx, d, meta)
+	return diags
+}
+
+func resourcePowerScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindPowerScheduleByName(name)
+	} else if id != "" {
+		resp, err = client.GetPowerSchedule(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Power schedule cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetPowerScheduleResult)
+	powerSchedule := result.PowerSchedule
+	if powerSchedule == nil {
+		return diag.Errorf("Power schedule not found in response data.") // should not happen
+	}
+
+	d.SetId(int64ToString(powerSchedule.ID))
+	d.Set("name", powerSchedule.Name)
+	d.Set("description", powerSchedule.Description)
+	d.Set("enabled", powerSchedule.Enabled)
+	if powerSchedule.ScheduleType == "power off" {
+		d.Set("schedule_type", "power_off")
+	} else {
+		d.Set("schedule_type", "power_on")
+	}
+	d.Set("time_zone", powerSchedule.ScheduleTimeZone)
+
+	hours := [][2]float64{
+		{powerSchedule.SundayOn, powerSchedule.SundayOff},
+		{powerSchedule.MondayOn, powerSchedule.MondayOff},
+		{powerSchedule.TuesdayOn, powerSchedule.TuesdayOff},
+		{powerSchedule.WednesdayOn, powerSchedule.WednesdayOff},
+		{powerSchedule.ThursdayOn, powerSchedule.ThursdayOff},
+		{powerSchedule.FridayOn, powerSchedule.FridayOff},
+		{powerSchedule.SaturdayOn, powerSchedule.SaturdayOff},
+	}
+	var windows []map[string]interface{}
+	for i, day := range powerScheduleDays {
+		// the days without a window are stored with both hours at 0
+		if hours[i][0] == 0 && hours[i][1] == 0 {
+			continue
+		}
+		windows = append(windows, map[string]interface{}{
+			"day":   day,
+			"start": powerScheduleTime(hours[i][0]),
+			"end":   powerScheduleTime(hours[i][1]),
+		})
+	}
+	d.Set("window", windows)
+
+	return diags
+}
+
+func resourcePowerScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	schedule, err := powerSchedulePayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"schedule": schedule,
+		},
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePowerSchedule(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdatePowerScheduleResult)
+	powerSchedule := result.PowerSchedule
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(powerSchedule.ID))
+	return resourcePowerScheduleRead(ctx, d, meta)
+}
+
+func resourcePowerScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePowerSchedule(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// powerSchedulePayload builds the schedule of the payload, the appliance
+// storing the on and off times of each day of the week as decimal hours
+func powerSchedulePayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	schedule := make(map[string]interface{})
+
+	schedule["name"] = d.Get("name").(string)
+	schedule["description"] = d.Get("description").(string)
+	schedule["enabled"] = d.Get("enabled").(bool)
+	if d.Get("schedule_type").(string) == "power_off" {
+		schedule["scheduleType"] = "power off"
+	} else {
+		schedule["scheduleType"] = "power"
+	}
+	schedule["scheduleTimezone"] = d.Get("time_zone").(string)
+
+	for _, day := range powerScheduleDays {
+		schedule[day+"On"] = 0
+		schedule[day+"Off"] = 0
+	}
+	days := make(map[string]bool)
+	for _, w := range d.Get("window").(*schema.Set).List() {
+		window := w.(map[string]interface{})
+		day := window["day"].(string)
+		if days[day] {
+			return nil, fmt.Errorf("power schedule %s has more than one window on %s", d.Get("name").(string), day)
+		}
+		days[day] = true
+		start := powerScheduleHours(window["start"].(string))
+		end := powerScheduleHours(window["end"].(string))
+		if end <= start {
+			return nil, fmt.Errorf("the window of power schedule %s on %s ends before it starts", d.Get("name").(string), day)
+		}
+		schedule[day+"On"] = start
+		schedule[day+"Off"] = end
+	}
+	return schedule, nil
+}
+
+// powerScheduleHours converts a HH:MM time to decimal hours
+func powerScheduleHours(value string) float64 {
+	var hours, minutes int
+	fmt.Sscanf(strings.TrimSpace(value), "%d:%d", &hours, &minutes)
+	return float64(hours) + float64(minutes)/60
+}
+
+// powerScheduleTime converts decimal hours to a HH:MM time
+func powerScheduleTime(hours float64) string {
+	minutes := int(math.Round(hours * 60))
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
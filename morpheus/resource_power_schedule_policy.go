@@ -13,7 +13,7 @@ import (
 
 func resourcePowerSchedulePolicy() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus power schedule policy resource",
+		Description:   "Provides a Morpheus power schedule policy resource, assigning a power schedule, such as one managed with the morpheus_power_schedule resource, to the instances provisioned in its scope",
 		CreateContext: resourcePowerSchedulePolicyCreate,
 		ReadContext:   resourcePowerSchedulePolicyRead,
 		UpdateContext: resourcePowerSchedulePolicyUpdate,
@@ -115,7 +115,7 @@ func resourcePowerSchedulePolicy() *schema.Resource {
 }
 
 func resourcePowerSchedulePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -187,7 +187,7 @@ func resourcePowerSchedulePolicyCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourcePowerSchedulePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -217,6 +217,7 @@ func resourcePowerSchedulePolicyRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -261,7 +262,7 @@ func resourcePowerSchedulePolicyRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourcePowerSchedulePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -315,7 +316,9 @@ func resourcePowerSchedulePolicyUpdate(ctx context.Context, d *schema.ResourceDa
 		},
 	}
 
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -331,14 +334,16 @@ func resourcePowerSchedulePolicyUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourcePowerSchedulePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
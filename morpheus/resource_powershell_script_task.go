@@ -127,6 +127,7 @@ func resourcePowerShellScriptTask() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The password of the user account used to authenticate to the remote target",
 				Optional:    true,
+				Sensitive:   true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					h := sha256.New()
 					h.Write([]byte(new))
@@ -135,6 +136,12 @@ func resourcePowerShellScriptTask() *schema.Resource {
 					//return strings.ToLower(old) == strings.ToLower(sha256_hash)
 				},
 			},
+			"remote_target_credential_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store entry used to authenticate to the remote target",
+				Optional:      true,
+				ConflictsWith: []string{"remote_target_password"},
+			},
 			"retryable": {
 				Type:        schema.TypeBool,
 				Description: "Whether to retry the task if there is a failure",
@@ -153,39 +160,35 @@ func resourcePowerShellScriptTask() *schema.Resource {
 				Optional:    true,
 				Default:     10,
 			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
 			"allow_custom_config": {
 				Type:        schema.TypeBool,
 				Description: "Custom configuration data to pass during the execution of the shell script",
 				Optional:    true,
 				Default:     false,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the powershell script task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourcePowerShellScriptTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("script_content") != "" {
-		sourceOptions["content"] = d.Get("script_content")
-	}
-	if d.Get("script_path") != "" {
-		sourceOptions["contentPath"] = d.Get("script_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "script_content", "script_path")
 
 	taskType := make(map[string]interface{})
 	taskType["code"] = "winrmTask"
@@ -209,12 +212,21 @@ func resourcePowerShellScriptTaskCreate(ctx context.Context, d *schema.ResourceD
 		taskOptions["password"] = d.Get("remote_target_password")
 	}
 
+	credential := make(map[string]interface{})
+	if d.Get("remote_target_credential_id").(int) != 0 {
+		credential["type"] = "username-password"
+		credential["id"] = d.Get("remote_target_credential_id").(int)
+	} else {
+		credential["type"] = "local"
+	}
+
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
 		for _, s := range attr.(*schema.Set).List() {
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -225,16 +237,18 @@ func resourcePowerShellScriptTaskCreate(ctx context.Context, d *schema.ResourceD
 				"file":              sourceOptions,
 				"taskType":          taskType,
 				"taskOptions":       taskOptions,
+				"credential":        credential,
 				"resultType":        d.Get("result_type"),
 				"executeTarget":     d.Get("execute_target").(string),
 				"retryable":         d.Get("retryable"),
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -252,8 +266,6 @@ func resourcePowerShellScriptTaskCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourcePowerShellScriptTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -264,9 +276,9 @@ func resourcePowerShellScriptTaskRead(ctx context.Context, d *schema.ResourceDat
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -284,6 +296,8 @@ func resourcePowerShellScriptTaskRead(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -293,12 +307,8 @@ func resourcePowerShellScriptTaskRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("code", powerShellScriptTask.Code)
 	d.Set("labels", powerShellScriptTask.Labels)
 	d.Set("result_type", powerShellScriptTask.ResultType)
-	d.Set("source_type", powerShellScriptTask.File.SourceType)
-	d.Set("script_content", powerShellScriptTask.File.Content)
-	d.Set("script_path", powerShellScriptTask.File.ContentPath)
-	d.Set("version_ref", powerShellScriptTask.File.ContentRef)
+	setSourceFile(d, SourceFile(powerShellScriptTask.File), "script_content", "script_path")
 	d.Set("execute_target", powerShellScriptTask.ExecuteTarget)
-	d.Set("repository_id", powerShellScriptTask.File.Repository.ID)
 	if powerShellScriptTask.TaskOptions.WinrmElevated == "on" {
 		d.Set("elevated_shell", true)
 	} else {
@@ -308,30 +318,20 @@ func resourcePowerShellScriptTaskRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("remote_target_port", powerShellScriptTask.TaskOptions.Port)
 	d.Set("remote_target_username", powerShellScriptTask.TaskOptions.Username)
 	d.Set("remote_target_password", powerShellScriptTask.TaskOptions.PasswordHash)
+	d.Set("remote_target_credential_id", powerShellScriptTask.Credential.ID)
 	d.Set("retryable", powerShellScriptTask.Retryable)
 	d.Set("retry_count", powerShellScriptTask.RetryCount)
 	d.Set("retry_delay_seconds", powerShellScriptTask.RetryDelaySeconds)
 	d.Set("allow_custom_config", powerShellScriptTask.AllowCustomConfig)
+	d.Set("visibility", powerShellScriptTask.Visibility)
 	return diags
 }
 
 func resourcePowerShellScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("script_content") != "" {
-		sourceOptions["content"] = d.Get("script_content")
-	}
-	if d.Get("script_path") != "" {
-		sourceOptions["contentPath"] = d.Get("script_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "script_content", "script_path")
 
 	taskType := make(map[string]interface{})
 	taskType["code"] = "winrmTask"
@@ -355,12 +355,21 @@ func resourcePowerShellScriptTaskUpdate(ctx context.Context, d *schema.ResourceD
 		taskOptions["password"] = d.Get("remote_target_password")
 	}
 
+	credential := make(map[string]interface{})
+	if d.Get("remote_target_credential_id").(int) != 0 {
+		credential["type"] = "username-password"
+		credential["id"] = d.Get("remote_target_credential_id").(int)
+	} else {
+		credential["type"] = "local"
+	}
+
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
 		for _, s := range attr.(*schema.Set).List() {
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -371,16 +380,20 @@ func resourcePowerShellScriptTaskUpdate(ctx context.Context, d *schema.ResourceD
 				"file":              sourceOptions,
 				"taskType":          taskType,
 				"taskOptions":       taskOptions,
+				"credential":        credential,
 				"resultType":        d.Get("result_type"),
 				"executeTarget":     d.Get("execute_target").(string),
 				"retryable":         d.Get("retryable"),
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -395,14 +408,14 @@ func resourcePowerShellScriptTaskUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourcePowerShellScriptTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -48,7 +48,7 @@ func resourcePreseedScript() *schema.Resource {
 }
 
 func resourcePreseedScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -79,7 +79,7 @@ func resourcePreseedScriptCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourcePreseedScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -111,6 +111,7 @@ func resourcePreseedScriptRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPreseedScriptResult)
@@ -122,7 +123,7 @@ func resourcePreseedScriptRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourcePreseedScriptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	req := &morpheus.Request{
@@ -134,7 +135,9 @@ func resourcePreseedScriptUpdate(ctx context.Context, d *schema.ResourceData, me
 		},
 	}
 
-	resp, err := client.UpdatePreseedScript(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePreseedScript(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -149,14 +152,16 @@ func resourcePreseedScriptUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourcePreseedScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePreseedScript(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePreseedScript(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
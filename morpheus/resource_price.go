@@ -135,7 +135,7 @@ func resourcePrice() *schema.Resource {
 }
 
 func resourcePriceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -239,7 +239,7 @@ func resourcePriceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourcePriceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -269,6 +269,7 @@ func resourcePriceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var price MorpheusPrice
@@ -324,7 +325,7 @@ func resourcePriceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 func resourcePriceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	price := make(map[string]interface{})
@@ -400,7 +401,9 @@ func resourcePriceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			"price": price,
 		},
 	}
-	resp, err := client.UpdatePrice(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePrice(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -417,14 +420,16 @@ func resourcePriceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourcePriceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePrice(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePrice(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
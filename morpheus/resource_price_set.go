@@ -84,7 +84,7 @@ func resourcePriceSet() *schema.Resource {
 }
 
 func resourcePriceSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -136,7 +136,7 @@ func resourcePriceSetCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourcePriceSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -166,6 +166,7 @@ func resourcePriceSetRead(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var priceSet MorpheusPriceSet
@@ -208,7 +209,7 @@ func resourcePriceSetRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourcePriceSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	priceSet := make(map[string]interface{})
@@ -244,7 +245,9 @@ func resourcePriceSetUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		},
 	}
 
-	resp, err := client.UpdatePriceSet(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePriceSet(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -261,14 +264,16 @@ func resourcePriceSetUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourcePriceSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePriceSet(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePriceSet(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -0,0 +1,283 @@
+package morpheus
+
+import (
+	"context"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProvisioningLicense() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus provisioning license resource for managing the software licenses, such as Windows KMS or MAK keys, applied to virtual images during provisioning.",
+		CreateContext: resourceProvisioningLicenseCreate,
+		ReadContext:   resourceProvisioningLicenseRead,
+		UpdateContext: resourceProvisioningLicenseUpdate,
+		DeleteContext: resourceProvisioningLicenseDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the provisioning license",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the provisioning license",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the provisioning license",
+				Optional:    true,
+				Computed:    true,
+			},
+			"license_type_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the provisioning license type",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"license_key": {
+				Type:        schema.TypeString,
+				Description: "The license key",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"org_name": {
+				Type:        schema.TypeString,
+				Description: "The organization name the license is registered to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"full_name": {
+				Type:        schema.TypeString,
+				Description: "The full name the license is registered to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"license_version": {
+				Type:        schema.TypeString,
+				Description: "The version of the licensed software",
+				Optional:    true,
+				Computed:    true,
+			},
+			"copies": {
+				Type:        schema.TypeInt,
+				Description: "The number of copies available for the license",
+				Required:    true,
+			},
+			"virtual_image_ids": {
+				Type:        schema.TypeSet,
+				Description: "The ids of the virtual images the license is applied to",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Description: "The ids of the tenants the license is available to",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"reservation_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of copies of the license currently in use",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceProvisioningLicenseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"license": provisioningLicensePayload(d),
+		},
+	}
+
+	resp, err := client.CreateSoftwareLicense(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateSoftwareLicenseResult)
+	license := result.SoftwareLicense
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(license.ID))
+
+	resourceProvisioningLicenseRead(ctx, d, meta)
+	return diags
+}
+
+func resourceProvisioningLicenseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindSoftwareLicenseByName(name)
+	} else if id != "" {
+		resp, err = client.GetSoftwareLicense(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Provisioning license cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetSoftwareLicenseResult)
+	license := result.SoftwareLicense
+	if license != nil {
+		d.SetId(int64ToString(license.ID))
+		d.Set("name", license.Name)
+		d.Set("description", license.Description)
+		d.Set("license_type_id", license.LicenseType.ID)
+		// the license key is masked for the users not allowed to see it, keep the configured value then
+		if strings.Trim(license.LicenseKey, "*") != "" {
+			d.Set("license_key", license.LicenseKey)
+		}
+		d.Set("org_name", license.OrgName)
+		d.Set("full_name", license.FullName)
+		d.Set("license_version", license.LicenseVersion)
+		d.Set("copies", license.Copies)
+		var virtualImageIds []int64
+		for _, virtualImage := range license.Virtualimages {
+			virtualImageIds = append(virtualImageIds, virtualImage.ID)
+		}
+		d.Set("virtual_image_ids", virtualImageIds)
+		var tenantIds []int64
+		for _, tenant := range license.Tenants {
+			if tenantMap, ok := tenant.(map[string]interface{}); ok {
+				if tenantId, ok := tenantMap["id"].(float64); ok {
+					tenantIds = append(tenantIds, int64(tenantId))
+				}
+			}
+		}
+		d.Set("tenant_ids", tenantIds)
+		d.Set("reservation_count", license.Reservationcount)
+	} else {
+		return diag.Errorf("read operation: provisioning license not found in response data") // should not happen
+	}
+
+	return diags
+}
+
+func resourceProvisioningLicenseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"license": provisioningLicensePayload(d),
+		},
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateSoftwareLicense(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.UpdateSoftwareLicenseResult)
+	license := result.SoftwareLicense
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(license.ID))
+	return resourceProvisioningLicenseRead(ctx, d, meta)
+}
+
+func resourceProvisioningLicenseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteSoftwareLicense(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func provisioningLicensePayload(d *schema.ResourceData) map[string]interface{} {
+	license := map[string]interface{}{
+		"name":           d.Get("name").(string),
+		"description":    d.Get("description").(string),
+		"licenseKey":     d.Get("license_key").(string),
+		"orgName":        d.Get("org_name").(string),
+		"fullName":       d.Get("full_name").(string),
+		"licenseVersion": d.Get("license_version").(string),
+		"copies":         d.Get("copies").(int),
+		"licenseType": map[string]interface{}{
+			"id": d.Get("license_type_id").(int),
+		},
+	}
+
+	virtualImages := make([]map[string]interface{}, 0)
+	for _, virtualImageId := range d.Get("virtual_image_ids").(*schema.Set).List() {
+		virtualImages = append(virtualImages, map[string]interface{}{
+			"id": virtualImageId.(int),
+		})
+	}
+	license["virtualImages"] = virtualImages
+
+	tenants := make([]map[string]interface{}, 0)
+	for _, tenantId := range d.Get("tenant_ids").(*schema.Set).List() {
+		tenants = append(tenants, map[string]interface{}{
+			"id": tenantId.(int),
+		})
+	}
+	license["tenants"] = tenants
+
+	return license
+}
@@ -138,7 +138,7 @@ func resourceProvisioningSetting() *schema.Resource {
 }
 
 func resourceProvisioningSettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -168,7 +168,9 @@ func resourceProvisioningSettingCreate(ctx context.Context, d *schema.ResourceDa
 	// 	}
 	// }
 
-	resp, err := client.UpdateProvisioningSettings(req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateProvisioningSettings(req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -186,7 +188,7 @@ func resourceProvisioningSettingCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceProvisioningSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -208,6 +210,7 @@ func resourceProvisioningSettingRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetProvisioningSettingsResult)
@@ -232,7 +235,7 @@ func resourceProvisioningSettingRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceProvisioningSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -259,7 +262,9 @@ func resourceProvisioningSettingUpdate(ctx context.Context, d *schema.ResourceDa
 	// 	}
 	// }
 
-	resp, err := client.UpdateProvisioningSettings(req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateProvisioningSettings(req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -58,12 +59,24 @@ func resourceProvisioningWorkflow() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
 				Default:      "private",
 			},
+			"option_types": {
+				Type:        schema.TypeList,
+				Description: "The option types associated with the provisioning workflow",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"allow_custom_config": {
+				Type:        schema.TypeBool,
+				Description: "Allow a custom configuration to be supplied",
+				Optional:    true,
+				Default:     false,
+			},
 			"task": {
 				Type:        schema.TypeList,
 				Description: "A list of tasks associated with the provisioning workflow",
 				Optional:    true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: workflowTaskFailureSchema(map[string]*schema.Schema{
 						"task_id": {
 							Type:        schema.TypeInt,
 							Description: "The ID of the task to associate with the provisioning workflow",
@@ -75,19 +88,22 @@ func resourceProvisioningWorkflow() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringInSlice([]string{"configure", "price", "preProvision", "provision", "postProvision", "start", "stop", "preDeploy", "deploy", "reconfigure", "teardown", "shutdown", "startup"}, false),
 						},
-					},
+					}),
 				},
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the provisioning workflow is created in, the tenant_id argument of the provider or the tenant of the user when not set. The tasks of the workflow must be visible to the tenant"),
 		},
+		CustomizeDiff: customdiff.All(
+			provisioningWorkflowTasksCustomizeDiff,
+			tenantIdCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceProvisioningWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -103,6 +119,7 @@ func resourceProvisioningWorkflowCreate(ctx context.Context, d *schema.ResourceD
 			taskconfig := taskList[i].(map[string]interface{})
 			row["taskId"] = taskconfig["task_id"]
 			row["taskPhase"] = taskconfig["task_phase"]
+			workflowTaskFailurePayload(row, taskconfig)
 			tasks = append(tasks, row)
 		}
 	}
@@ -113,22 +130,25 @@ func resourceProvisioningWorkflowCreate(ctx context.Context, d *schema.ResourceD
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"taskSet": map[string]interface{}{
-				"name":        name,
-				"description": description,
-				"labels":      labelsPayload,
-				"type":        "provision",
-				"visibility":  d.Get("visibility"),
-				"platform":    d.Get("platform"),
-				"tasks":       tasks,
+				"name":              name,
+				"description":       description,
+				"labels":            labelsPayload,
+				"type":              "provision",
+				"visibility":        d.Get("visibility"),
+				"platform":          d.Get("platform"),
+				"optionTypes":       d.Get("option_types"),
+				"allowCustomConfig": d.Get("allow_custom_config"),
+				"tasks":             tasks,
 			},
 		},
 	}
 
-	resp, err := client.CreateTaskSet(req)
+	resp, err := taskSetApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -145,8 +165,6 @@ func resourceProvisioningWorkflowCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceProvisioningWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -157,9 +175,9 @@ func resourceProvisioningWorkflowRead(ctx context.Context, d *schema.ResourceDat
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskSetByName(name)
+		resp, err = taskSetApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTaskSet(toInt64(id), &morpheus.Request{})
+		resp, err = taskSetApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("TaskSet cannot be read without name or id")
 	}
@@ -176,6 +194,8 @@ func resourceProvisioningWorkflowRead(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskSetResult)
@@ -184,9 +204,11 @@ func resourceProvisioningWorkflowRead(ctx context.Context, d *schema.ResourceDat
 	// Tasks
 	var tasks []map[string]interface{}
 	var taskOrderList []TaskOrder
+	failureSettings := workflowTaskFailureSettings(resp)
 	if len(workflow.TaskSetTasks) != 0 {
 		for _, task := range workflow.TaskSetTasks {
 			var data TaskOrder
+			data.TaskSetTaskID = task.ID
 			data.ID = task.Task.ID
 			data.Phase = task.TaskPhase
 			data.Order = task.TaskOrder
@@ -197,6 +219,9 @@ func resourceProvisioningWorkflowRead(ctx context.Context, d *schema.ResourceDat
 			tag := make(map[string]interface{})
 			tag["task_phase"] = task.Phase
 			tag["task_id"] = task.ID
+			for key, value := range failureSettings[task.TaskSetTaskID] {
+				tag[key] = value
+			}
 			tasks = append(tasks, tag)
 		}
 	}
@@ -211,6 +236,17 @@ func resourceProvisioningWorkflowRead(ctx context.Context, d *schema.ResourceDat
 		} else {
 			d.Set("platform", workflow.Platform)
 		}
+		// option types
+		var optionTypes []int64
+		for _, optionType := range workflow.OptionTypes {
+			if option, ok := optionType.(map[string]interface{}); ok {
+				if optionID, ok := option["id"].(float64); ok {
+					optionTypes = append(optionTypes, int64(optionID))
+				}
+			}
+		}
+		d.Set("option_types", optionTypes)
+		d.Set("allow_custom_config", workflow.AllowCustomConfig)
 		d.Set("task", tasks)
 	} else {
 		return diag.Errorf("read operation: workflow not found in response data") // should not happen
@@ -220,7 +256,6 @@ func resourceProvisioningWorkflowRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceProvisioningWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -234,6 +269,7 @@ func resourceProvisioningWorkflowUpdate(ctx context.Context, d *schema.ResourceD
 			taskconfig := taskList[i].(map[string]interface{})
 			row["taskId"] = taskconfig["task_id"]
 			row["taskPhase"] = taskconfig["task_phase"]
+			workflowTaskFailurePayload(row, taskconfig)
 			tasks = append(tasks, row)
 		}
 	}
@@ -244,20 +280,25 @@ func resourceProvisioningWorkflowUpdate(ctx context.Context, d *schema.ResourceD
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"taskSet": map[string]interface{}{
-				"name":        name,
-				"description": description,
-				"labels":      labelsPayload,
-				"visibility":  d.Get("visibility"),
-				"platform":    d.Get("platform"),
-				"tasks":       tasks,
+				"name":              name,
+				"description":       description,
+				"labels":            labelsPayload,
+				"visibility":        d.Get("visibility"),
+				"platform":          d.Get("platform"),
+				"optionTypes":       d.Get("option_types"),
+				"allowCustomConfig": d.Get("allow_custom_config"),
+				"tasks":             tasks,
 			},
 		},
 	}
-	resp, err := client.UpdateTaskSet(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskSetApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -272,14 +313,14 @@ func resourceProvisioningWorkflowUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceProvisioningWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTaskSet(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskSetApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -295,7 +336,8 @@ func resourceProvisioningWorkflowDelete(ctx context.Context, d *schema.ResourceD
 }
 
 type TaskOrder struct {
-	Order int64  `json:"order"`
-	ID    int64  `json:"id"`
-	Phase string `json:"phase"`
+	Order         int64  `json:"order"`
+	ID            int64  `json:"id"`
+	Phase         string `json:"phase"`
+	TaskSetTaskID int64  `json:"taskSetTaskId"`
 }
@@ -125,39 +125,35 @@ func resourcePythonScriptTask() *schema.Resource {
 				Optional:    true,
 				Default:     10,
 			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
 			"allow_custom_config": {
 				Type:        schema.TypeBool,
 				Description: "Custom configuration data to pass during the execution of the python script",
 				Optional:    true,
 				Default:     false,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the python script task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourcePythonScriptTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("script_content") != "" {
-		sourceOptions["content"] = d.Get("script_content")
-	}
-	if d.Get("script_path") != "" {
-		sourceOptions["contentPath"] = d.Get("script_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "script_content", "script_path")
 
 	taskOptions := make(map[string]interface{})
 	taskOptions["pythonAdditionalPackages"] = d.Get("additional_packages")
@@ -173,6 +169,7 @@ func resourcePythonScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -189,10 +186,11 @@ func resourcePythonScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -210,8 +208,6 @@ func resourcePythonScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourcePythonScriptTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -222,9 +218,9 @@ func resourcePythonScriptTaskRead(ctx context.Context, d *schema.ResourceData, m
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -242,6 +238,8 @@ func resourcePythonScriptTaskRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -251,35 +249,20 @@ func resourcePythonScriptTaskRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("code", pythonScriptTask.Code)
 	d.Set("labels", pythonScriptTask.Labels)
 	d.Set("result_type", pythonScriptTask.ResultType)
-	d.Set("source_type", pythonScriptTask.File.SourceType)
-	d.Set("script_content", pythonScriptTask.File.Content)
-	d.Set("script_path", pythonScriptTask.File.ContentPath)
-	d.Set("version_ref", pythonScriptTask.File.ContentRef)
-	d.Set("repository_id", pythonScriptTask.File.Repository.ID)
+	setSourceFile(d, SourceFile(pythonScriptTask.File), "script_content", "script_path")
 	d.Set("retryable", pythonScriptTask.Retryable)
 	d.Set("retry_count", pythonScriptTask.RetryCount)
 	d.Set("retry_delay_seconds", pythonScriptTask.RetryDelaySeconds)
 	d.Set("allow_custom_config", pythonScriptTask.AllowCustomConfig)
+	d.Set("visibility", pythonScriptTask.Visibility)
 	return diags
 }
 
 func resourcePythonScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("script_content") != "" {
-		sourceOptions["content"] = d.Get("script_content")
-	}
-	if d.Get("script_path") != "" {
-		sourceOptions["contentPath"] = d.Get("script_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "script_content", "script_path")
 
 	taskOptions := make(map[string]interface{})
 	taskOptions["pythonAdditionalPackages"] = d.Get("additional_packages")
@@ -295,6 +278,7 @@ func resourcePythonScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -311,10 +295,13 @@ func resourcePythonScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -329,14 +316,14 @@ func resourcePythonScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourcePythonScriptTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -120,16 +120,17 @@ func resourceRadioListOptionType() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"force_delete": forceDeleteSchema(),
+			"tenant_id":    tenantIdSchema("The id of the tenant the radio list option type is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceRadioListOptionTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -142,6 +143,7 @@ func resourceRadioListOptionTypeCreate(ctx context.Context, d *schema.ResourceDa
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -168,7 +170,7 @@ func resourceRadioListOptionTypeCreate(ctx context.Context, d *schema.ResourceDa
 			},
 		},
 	}
-	resp, err := client.CreateOptionType(req)
+	resp, err := optionTypeApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -185,7 +187,6 @@ func resourceRadioListOptionTypeCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceRadioListOptionTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -196,9 +197,9 @@ func resourceRadioListOptionTypeRead(ctx context.Context, d *schema.ResourceData
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindOptionTypeByName(name)
+		resp, err = optionTypeApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetOptionType(toInt64(id), &morpheus.Request{})
+		resp, err = optionTypeApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("OptionType cannot be read without name or id")
 	}
@@ -216,6 +217,8 @@ func resourceRadioListOptionTypeRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetOptionTypeResult)
@@ -247,7 +250,6 @@ func resourceRadioListOptionTypeRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceRadioListOptionTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -257,6 +259,7 @@ func resourceRadioListOptionTypeUpdate(ctx context.Context, d *schema.ResourceDa
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"optionType": map[string]interface{}{
@@ -282,7 +285,9 @@ func resourceRadioListOptionTypeUpdate(ctx context.Context, d *schema.ResourceDa
 			},
 		},
 	}
-	resp, err := client.UpdateOptionType(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return optionTypeApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -297,14 +302,19 @@ func resourceRadioListOptionTypeUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceRadioListOptionTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteOptionType(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "option type", func() ([]string, error) {
+		return optionTypeDependents(meta, d, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return optionTypeApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
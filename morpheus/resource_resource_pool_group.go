@@ -93,7 +93,7 @@ func resourceResourcePoolGroup() *schema.Resource {
 }
 
 func resourceResourcePoolGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -153,7 +153,7 @@ func resourceResourcePoolGroupCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceResourcePoolGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -185,6 +185,7 @@ func resourceResourcePoolGroupRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetResourcePoolGroupResult)
@@ -222,7 +223,7 @@ func resourceResourcePoolGroupRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceResourcePoolGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	resourcePermissions := make(map[string]interface{})
@@ -262,7 +263,9 @@ func resourceResourcePoolGroupUpdate(ctx context.Context, d *schema.ResourceData
 			"tenantPermissions":   tenantPermissions,
 		},
 	}
-	resp, err := client.UpdateResourcePoolGroup(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateResourcePoolGroup(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -277,14 +280,16 @@ func resourceResourcePoolGroupUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceResourcePoolGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteResourcePoolGroup(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteResourcePoolGroup(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -129,7 +129,7 @@ func resourceRestOptionList() *schema.Resource {
 }
 
 func resourceRestOptionListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	headers := d.Get("source_headers").([]interface{})
 	var sourceHeaders []map[string]interface{}
@@ -161,6 +161,7 @@ func resourceRestOptionListCreate(ctx context.Context, d *schema.ResourceData, m
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"optionTypeList": map[string]interface{}{
@@ -199,7 +200,7 @@ func resourceRestOptionListCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceRestOptionListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -229,6 +230,7 @@ func resourceRestOptionListRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetOptionListResult)
@@ -254,7 +256,7 @@ func resourceRestOptionListRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceRestOptionListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -264,6 +266,7 @@ func resourceRestOptionListUpdate(ctx context.Context, d *schema.ResourceData, m
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	headers := d.Get("source_headers").([]interface{})
 	var sourceHeaders []map[string]interface{}
 	// iterate over the array of sourceHeaders
@@ -305,7 +308,9 @@ func resourceRestOptionListUpdate(ctx context.Context, d *schema.ResourceData, m
 			},
 		},
 	}
-	resp, err := client.UpdateOptionList(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateOptionList(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -320,14 +325,16 @@ func resourceRestOptionListUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceRestOptionListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteOptionList(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteOptionList(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
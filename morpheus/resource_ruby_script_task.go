@@ -101,39 +101,35 @@ func resourceRubyScriptTask() *schema.Resource {
 				Optional:    true,
 				Default:     10,
 			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
 			"allow_custom_config": {
 				Type:        schema.TypeBool,
 				Description: "Custom configuration data to pass during the execution of the ruby script",
 				Optional:    true,
 				Default:     false,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the ruby script task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceRubyScriptTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("script_content") != "" {
-		sourceOptions["content"] = d.Get("script_content")
-	}
-	if d.Get("script_path") != "" {
-		sourceOptions["contentPath"] = d.Get("script_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "script_content", "script_path")
 
 	taskType := make(map[string]interface{})
 	taskType["code"] = "jrubyTask"
@@ -144,6 +140,7 @@ func resourceRubyScriptTaskCreate(ctx context.Context, d *schema.ResourceData, m
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -159,10 +156,11 @@ func resourceRubyScriptTaskCreate(ctx context.Context, d *schema.ResourceData, m
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -180,8 +178,6 @@ func resourceRubyScriptTaskCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceRubyScriptTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -192,9 +188,9 @@ func resourceRubyScriptTaskRead(ctx context.Context, d *schema.ResourceData, met
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -212,6 +208,8 @@ func resourceRubyScriptTaskRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -221,35 +219,20 @@ func resourceRubyScriptTaskRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("code", rubyScriptTask.Code)
 	d.Set("labels", rubyScriptTask.Labels)
 	d.Set("result_type", rubyScriptTask.ResultType)
-	d.Set("source_type", rubyScriptTask.File.SourceType)
-	d.Set("script_content", rubyScriptTask.File.Content)
-	d.Set("script_path", rubyScriptTask.File.ContentPath)
-	d.Set("version_ref", rubyScriptTask.File.ContentRef)
-	d.Set("repository_id", rubyScriptTask.File.Repository.ID)
+	setSourceFile(d, SourceFile(rubyScriptTask.File), "script_content", "script_path")
 	d.Set("retryable", rubyScriptTask.Retryable)
 	d.Set("retry_count", rubyScriptTask.RetryCount)
 	d.Set("retry_delay_seconds", rubyScriptTask.RetryDelaySeconds)
 	d.Set("allow_custom_config", rubyScriptTask.AllowCustomConfig)
+	d.Set("visibility", rubyScriptTask.Visibility)
 	return diags
 }
 
 func resourceRubyScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("script_content") != "" {
-		sourceOptions["content"] = d.Get("script_content")
-	}
-	if d.Get("script_path") != "" {
-		sourceOptions["contentPath"] = d.Get("script_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "script_content", "script_path")
 
 	taskType := make(map[string]interface{})
 	taskType["code"] = "jrubyTask"
@@ -260,6 +243,7 @@ func resourceRubyScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, m
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -275,10 +259,13 @@ func resourceRubyScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, m
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -293,14 +280,14 @@ func resourceRubyScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceRubyScriptTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
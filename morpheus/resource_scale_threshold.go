@@ -49,6 +49,12 @@ func resourceScaleThreshold() *schema.Resource {
 				Description: "The maximum number of instances to scale up to",
 				Required:    true,
 			},
+			"scale_increment": {
+				Type:        schema.TypeInt,
+				Description: "The number of instances added or removed by each scaling operation",
+				Optional:    true,
+				Computed:    true,
+			},
 			"enable_cpu_threshold": {
 				Type:        schema.TypeBool,
 				Description: "Whether scaling operations based upon cpu usage is enabled or not",
@@ -63,7 +69,7 @@ func resourceScaleThreshold() *schema.Resource {
 			},
 			"max_cpu_percentage": {
 				Type:        schema.TypeFloat,
-				Description: "The maximum memory percentage for scaling",
+				Description: "The maximum cpu percentage for scaling",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -111,29 +117,35 @@ func resourceScaleThreshold() *schema.Resource {
 }
 
 func resourceScaleThresholdCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	payload := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"autoUp":        d.Get("auto_upscale").(bool),
+		"autoDown":      d.Get("auto_downscale").(bool),
+		"minCount":      d.Get("min_count").(int),
+		"maxCount":      d.Get("max_count").(int),
+		"cpuEnabled":    d.Get("enable_cpu_threshold").(bool),
+		"minCpu":        d.Get("min_cpu_percentage").(float64),
+		"maxCpu":        d.Get("max_cpu_percentage").(float64),
+		"memoryEnabled": d.Get("enable_memory_threshold").(bool),
+		"minMemory":     d.Get("min_memory_percentage").(float64),
+		"maxMemory":     d.Get("max_memory_percentage").(float64),
+		"diskEnabled":   d.Get("enable_disk_threshold").(bool),
+		"minDisk":       d.Get("min_disk_percentage").(float64),
+		"maxDisk":       d.Get("max_disk_percentage").(float64),
+	}
+	// the appliance defaults the scale increment when not set
+	if scaleIncrement, ok := d.GetOk("scale_increment"); ok {
+		payload["scaleIncrement"] = scaleIncrement.(int)
+	}
+
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
-			"scaleThreshold": map[string]interface{}{
-				"name":          d.Get("name").(string),
-				"autoUp":        d.Get("auto_upscale").(bool),
-				"autoDown":      d.Get("auto_downscale").(bool),
-				"minCount":      d.Get("min_count").(int),
-				"maxCount":      d.Get("max_count").(int),
-				"cpuEnabled":    d.Get("enable_cpu_threshold").(bool),
-				"minCpu":        d.Get("min_cpu_percentage").(float64),
-				"maxCpu":        d.Get("max_cpu_percentage").(float64),
-				"memoryEnabled": d.Get("enable_memory_threshold").(bool),
-				"minMemory":     d.Get("min_memory_percentage").(float64),
-				"maxMemory":     d.Get("max_memory_percentage").(float64),
-				"diskEnabled":   d.Get("enable_disk_threshold").(bool),
-				"minDisk":       d.Get("min_disk_percentage").(float64),
-				"maxDisk":       d.Get("max_disk_percentage").(float64),
-			},
+			"scaleThreshold": payload,
 		},
 	}
 
@@ -154,7 +166,7 @@ func resourceScaleThresholdCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScaleThresholdRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -184,6 +196,7 @@ func resourceScaleThresholdRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetScaleThresholdResult)
@@ -195,6 +208,7 @@ func resourceScaleThresholdRead(ctx context.Context, d *schema.ResourceData, met
 		d.Set("auto_downscale", scaleThreshold.AutoDown)
 		d.Set("min_count", scaleThreshold.MinCount)
 		d.Set("max_count", scaleThreshold.MaxCount)
+		d.Set("scale_increment", scaleThreshold.ScaleIncrement)
 		d.Set("enable_cpu_threshold", scaleThreshold.CpuEnabled)
 		d.Set("min_cpu_percentage", scaleThreshold.MinCpu)
 		d.Set("max_cpu_percentage", scaleThreshold.MaxCpu)
@@ -211,31 +225,39 @@ func resourceScaleThresholdRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScaleThresholdUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
+	payload := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"autoUp":        d.Get("auto_upscale").(bool),
+		"autoDown":      d.Get("auto_downscale").(bool),
+		"minCount":      d.Get("min_count").(int),
+		"maxCount":      d.Get("max_count").(int),
+		"cpuEnabled":    d.Get("enable_cpu_threshold").(bool),
+		"minCpu":        d.Get("min_cpu_percentage").(float64),
+		"maxCpu":        d.Get("max_cpu_percentage").(float64),
+		"memoryEnabled": d.Get("enable_memory_threshold").(bool),
+		"minMemory":     d.Get("min_memory_percentage").(float64),
+		"maxMemory":     d.Get("max_memory_percentage").(float64),
+		"diskEnabled":   d.Get("enable_disk_threshold").(bool),
+		"minDisk":       d.Get("min_disk_percentage").(float64),
+		"maxDisk":       d.Get("max_disk_percentage").(float64),
+	}
+	// the appliance defaults the scale increment when not set
+	if scaleIncrement, ok := d.GetOk("scale_increment"); ok {
+		payload["scaleIncrement"] = scaleIncrement.(int)
+	}
+
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
-			"scaleThreshold": map[string]interface{}{
-				"name":          d.Get("name").(string),
-				"autoUp":        d.Get("auto_upscale").(bool),
-				"autoDown":      d.Get("auto_downscale").(bool),
-				"minCount":      d.Get("min_count").(int),
-				"maxCount":      d.Get("max_count").(int),
-				"cpuEnabled":    d.Get("enable_cpu_threshold").(bool),
-				"minCpu":        d.Get("min_cpu_percentage").(float64),
-				"maxCpu":        d.Get("max_cpu_percentage").(float64),
-				"memoryEnabled": d.Get("enable_memory_threshold").(bool),
-				"minMemory":     d.Get("min_memory_percentage").(float64),
-				"maxMemory":     d.Get("max_memory_percentage").(float64),
-				"diskEnabled":   d.Get("enable_disk_threshold").(bool),
-				"minDisk":       d.Get("min_disk_percentage").(float64),
-				"maxDisk":       d.Get("max_disk_percentage").(float64),
-			},
+			"scaleThreshold": payload,
 		},
 	}
 
-	resp, err := client.UpdateScaleThreshold(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateScaleThreshold(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -250,14 +272,16 @@ func resourceScaleThresholdUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScaleThresholdDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteScaleThreshold(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteScaleThreshold(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -69,16 +69,16 @@ func resourceScriptTemplate() *schema.Resource {
 				Description: "Whether the script should run with sudo privileges",
 				Optional:    true,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the script template is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceScriptTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -90,6 +90,7 @@ func resourceScriptTemplateCreate(ctx context.Context, d *schema.ResourceData, m
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -104,7 +105,7 @@ func resourceScriptTemplateCreate(ctx context.Context, d *schema.ResourceData, m
 			},
 		},
 	}
-	resp, err := client.CreateScriptTemplate(req)
+	resp, err := scriptTemplateApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -121,8 +122,6 @@ func resourceScriptTemplateCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScriptTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -133,9 +132,9 @@ func resourceScriptTemplateRead(ctx context.Context, d *schema.ResourceData, met
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindScriptTemplateByName(name)
+		resp, err = scriptTemplateApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetScriptTemplate(toInt64(id), &morpheus.Request{})
+		resp, err = scriptTemplateApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Script template cannot be read without name or id")
 	}
@@ -153,6 +152,8 @@ func resourceScriptTemplateRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetScriptTemplateResult)
@@ -169,7 +170,6 @@ func resourceScriptTemplateRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScriptTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 
 	name := d.Get("name").(string)
@@ -180,6 +180,7 @@ func resourceScriptTemplateUpdate(ctx context.Context, d *schema.ResourceData, m
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -195,7 +196,9 @@ func resourceScriptTemplateUpdate(ctx context.Context, d *schema.ResourceData, m
 		},
 	}
 
-	resp, err := client.UpdateScriptTemplate(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return scriptTemplateApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -210,14 +213,14 @@ func resourceScriptTemplateUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScriptTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteScriptTemplate(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return scriptTemplateApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -61,7 +61,7 @@ func resourceSecurityPackage() *schema.Resource {
 }
 
 func resourceSecurityPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -77,6 +77,7 @@ func resourceSecurityPackageCreate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	securityPackage["labels"] = labelsPayload
 	securityPackage["enabled"] = d.Get("enabled").(bool)
 	securityPackage["url"] = d.Get("url").(string)
@@ -103,7 +104,7 @@ func resourceSecurityPackageCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceSecurityPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -133,6 +134,7 @@ func resourceSecurityPackageRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 	// store resource data
 	result := resp.Result.(*morpheus.GetSecurityPackageResult)
 	securityPackage := result.SecurityPackage
@@ -148,7 +150,7 @@ func resourceSecurityPackageRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceSecurityPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	securityPackage := make(map[string]interface{})
 
@@ -160,6 +162,7 @@ func resourceSecurityPackageUpdate(ctx context.Context, d *schema.ResourceData,
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	securityPackage["labels"] = labelsPayload
 	securityPackage["enabled"] = d.Get("enabled").(bool)
 	securityPackage["url"] = d.Get("url").(string)
@@ -169,7 +172,9 @@ func resourceSecurityPackageUpdate(ctx context.Context, d *schema.ResourceData,
 			"securityPackage": securityPackage,
 		},
 	}
-	resp, err := client.UpdateSecurityPackage(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateSecurityPackage(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -185,14 +190,16 @@ func resourceSecurityPackageUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceSecurityPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteSecurityPackage(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteSecurityPackage(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
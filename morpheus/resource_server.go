@@ -0,0 +1,391 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceServer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus server resource for managing an existing server, such as a bare metal host or a discovered virtual machine, by converting it to managed, installing the agent, assigning it to a group and setting its labels and power schedule. Destroying the resource only removes it from the terraform state, the server itself is not deleted.",
+		CreateContext: resourceServerCreate,
+		ReadContext:   resourceServerRead,
+		UpdateContext: resourceServerUpdate,
+		DeleteContext: resourceServerDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the server",
+				Computed:    true,
+			},
+			"server_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the existing server to manage",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the server",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the server",
+				Optional:    true,
+				Computed:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The organization labels associated with the server, replacing the labels the server had before it was managed by the resource",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"managed": {
+				Type:        schema.TypeBool,
+				Description: "Whether to convert the discovered server to a managed server",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"install_agent": {
+				Type:        schema.TypeBool,
+				Description: "Whether to install the Morpheus agent on the managed server",
+				Optional:    true,
+				Default:     true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the group the server is assigned to when it is converted to managed",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"server_os_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the operating system of the server, used when it is converted to managed",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ssh_username": {
+				Type:        schema.TypeString,
+				Description: "The username used to connect to the server when it is converted to managed",
+				Optional:    true,
+			},
+			"ssh_password": {
+				Type:        schema.TypeString,
+				Description: "The password used to connect to the server when it is converted to managed",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"power_schedule_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the power schedule applied to the server",
+				Optional:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the cloud the server belongs to",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the server",
+				Computed:    true,
+			},
+			"power_state": {
+				Type:        schema.TypeString,
+				Description: "The power state of the server",
+				Computed:    true,
+			},
+			"agent_installed": {
+				Type:        schema.TypeBool,
+				Description: "Whether the Morpheus agent is installed on the server",
+				Computed:    true,
+			},
+			"internal_ip": {
+				Type:        schema.TypeString,
+				Description: "The internal ip address of the server",
+				Computed:    true,
+			},
+			"external_ip": {
+				Type:        schema.TypeString,
+				Description: "The external ip address of the server",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServerImport,
+		},
+	}
+}
+
+func resourceServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	serverId := int64(d.Get("server_id").(int))
+	resp, err := client.GetHost(serverId, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	host := resp.Result.(*morpheus.GetHostResult).Host
+	if host == nil {
+		return diag.Errorf("server %d not found", serverId)
+	}
+	d.SetId(int64ToString(host.ID))
+
+	if d.Get("managed").(bool) && !host.ComputeServerType.Managed {
+		server := map[string]interface{}{
+			"sshUsername": d.Get("ssh_username").(string),
+			"sshPassword": d.Get("ssh_password").(string),
+		}
+		if serverOsId, ok := d.GetOk("server_os_id"); ok {
+			server["serverOs"] = map[string]interface{}{
+				"id": serverOsId.(int),
+			}
+		}
+		if groupId, ok := d.GetOk("group_id"); ok {
+			server["provisionSiteId"] = groupId.(int)
+		}
+
+		resp, err := client.ConvertHostToManaged(host.ID, &morpheus.Request{
+			Body: map[string]interface{}{
+				"server":       server,
+				"installAgent": d.Get("install_agent").(bool),
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+
+		result := resp.Result.(*morpheus.ConvertToManagedResult)
+		if !result.Success && result.Message != "" {
+			return diag.Errorf("error converting server to managed: %s", result.Message)
+		}
+	} else if d.Get("install_agent").(bool) && !host.AgentInstalled {
+		if diags := installServerAgent(client, host.ID); diags.HasError() {
+			return diags
+		}
+	}
+
+	if err := waitForServer(ctx, client, host.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		resourceServerRead(ctx, d, meta)
+		return diag.Errorf("error managing server: %s", err)
+	}
+
+	if diags := updateServer(ctx, client, d, meta); diags.HasError() {
+		return diags
+	}
+
+	return resourceServerRead(ctx, d, meta)
+}
+
+func resourceServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetHost(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetHostResult)
+	host := result.Host
+	if host != nil {
+		d.SetId(int64ToString(host.ID))
+		d.Set("server_id", host.ID)
+		d.Set("name", host.Name)
+		d.Set("description", host.Description)
+		d.Set("labels", host.Labels)
+		if host.SiteId != 0 {
+			d.Set("group_id", host.SiteId)
+		}
+		if host.ServerOs.ID != 0 {
+			d.Set("server_os_id", host.ServerOs.ID)
+		}
+		switch powerScheduleType := host.Config.PowerScheduleType.(type) {
+		case float64:
+			d.Set("power_schedule_id", int64(powerScheduleType))
+		case map[string]interface{}:
+			if powerScheduleId, ok := powerScheduleType["id"].(float64); ok {
+				d.Set("power_schedule_id", int64(powerScheduleId))
+			}
+		case nil:
+			d.Set("power_schedule_id", nil)
+		}
+		d.Set("cloud_id", host.Zone.ID)
+		d.Set("status", host.Status)
+		d.Set("power_state", host.PowerState)
+		d.Set("agent_installed", host.AgentInstalled)
+		d.Set("internal_ip", host.InternalIp)
+		d.Set("external_ip", host.ExternalIp)
+	} else {
+		return diag.Errorf("read operation: server not found in response data") // should not happen
+	}
+
+	return diags
+}
+
+func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	id := toInt64(d.Id())
+
+	if d.HasChange("install_agent") && d.Get("install_agent").(bool) && !d.Get("agent_installed").(bool) {
+		if diags := installServerAgent(client, id); diags.HasError() {
+			return diags
+		}
+		if err := waitForServer(ctx, client, id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error installing agent: %s", err)
+		}
+	}
+
+	if d.HasChanges("name", "description", "labels", "power_schedule_id") {
+		if diags := updateServer(ctx, client, d, meta); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceServerRead(ctx, d, meta)
+}
+
+func resourceServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The server existed before it was managed by terraform, only remove it from state
+	d.SetId("")
+	return diags
+}
+
+func resourceServerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("server_id", toInt64(d.Id()))
+	d.Set("managed", true)
+	d.Set("install_agent", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateServer saves the name, description, labels and power schedule of the server
+func updateServer(ctx context.Context, client *apiClient, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	server := make(map[string]interface{})
+	if name, ok := d.GetOk("name"); ok {
+		server["name"] = name.(string)
+	}
+	if description, ok := d.GetOk("description"); ok {
+		server["description"] = description.(string)
+	}
+	// the labels are sent even when empty so removed labels are cleared, and
+	// on creation so the marker label of the provider is added to the server
+	if labels := managedLabelsPayload(d, meta); d.HasChange("labels") || (d.IsNewResource() && len(labels) > 0) {
+		server["labels"] = labels
+	}
+	if powerScheduleId, ok := d.GetOk("power_schedule_id"); ok {
+		server["powerScheduleType"] = powerScheduleId.(int)
+	} else if d.HasChange("power_schedule_id") {
+		server["powerScheduleType"] = nil
+	}
+	if len(server) == 0 {
+		return nil
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateHost(toInt64(d.Id()), &morpheus.Request{
+			Body: map[string]interface{}{
+				"server": server,
+			},
+		})
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.UpdateHostResult)
+	if !result.Success && result.Message != "" {
+		return diag.Errorf("error updating server: %s", result.Message)
+	}
+	return nil
+}
+
+func installServerAgent(client *apiClient, id int64) diag.Diagnostics {
+	resp, err := client.InstallHostAgent(id, &morpheus.Request{
+		Body: map[string]interface{}{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.ConvertToManagedResult)
+	if !result.Success && result.Message != "" {
+		return diag.Errorf("error installing agent: %s", result.Message)
+	}
+	return nil
+}
+
+// waitForServer waits until the server is no longer being provisioned
+func waitForServer(ctx context.Context, client *apiClient, id int64, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"provisioning", "pending"},
+		Target:  []string{"provisioned", "running", "stopped", "suspended", "unknown"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetHost(id, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			host := resp.Result.(*morpheus.GetHostResult).Host
+			status := strings.ToLower(host.Status)
+			if status == "failed" {
+				return host, status, fmt.Errorf("%s", host.StatusMessage)
+			}
+			if status == "" {
+				status = "unknown"
+			}
+			return host, status, nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   10 * time.Second,
+		Delay:        10 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
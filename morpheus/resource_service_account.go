@@ -0,0 +1,442 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceMorpheusServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus service account resource, a user account dedicated to automation whose password is generated and whose API access token is issued by terraform, so the credentials of the automation can be rotated with the keepers of the resource. The API access token is issued again when it expires, a token expiring within the renewal window or cleared outside of terraform being removed from the state on refresh so the next apply issues a new one.",
+		CreateContext: resourceMorpheusServiceAccountCreate,
+		ReadContext:   resourceMorpheusServiceAccountRead,
+		UpdateContext: resourceMorpheusServiceAccountUpdate,
+		DeleteContext: resourceMorpheusServiceAccountDelete,
+		CustomizeDiff: serviceAccountAccessTokenCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the service account",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tenant_id": {
+				Description: "The ID of the tenant to create the service account in",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"username": {
+				Description: "The username of the service account",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"email": {
+				Description: "The email address of the service account, usually the one of the team owning the automation",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"role_ids": {
+				Description: "The IDs of the roles granted to the service account",
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"renewal_hours": {
+				Description:  "The number of hours before the expiration of the API access token from which a new one is issued, lower than the lifetime of the tokens",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"keepers": keepersSchema("Arbitrary values that, when changed, generate a new password for the service account and issue a new API access token, to force their rotation", false),
+			"password": {
+				Description: "The password generated for the service account",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"access_token": {
+				Description: "The API access token of the service account",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"refresh_token": {
+				Description: "The refresh token of the API access token of the service account",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"access_token_expiration": {
+				Description: "The expiration date of the API access token, in RFC 3339 format, empty when the token does not expire",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceMorpheusServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	password, err := generateServiceAccountPassword()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"user": map[string]interface{}{
+				"username":             d.Get("username").(string),
+				"email":                d.Get("email").(string),
+				"password":             password,
+				"passwordExpired":      false,
+				"receiveNotifications": false,
+				"roles":                serviceAccountRoles(d),
+			},
+		},
+	}
+	if tenantId := d.Get("tenant_id").(int); tenantId != 0 {
+		req.QueryParams = map[string]string{
+			"accountId": fmt.Sprintf("%d", tenantId),
+		}
+	}
+
+	resp, err := client.CreateUser(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.CreateUserResult)
+	user := result.User
+
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(user.ID))
+	d.Set("password", password)
+
+	if err := issueServiceAccountAccessToken(meta, d, password); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags = resourceMorpheusServiceAccountRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	// a renewal window longer than the lifetime of the tokens removes the
+	// issued token from the state as soon as it is read
+	if d.Get("access_token").(string) == "" {
+		return diag.Errorf("the API access token issued to the service account is within the renewal window of %d hours: renewal_hours must be lower than the lifetime of the tokens", d.Get("renewal_hours").(int))
+	}
+	return diags
+}
+
+func resourceMorpheusServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.GetUser(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetUserResult)
+	user := result.User
+	if user == nil {
+		return diag.Errorf("Service account not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(user.ID))
+	d.Set("username", user.Username)
+	d.Set("email", user.Email)
+	if _, ok := d.GetOk("tenant_id"); ok {
+		d.Set("tenant_id", user.AccountID)
+	}
+	var roleIds []int
+	for _, role := range user.Roles {
+		roleIds = append(roleIds, int(role.ID))
+	}
+	d.Set("role_ids", matchUserRoleIdsWithSchema(roleIds, d.Get("role_ids").([]interface{})))
+
+	// the token is cleared from the state when it was cleared outside of
+	// terraform, or when it enters its renewal window, for the next apply to
+	// issue a new one
+	expiration, found, resp, err := userAccessTokenExpiration(client, user.ID, serviceAccountClientId)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	if !found {
+		log.Printf("API access token of service account %s not found", id)
+		clearServiceAccountAccessToken(d)
+		return diags
+	}
+	d.Set("access_token_expiration", expiration)
+	if accessTokenRenewalDue(expiration, d.Get("renewal_hours").(int)) {
+		log.Printf("API access token of service account %s expires at %s", id, expiration)
+		clearServiceAccountAccessToken(d)
+	}
+	return diags
+}
+
+func resourceMorpheusServiceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	user := map[string]interface{}{
+		"email": d.Get("email").(string),
+		"roles": serviceAccountRoles(d),
+	}
+	password := d.Get("password").(string)
+	rotate := d.HasChange("keepers") || password == ""
+	if rotate {
+		var err error
+		password, err = generateServiceAccountPassword()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		user["password"] = password
+		user["passwordExpired"] = false
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"user": user,
+		},
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateUser(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	if rotate || d.Get("access_token").(string) == "" {
+		d.Set("password", password)
+		if err := issueServiceAccountAccessToken(meta, d, password); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceMorpheusServiceAccountRead(ctx, d, meta)
+}
+
+func resourceMorpheusServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteUserResult(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func serviceAccountRoles(d *schema.ResourceData) []map[string]interface{} {
+	var roles []map[string]interface{}
+	for _, roleId := range d.Get("role_ids").([]interface{}) {
+		roles = append(roles, map[string]interface{}{
+			"id": roleId,
+		})
+	}
+	return roles
+}
+
+// serviceAccountClientId is the API client the access token of the service
+// account is issued for, the one the login of the SDK uses
+const serviceAccountClientId = "morph-api"
+
+// serviceAccountAccessTokenCustomizeDiff plans a new API access token when
+// the token was removed from the state on refresh
+func serviceAccountAccessTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.Get("access_token").(string) != "" {
+		return nil
+	}
+	for _, key := range []string{"access_token", "refresh_token", "access_token_expiration"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clearServiceAccountAccessToken removes the API access token of the service
+// account from the state
+func clearServiceAccountAccessToken(d *schema.ResourceData) {
+	d.Set("access_token", "")
+	d.Set("refresh_token", "")
+	d.Set("access_token_expiration", "")
+}
+
+// issueServiceAccountAccessToken logs in as the service account with a client
+// of its own, the client of the provider keeps its credentials, and stores
+// the API access token issued to the service account
+func issueServiceAccountAccessToken(meta interface{}, d *schema.ResourceData, password string) error {
+	client := meta.(*providerMeta).client
+	username, err := serviceAccountLogin(client, d)
+	if err != nil {
+		return err
+	}
+
+	var serviceAccountClient *morpheus.Client
+	if meta.(*providerMeta).insecure {
+		serviceAccountClient = morpheus.NewClient(client.Url, morpheus.Insecure())
+	} else {
+		serviceAccountClient = morpheus.NewClient(client.Url, morpheus.WithErrCallbackFunc(certErrCallback))
+	}
+	serviceAccountClient.SetUsernameAndPassword(username, password)
+	resp, err := serviceAccountClient.Login()
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return fmt.Errorf("unable to issue an API access token to the service account %s: %s", username, err)
+	}
+
+	d.Set("access_token", serviceAccountClient.AccessToken)
+	d.Set("refresh_token", serviceAccountClient.RefreshToken)
+	d.Set("access_token_expiration", time.Now().Add(time.Duration(serviceAccountClient.ExpiresIn)*time.Second).UTC().Format(time.RFC3339))
+	return nil
+}
+
+// serviceAccountLogin returns the login name of the service account, prefixed
+// with the subdomain of its tenant. The service account is in the tenant the
+// provider is authenticated in when tenant_id is not set, which is read from
+// whoami since the provider may be authenticated with an access token only.
+func serviceAccountLogin(client *apiClient, d *schema.ResourceData) (string, error) {
+	username := d.Get("username").(string)
+	tenantId := int64(d.Get("tenant_id").(int))
+	if tenantId == 0 {
+		resp, err := client.Whoami()
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return "", err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		if resp.Result.(*morpheus.WhoamiResult).IsMasterAccount {
+			return username, nil
+		}
+		if data, ok := resp.JsonData.(map[string]interface{}); ok {
+			if user, ok := data["user"].(map[string]interface{}); ok {
+				tenantId = jsonRefIdValue(user["account"])
+			}
+		}
+		if tenantId == 0 {
+			return "", fmt.Errorf("unable to determine the tenant of the service account %s", username)
+		}
+	}
+
+	resp, err := client.GetTenant(tenantId, &morpheus.Request{})
+	if err != nil {
+		// the users of a subtenant may not read their own tenant, the
+		// subdomain is then taken from the login name of the provider
+		if subdomain := loginSubdomain(client.Username); d.Get("tenant_id").(int) == 0 && subdomain != "" {
+			return subtenantLogin(subdomain, username), nil
+		}
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return "", err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	if tenant := resp.Result.(*morpheus.GetTenantResult).Tenant; tenant != nil {
+		username = subtenantLogin(tenant.Subdomain, username)
+	}
+	return username, nil
+}
+
+// subtenantLogin returns the login name of a user of a subtenant, the user
+// name prefixed with the subdomain of the tenant and a backslash, the user
+// name alone when the tenant has no subdomain
+func subtenantLogin(subdomain string, username string) string {
+	if subdomain == "" {
+		return username
+	}
+	return fmt.Sprintf("%s\\%s", subdomain, username)
+}
+
+// loginSubdomain returns the subdomain of the tenant of a login name, empty
+// when the login name has no subdomain
+func loginSubdomain(login string) string {
+	subdomain, _, ok := strings.Cut(login, "\\")
+	if !ok {
+		return ""
+	}
+	return subdomain
+}
+
+// generateServiceAccountPassword generates a random password meeting the
+// complexity rules of the appliance, with at least one character of each class
+func generateServiceAccountPassword() (string, error) {
+	classes := []string{
+		"abcdefghijklmnopqrstuvwxyz",
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+		"0123456789",
+		"!#%*+-=?@^_",
+	}
+	all := strings.Join(classes, "")
+	password := make([]byte, 32)
+	for i := range password {
+		chars := all
+		if i < len(classes) {
+			chars = classes[i]
+		}
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+		if err != nil {
+			return "", err
+		}
+		password[i] = chars[n.Int64()]
+	}
+	// move the characters of each class to random positions
+	for i := len(password) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[n.Int64()] = password[n.Int64()], password[i]
+	}
+	return string(password), nil
+}
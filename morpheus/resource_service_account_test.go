@@ -0,0 +1,36 @@
+package morpheus
+
+import "testing"
+
+func TestSubtenantLogin(t *testing.T) {
+	cases := map[string]struct {
+		subdomain string
+		username  string
+		want      string
+	}{
+		"subtenant":    {subdomain: "acme", username: "svc-terraform", want: `acme\svc-terraform`},
+		"no subdomain": {username: "svc-terraform", want: "svc-terraform"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := subtenantLogin(tc.subdomain, tc.username); got != tc.want {
+				t.Errorf("subtenantLogin(%q, %q) = %q, want %q", tc.subdomain, tc.username, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLoginSubdomain(t *testing.T) {
+	cases := map[string]string{
+		`acme\admin`: "acme",
+		"admin":      "",
+	}
+	for login, want := range cases {
+		if got := loginSubdomain(login); got != want {
+			t.Errorf("loginSubdomain(%q) = %q, want %q", login, got, want)
+		}
+	}
+	if got := subtenantLogin(loginSubdomain(`acme\admin`), "svc-terraform"); got != `acme\svc-terraform` {
+		t.Errorf("login of a service account of the tenant of the provider = %q, want %q", got, `acme\svc-terraform`)
+	}
+}
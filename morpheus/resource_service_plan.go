@@ -187,7 +187,7 @@ func resourceServicePlan() *schema.Resource {
 }
 
 func resourceServicePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -339,7 +339,7 @@ func resourceServicePlanCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceServicePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -369,6 +369,7 @@ func resourceServicePlanRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var servicePlan, ok = resp.Result.(*morpheus.GetServicePlanResult)
@@ -433,7 +434,7 @@ func resourceServicePlanRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceServicePlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	servicePlan := make(map[string]interface{})
@@ -545,7 +546,9 @@ func resourceServicePlanUpdate(ctx context.Context, d *schema.ResourceData, meta
 		},
 	}
 
-	resp, err := client.UpdatePlan(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePlan(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -562,14 +565,16 @@ func resourceServicePlanUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceServicePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePlan(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePlan(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -585,7 +590,7 @@ func resourceServicePlanDelete(ctx context.Context, d *schema.ResourceData, meta
 }
 
 // FindPriceByName gets an existing price by name
-func FindProvisionTypeByCode(client *morpheus.Client, code string) (*morpheus.Response, error) {
+func FindProvisionTypeByCode(client *apiClient, code string) (*morpheus.Response, error) {
 	// Find by name, then get by ID
 	resp, err := client.ListProvisionTypes(&morpheus.Request{
 		QueryParams: map[string]string{
@@ -4,7 +4,6 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
-	"strconv"
 	"strings"
 
 	"log"
@@ -69,11 +68,11 @@ func resourceServiceNowIntegration() *schema.Resource {
 				},
 				DiffSuppressOnRefresh: true,
 			},
-			"cmdb_custom_mapping": {
+			"cmdb_custom_mapping": jsonStringSchema(&schema.Schema{
 				Type:        schema.TypeString,
 				Description: "A JSON encoded payload to populate a specific field in the ServiceNow table and with a specific mapping",
 				Optional:    true,
-			},
+			}),
 			"cmdb_class_mapping": {
 				Type:        schema.TypeMap,
 				Description: "The mapping between Morpheus server types and ServiceNow CI classes",
@@ -86,6 +85,7 @@ func resourceServiceNowIntegration() *schema.Resource {
 				Description: "The default ServiceNow table that records are written to if they aren't explicitly defined",
 				Optional:    true,
 			},
+			"force_delete": forceDeleteSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -94,7 +94,7 @@ func resourceServiceNowIntegration() *schema.Resource {
 }
 
 func resourceServiceNowIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -131,7 +131,7 @@ func resourceServiceNowIntegrationCreate(ctx context.Context, d *schema.Resource
 				if key == mapping.Name {
 					var classMapping Mapping
 					classMapping.Name = mapping.Name
-					classMapping.ID = strconv.Itoa(int(mapping.Value.(float64)))
+					classMapping.ID = jsonStringValue(mapping.Value)
 					classMapping.NowClass = value.(string)
 					classMappings = append(classMappings, classMapping)
 					matchStatus = true
@@ -171,7 +171,7 @@ func resourceServiceNowIntegrationCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceServiceNowIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -201,6 +201,7 @@ func resourceServiceNowIntegrationRead(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetIntegrationResult)
@@ -230,7 +231,7 @@ func resourceServiceNowIntegrationRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceServiceNowIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	integration := make(map[string]interface{})
@@ -271,7 +272,7 @@ func resourceServiceNowIntegrationUpdate(ctx context.Context, d *schema.Resource
 				if key == mapping.Name {
 					var classMapping Mapping
 					classMapping.Name = mapping.Name
-					classMapping.ID = strconv.Itoa(int(mapping.Value.(float64)))
+					classMapping.ID = jsonStringValue(mapping.Value)
 					classMapping.NowClass = value.(string)
 					classMappings = append(classMappings, classMapping)
 					matchStatus = true
@@ -295,7 +296,9 @@ func resourceServiceNowIntegrationUpdate(ctx context.Context, d *schema.Resource
 		},
 	}
 
-	resp, err := client.UpdateIntegration(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -311,14 +314,21 @@ func resourceServiceNowIntegrationUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceServiceNowIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
-	req := &morpheus.Request{}
-	resp, err := client.DeleteIntegration(toInt64(id), req)
+	req, err := dependencyDeleteRequest(d, "integration", func() ([]string, error) {
+		return integrationDependents(meta, toInt64(id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteIntegration(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -55,7 +55,7 @@ func resourceShellScriptTask() *schema.Resource {
 			},
 			"sudo": {
 				Type:        schema.TypeBool,
-				Description: "Whether to run the script as sudo",
+				Description: "Whether to run the script with sudo on the resource or remote target",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -145,6 +145,7 @@ func resourceShellScriptTask() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The password of the user account used to authenticate to the remote target",
 				Optional:    true,
+				Sensitive:   true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					h := sha256.New()
 					h.Write([]byte(new))
@@ -154,6 +155,18 @@ func resourceShellScriptTask() *schema.Resource {
 				},
 				Computed: true,
 			},
+			"remote_target_credential_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store entry used to authenticate to the remote target",
+				Optional:      true,
+				ConflictsWith: []string{"remote_target_password"},
+			},
+			"remote_target_key_pair_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the key pair used to authenticate to the remote target over SSH",
+				Optional:    true,
+				Computed:    true,
+			},
 			"retryable": {
 				Type:        schema.TypeBool,
 				Description: "Whether to retry the task if there is a failure",
@@ -178,33 +191,22 @@ func resourceShellScriptTask() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the shell script task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceShellScriptTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("script_content") != "" {
-		sourceOptions["content"] = d.Get("script_content")
-	}
-	if d.Get("script_path") != "" {
-		sourceOptions["contentPath"] = d.Get("script_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "script_content", "script_path")
 
 	taskType := make(map[string]interface{})
 	taskType["code"] = "script"
@@ -227,6 +229,9 @@ func resourceShellScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 	if d.Get("remote_target_password") != "" {
 		taskOptions["password"] = d.Get("remote_target_password")
 	}
+	if d.Get("remote_target_key_pair_id").(int) != 0 {
+		taskOptions["sshKey"] = d.Get("remote_target_key_pair_id").(int)
+	}
 	if d.Get("local_repository_id") != "" {
 		taskOptions["localScriptGitId"] = d.Get("local_repository_id")
 	}
@@ -237,12 +242,21 @@ func resourceShellScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 		taskOptions["visibility"] = d.Get("visibility")
 	}
 
+	credential := make(map[string]interface{})
+	if d.Get("remote_target_credential_id").(int) != 0 {
+		credential["type"] = "username-password"
+		credential["id"] = d.Get("remote_target_credential_id").(int)
+	} else {
+		credential["type"] = "local"
+	}
+
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
 		for _, s := range attr.(*schema.Set).List() {
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -253,6 +267,7 @@ func resourceShellScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 				"file":              sourceOptions,
 				"taskType":          taskType,
 				"taskOptions":       taskOptions,
+				"credential":        credential,
 				"resultType":        d.Get("result_type"),
 				"executeTarget":     d.Get("execute_target").(string),
 				"localScriptGitRef": d.Get("local_repository_ref"),
@@ -265,7 +280,7 @@ func resourceShellScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -283,8 +298,6 @@ func resourceShellScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceShellScriptTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -295,9 +308,9 @@ func resourceShellScriptTaskRead(ctx context.Context, d *schema.ResourceData, me
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -315,6 +328,8 @@ func resourceShellScriptTaskRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -325,14 +340,10 @@ func resourceShellScriptTaskRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("code", shellScriptTask.Code)
 	d.Set("labels", shellScriptTask.Labels)
 	d.Set("result_type", shellScriptTask.ResultType)
-	d.Set("source_type", shellScriptTask.File.SourceType)
-	d.Set("script_content", shellScriptTask.File.Content)
-	d.Set("script_path", shellScriptTask.File.ContentPath)
-	d.Set("version_ref", shellScriptTask.File.ContentRef)
+	setSourceFile(d, SourceFile(shellScriptTask.File), "script_content", "script_path")
 	d.Set("execute_target", shellScriptTask.ExecuteTarget)
 	d.Set("local_repository_id", shellScriptTask.TaskOptions.LocalScriptGitId)
 	d.Set("local_repository_ref", shellScriptTask.TaskOptions.LocalScriptGitRef)
-	d.Set("repository_id", shellScriptTask.File.Repository.ID)
 	if shellScriptTask.TaskOptions.ShellSudo == "on" {
 		d.Set("sudo", true)
 	} else {
@@ -342,6 +353,8 @@ func resourceShellScriptTaskRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("remote_target_port", shellScriptTask.TaskOptions.Port)
 	d.Set("remote_target_username", shellScriptTask.TaskOptions.Username)
 	d.Set("remote_target_password", shellScriptTask.TaskOptions.PasswordHash)
+	d.Set("remote_target_credential_id", shellScriptTask.Credential.ID)
+	d.Set("remote_target_key_pair_id", stringToInt64(shellScriptTask.TaskOptions.SshKey))
 	d.Set("retryable", shellScriptTask.Retryable)
 	d.Set("retry_count", shellScriptTask.RetryCount)
 	d.Set("retry_delay_seconds", shellScriptTask.RetryDelaySeconds)
@@ -351,22 +364,10 @@ func resourceShellScriptTaskRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceShellScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("script_content") != "" {
-		sourceOptions["content"] = d.Get("script_content")
-	}
-	if d.Get("script_path") != "" {
-		sourceOptions["contentPath"] = d.Get("script_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "script_content", "script_path")
 
 	taskType := make(map[string]interface{})
 	taskType["code"] = "script"
@@ -389,6 +390,9 @@ func resourceShellScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChange("remote_target_password") {
 		taskOptions["password"] = d.Get("remote_target_password")
 	}
+	if d.HasChange("remote_target_key_pair_id") {
+		taskOptions["sshKey"] = d.Get("remote_target_key_pair_id").(int)
+	}
 	if d.HasChange("local_repository_id") {
 		taskOptions["localScriptGitId"] = d.Get("local_repository_id")
 	}
@@ -399,12 +403,21 @@ func resourceShellScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 		taskOptions["visibility"] = d.Get("visibility")
 	}
 
+	credential := make(map[string]interface{})
+	if d.Get("remote_target_credential_id").(int) != 0 {
+		credential["type"] = "username-password"
+		credential["id"] = d.Get("remote_target_credential_id").(int)
+	} else {
+		credential["type"] = "local"
+	}
+
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
 		for _, s := range attr.(*schema.Set).List() {
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -415,6 +428,7 @@ func resourceShellScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 				"file":              sourceOptions,
 				"taskType":          taskType,
 				"taskOptions":       taskOptions,
+				"credential":        credential,
 				"resultType":        d.Get("result_type"),
 				"executeTarget":     d.Get("execute_target").(string),
 				"localScriptGitRef": d.Get("local_repository_ref"),
@@ -427,7 +441,9 @@ func resourceShellScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 			},
 		},
 	}
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -442,14 +458,14 @@ func resourceShellScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceShellScriptTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
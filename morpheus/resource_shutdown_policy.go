@@ -0,0 +1,20 @@
+package morpheus
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// shutdownPolicy is the lifecycle policy shutting down the instances
+var shutdownPolicy = lifecyclePolicy{
+	code:         "shutdown",
+	name:         "Shutdown",
+	configPrefix: "shutdown",
+	noun:         "shutdown",
+	outcome:      "is shut down",
+	daysKey:      "shutdown_days",
+	hideKey:      "hide_shutdown_if_fixed",
+}
+
+func resourceShutdownPolicy() *schema.Resource {
+	return shutdownPolicy.resource("Provides a Morpheus shutdown policy resource, setting the number of days after which the provisioned instances are shut down, along with the extensions of the shutdown the owners can request")
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,6 +18,9 @@ func resourceStandardCloud() *schema.Resource {
 		ReadContext:   resourceStandardCloudRead,
 		UpdateContext: resourceStandardCloudUpdate,
 		DeleteContext: resourceStandardCloudDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -140,15 +142,16 @@ func resourceStandardCloud() *schema.Resource {
 				Computed:    true,
 			},
 			*/
+			"wait_for_sync": cloudWaitForSyncSchema(),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importCloudState,
 		},
 	}
 }
 
 func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -232,25 +235,7 @@ func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, me
 	result := resp.Result.(*morpheus.CreateCloudResult)
 	cloudOutput := result.Cloud
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"initializing", "syncing"},
-		Target:  []string{"ok"},
-		Refresh: func() (interface{}, string, error) {
-			cloudDetails, err := client.GetCloud(cloudOutput.ID, &morpheus.Request{})
-			if err != nil {
-				return "", "", err
-			}
-			result := cloudDetails.Result.(*morpheus.GetCloudResult)
-			cloudStatus := result.Cloud
-			return result, cloudStatus.Status, nil
-		},
-		Timeout:      1 * time.Hour,
-		MinTimeout:   1 * time.Minute,
-		PollInterval: 30 * time.Second,
-	}
-
-	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	err = waitForCloud(ctx, client, cloudOutput.ID, d.Timeout(schema.TimeoutCreate), d.Get("wait_for_sync").(bool))
 	if err != nil {
 		return diag.Errorf("error creating cloud: %s", err)
 	}
@@ -285,7 +270,9 @@ func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, me
 		filePayloads = append(filePayloads, darkLogoPayload)
 	}
 
-	response, err := client.UpdateCloudLogo(cloudOutput.ID, filePayloads, &morpheus.Request{})
+	response, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateCloudLogo(cloudOutput.ID, filePayloads, &morpheus.Request{})
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", response, err)
 	}
@@ -298,7 +285,7 @@ func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceStandardCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -325,6 +312,7 @@ func resourceStandardCloudRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetCloudResult)
@@ -372,7 +360,7 @@ func resourceStandardCloudRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceStandardCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	cloud := make(map[string]interface{})
 	// Name
@@ -441,7 +429,9 @@ func resourceStandardCloudUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	req := &morpheus.Request{Body: payload}
-	resp, err := client.UpdateCloud(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateCloud(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -480,7 +470,9 @@ func resourceStandardCloudUpdate(ctx context.Context, d *schema.ResourceData, me
 		filePayloads = append(filePayloads, darkLogoPayload)
 	}
 
-	response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
+	response, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", response, err)
 	}
@@ -493,14 +485,16 @@ func resourceStandardCloudUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceStandardCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteCloud(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCloud(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -13,7 +13,7 @@ import (
 
 func resourceTagPolicy() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus tag policy resource",
+		Description:   "Provides a Morpheus tag policy resource, requiring a tag on the instances provisioned in its scope, optionally with a fixed value or with a value from an option list",
 		CreateContext: resourceTagPolicyCreate,
 		ReadContext:   resourceTagPolicyRead,
 		UpdateContext: resourceTagPolicyUpdate,
@@ -44,26 +44,28 @@ func resourceTagPolicy() *schema.Resource {
 			},
 			"strict_enforcement": {
 				Type:        schema.TypeBool,
-				Description: "Whether users will be able to provision new workloads if they violate the tag policy",
+				Description: "Whether the provisioning of the workloads violating the tag policy is refused (true) or only reported as a warning (false)",
 				Optional:    true,
-				Computed:    true,
+				Default:     false,
 			},
 			"tag_key": {
 				Type:        schema.TypeString,
-				Description: "The key of the tag to enforce",
+				Description: "The name of the tag required by the policy",
 				Required:    true,
 			},
 			"tag_value": {
-				Type:        schema.TypeString,
-				Description: "The value of the tag to enforce",
-				Optional:    true,
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "The value the tag is required to have",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"option_list_id"},
 			},
 			"option_list_id": {
-				Type:        schema.TypeInt,
-				Description: "The id of the option list associated with the policy",
-				Optional:    true,
-				Computed:    true,
+				Type:          schema.TypeInt,
+				Description:   "The id of the option list holding the allowed values of the tag",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"tag_value"},
 			},
 			"scope": {
 				Type:         schema.TypeString,
@@ -108,7 +110,7 @@ func resourceTagPolicy() *schema.Resource {
 }
 
 func resourceTagPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -175,7 +177,7 @@ func resourceTagPolicyCreate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceTagPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -205,6 +207,7 @@ func resourceTagPolicyRead(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -217,7 +220,7 @@ func resourceTagPolicyRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("strict_enforcement", tagPolicy.Config.Strict)
 	d.Set("tag_key", tagPolicy.Config.Key)
 	d.Set("tag_value", tagPolicy.Config.Value)
-	d.Set("option_list_id", tagPolicy.Config.ValueListId)
+	d.Set("option_list_id", jsonInt64Value(tagPolicy.Config.ValueListId))
 
 	switch tagPolicy.RefType {
 	case "ComputeSite":
@@ -246,7 +249,7 @@ func resourceTagPolicyRead(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceTagPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -294,7 +297,9 @@ func resourceTagPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		},
 	}
 
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -310,14 +315,16 @@ func resourceTagPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceTagPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
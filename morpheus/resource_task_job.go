@@ -83,7 +83,7 @@ func resourceTaskJob() *schema.Resource {
 			},
 			"server_label": {
 				Type:          schema.TypeString,
-				Description:   "The server label used for dynamic automation targeting",
+				Description:   "The server label used for dynamic automation targeting, the servers carrying the label are resolved each time the job runs",
 				Optional:      true,
 				ConflictsWith: []string{"instance_ids", "server_ids", "instance_label"},
 			},
@@ -96,24 +96,40 @@ func resourceTaskJob() *schema.Resource {
 			},
 			"instance_label": {
 				Type:          schema.TypeString,
-				Description:   "The instance label used for dynamic automation targeting",
+				Description:   "The instance label used for dynamic automation targeting, the instances carrying the label are resolved each time the job runs",
 				Optional:      true,
 				ConflictsWith: []string{"instance_ids", "server_ids", "server_label"},
 			},
-			"custom_config": {
+			"custom_config": jsonStringSchema(&schema.Schema{
 				Type:        schema.TypeString,
-				Description: "The task custom configuration",
+				Description: "The custom configuration passed to the task, a JSON document",
 				Optional:    true,
-			},
+			}),
 		},
+		CustomizeDiff: jobTargetCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
+// jobTargetCustomizeDiff ensures the label is set when a job targets instances or servers by label
+func jobTargetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	switch d.Get("context_type").(string) {
+	case "instance-label":
+		if d.NewValueKnown("instance_label") && d.Get("instance_label").(string) == "" {
+			return fmt.Errorf("instance_label must be set when context_type is instance-label")
+		}
+	case "server-label":
+		if d.NewValueKnown("server_label") && d.Get("server_label").(string) == "" {
+			return fmt.Errorf("server_label must be set when context_type is server-label")
+		}
+	}
+	return nil
+}
+
 func resourceTaskJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -127,6 +143,7 @@ func resourceTaskJobCreate(ctx context.Context, d *schema.ResourceData, meta int
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	job["labels"] = labelsPayload
 	job["enabled"] = d.Get("enabled").(bool)
 	job["task"] = map[string]int{
@@ -191,10 +208,16 @@ func resourceTaskJobCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	var result map[string]interface{}
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
-		log.Fatal(err)
+		return diag.FromErr(err)
 	}
 
-	jobId := fmt.Sprintf("%v", result["id"])
+	jobId := jsonStringValue(result["id"])
+	if job, ok := result["job"].(map[string]interface{}); ok && jobId == "" {
+		jobId = jsonStringValue(job["id"])
+	}
+	if jobId == "" {
+		return diag.Errorf("Job not found in response data.") // should not happen
+	}
 	// Successfully created resource, now set id
 	d.SetId(jobId)
 
@@ -203,7 +226,7 @@ func resourceTaskJobCreate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceTaskJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -233,6 +256,7 @@ func resourceTaskJobRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetJobResult)
@@ -289,16 +313,20 @@ func resourceTaskJobRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 		d.Set("server_ids", serverIds)
 	case "instance-label":
-		d.Set("instance_label", taskJob.Targets[0].Name)
+		if len(taskJob.Targets) > 0 {
+			d.Set("instance_label", taskJob.Targets[0].Name)
+		}
 	case "server-label":
-		d.Set("server_label", taskJob.Targets[0].Name)
+		if len(taskJob.Targets) > 0 {
+			d.Set("server_label", taskJob.Targets[0].Name)
+		}
 	}
 
 	return diags
 }
 
 func resourceTaskJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	job := make(map[string]interface{})
@@ -310,6 +338,7 @@ func resourceTaskJobUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	job["labels"] = labelsPayload
 	job["enabled"] = d.Get("enabled").(bool)
 	job["task"] = map[string]int{
@@ -366,7 +395,9 @@ func resourceTaskJobUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		},
 	}
 
-	resp, err := client.UpdateJob(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateJob(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -375,7 +406,7 @@ func resourceTaskJobUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	var result map[string]interface{}
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
-		log.Fatal(err)
+		return diag.FromErr(err)
 	}
 
 	// Successfully updated resource, now set id
@@ -386,14 +417,16 @@ func resourceTaskJobUpdate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceTaskJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteJob(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteJob(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -14,7 +14,7 @@ import (
 
 func resourceTenantRole() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus tenant role resource (This resource requires Morpheus 6.0.4 or later).",
+		Description:   "Provides a Morpheus tenant role resource.",
 		CreateContext: resourceTenantRoleCreate,
 		ReadContext:   resourceTenantRoleRead,
 		UpdateContext: resourceTenantRoleUpdate,
@@ -37,13 +37,17 @@ func resourceTenantRole() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
-			"permission_set": {
-				Type:             schema.TypeString,
-				Description:      "The permission set JSON document",
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			"landing_url": {
+				Type:        schema.TypeString,
+				Description: "The relative url, such as /provisioning/instances, the users assigned the tenant role land on after logging in instead of the default landing page of their persona",
+				Optional:    true,
 			},
+			"permission_set": jsonStringSchema(&schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The permission set JSON document",
+				Optional:    true,
+				Computed:    true,
+			}),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -52,7 +56,7 @@ func resourceTenantRole() *schema.Resource {
 }
 
 func resourceTenantRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -65,6 +69,7 @@ func resourceTenantRoleCreate(ctx context.Context, d *schema.ResourceData, meta
 	var roleDefinition TenantRolePermissionPayload
 	roleDefinition.Name = d.Get("name").(string)
 	roleDefinition.Description = d.Get("description").(string)
+	roleDefinition.LandingUrl = d.Get("landing_url").(string)
 	roleDefinition.RoleType = "account"
 	roleDefinition.DefaultPersona.Code = data.DefaultPersona
 	roleDefinition.GlobalCloudAccess = data.DefaultCloudPermission
@@ -115,7 +120,7 @@ func resourceTenantRoleCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceTenantRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -145,6 +150,7 @@ func resourceTenantRoleRead(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetRoleResult)
@@ -153,6 +159,7 @@ func resourceTenantRoleRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.SetId(int64ToString(role.Role.ID))
 	d.Set("name", role.Role.Authority)
 	d.Set("description", role.Role.Description)
+	d.Set("landing_url", role.Role.LandingUrl)
 
 	// Convert the Morpheus API response into the permission set JSON format for comparison
 	data := PermissionSet{}
@@ -374,7 +381,7 @@ func resourceTenantRoleRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceTenantRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	data := PermissionSet{}
@@ -385,6 +392,7 @@ func resourceTenantRoleUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var roleDefinition TenantRolePermissionPayload
 	roleDefinition.Name = d.Get("name").(string)
 	roleDefinition.Description = d.Get("description").(string)
+	roleDefinition.LandingUrl = d.Get("landing_url").(string)
 	roleDefinition.RoleType = "account"
 	roleDefinition.DefaultPersona.Code = data.DefaultPersona
 	roleDefinition.GlobalCloudAccess = data.DefaultCloudPermission
@@ -412,7 +420,9 @@ func resourceTenantRoleUpdate(ctx context.Context, d *schema.ResourceData, meta
 		},
 	}
 
-	resp, err := client.UpdateRole(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateRole(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -430,14 +440,16 @@ func resourceTenantRoleUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceTenantRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteRole(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteRole(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -455,6 +467,7 @@ func resourceTenantRoleDelete(ctx context.Context, d *schema.ResourceData, meta
 type TenantRolePermissionPayload struct {
 	Name           string `json:"authority"`
 	Description    string `json:"description"`
+	LandingUrl     string `json:"landingUrl"`
 	Owner          int64  `json:"owner"`
 	RoleType       string `json:"roleType"`
 	DefaultPersona struct {
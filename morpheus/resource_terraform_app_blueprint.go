@@ -113,7 +113,7 @@ func resourceTerraformAppBlueprint() *schema.Resource {
 }
 
 func resourceTerraformAppBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -144,12 +144,7 @@ func resourceTerraformAppBlueprintCreate(ctx context.Context, d *schema.Resource
 		terraformConfig["json"] = d.Get("blueprint_content").(string)
 	case "repository":
 		terraformConfig["configType"] = "git"
-		terraformGitConfig := make(map[string]interface{})
-		terraformGitConfig["integrationId"] = d.Get("integration_id")
-		terraformGitConfig["repoId"] = d.Get("repository_id")
-		terraformGitConfig["branch"] = d.Get("version_ref").(string)
-		terraformGitConfig["path"] = d.Get("working_path").(string)
-		terraformConfig["git"] = terraformGitConfig
+		terraformConfig["git"] = appBlueprintGitPayload(d)
 	case "spec":
 		terraformConfig["configType"] = "spec"
 		var spec_templates []map[string]interface{}
@@ -197,7 +192,7 @@ func resourceTerraformAppBlueprintCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTerraformAppBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -229,6 +224,7 @@ func resourceTerraformAppBlueprintRead(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	var terraformBlueprint TerraformAppBlueprint
@@ -252,10 +248,7 @@ func resourceTerraformAppBlueprintRead(ctx context.Context, d *schema.ResourceDa
 		d.Set("blueprint_content", terraformBlueprint.Blueprint.Config.Terraform.JSON)
 	case "git":
 		d.Set("source_type", "repository")
-		d.Set("working_path", terraformBlueprint.Blueprint.Config.Terraform.Git.Path)
-		d.Set("integration_id", terraformBlueprint.Blueprint.Config.Terraform.Git.IntegrationId)
-		d.Set("repository_id", terraformBlueprint.Blueprint.Config.Terraform.Git.RepoId)
-		d.Set("version_ref", terraformBlueprint.Blueprint.Config.Terraform.Git.Branch)
+		setAppBlueprintGit(d, terraformBlueprint.Blueprint.Config.Terraform.Git)
 	case "spec":
 		d.Set("source_type", "spec")
 		// spec templates
@@ -273,7 +266,7 @@ func resourceTerraformAppBlueprintRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceTerraformAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	blueprint_type := "terraform"
@@ -301,12 +294,7 @@ func resourceTerraformAppBlueprintUpdate(ctx context.Context, d *schema.Resource
 		terraformConfig["json"] = d.Get("blueprint_content").(string)
 	case "repository":
 		terraformConfig["configType"] = "git"
-		terraformGitConfig := make(map[string]interface{})
-		terraformGitConfig["integrationId"] = d.Get("integration_id")
-		terraformGitConfig["repoId"] = d.Get("repository_id")
-		terraformGitConfig["branch"] = d.Get("version_ref").(string)
-		terraformGitConfig["path"] = d.Get("working_path").(string)
-		terraformConfig["git"] = terraformGitConfig
+		terraformConfig["git"] = appBlueprintGitPayload(d)
 	case "spec":
 		terraformConfig["configType"] = "spec"
 		var spec_templates []map[string]interface{}
@@ -336,7 +324,9 @@ func resourceTerraformAppBlueprintUpdate(ctx context.Context, d *schema.Resource
 			},
 		},
 	}
-	resp, err := client.UpdateBlueprint(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateBlueprint(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -351,14 +341,16 @@ func resourceTerraformAppBlueprintUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTerraformAppBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteBlueprint(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteBlueprint(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -384,18 +376,13 @@ type TerraformAppBlueprint struct {
 			Name        string `json:"name"`
 			Description string `json:"description"`
 			Terraform   struct {
-				Tfversion      string `json:"tfVersion"`
-				Tf             string `json:"tf"`
-				Tfvarsecret    string `json:"tfvarSecret"`
-				Commandoptions string `json:"commandOptions"`
-				Configtype     string `json:"configType"`
-				JSON           string `json:"json"`
-				Git            struct {
-					Path          string `json:"path"`
-					RepoId        int    `json:"repoId"`
-					IntegrationId int    `json:"integrationId"`
-					Branch        string `json:"branch"`
-				} `json:"git"`
+				Tfversion      string          `json:"tfVersion"`
+				Tf             string          `json:"tf"`
+				Tfvarsecret    string          `json:"tfvarSecret"`
+				Commandoptions string          `json:"commandOptions"`
+				Configtype     string          `json:"configType"`
+				JSON           string          `json:"json"`
+				Git            appBlueprintGit `json:"git"`
 			} `json:"terraform"`
 			Config struct {
 				Specs []struct {
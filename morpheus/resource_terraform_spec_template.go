@@ -66,47 +66,55 @@ func resourceTerraformSpecTemplate() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"terraform_version": {
+				Type:        schema.TypeString,
+				Description: "The version of terraform used to apply the terraform spec template",
+				Optional:    true,
+				Computed:    true,
+			},
+			"config":    specTemplateConfigSchema(),
+			"tenant_id": tenantIdSchema("The id of the tenant the terraform spec template is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceTerraformSpecTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("spec_content") != "" {
-		sourceOptions["content"] = d.Get("spec_content")
-	}
-	if d.Get("spec_path") != "" {
-		sourceOptions["contentPath"] = d.Get("spec_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "spec_content", "spec_path")
 
 	specTemplateType := make(map[string]interface{})
 	specTemplateType["code"] = "terraform"
 
+	typedConfig := make(map[string]interface{})
+	if terraformVersion := d.Get("terraform_version").(string); terraformVersion != "" {
+		typedConfig["terraform"] = map[string]interface{}{
+			"tfVersion": terraformVersion,
+		}
+	}
+	config, err := specTemplateConfigPayload(d, typedConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"specTemplate": map[string]interface{}{
-				"name": name,
-				"file": sourceOptions,
-				"type": specTemplateType,
+				"name":   name,
+				"file":   sourceOptions,
+				"type":   specTemplateType,
+				"config": config,
 			},
 		},
 	}
-	resp, err := client.CreateSpecTemplate(req)
+	resp, err := specTemplateApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -123,8 +131,6 @@ func resourceTerraformSpecTemplateCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTerraformSpecTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -135,9 +141,9 @@ func resourceTerraformSpecTemplateRead(ctx context.Context, d *schema.ResourceDa
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindSpecTemplateByName(name)
+		resp, err = specTemplateApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetSpecTemplate(toInt64(id), &morpheus.Request{})
+		resp, err = specTemplateApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Spec template cannot be read without name or id")
 	}
@@ -155,6 +161,8 @@ func resourceTerraformSpecTemplateRead(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	var terraformSpecTemplate TerraformSpecTemplate
@@ -163,55 +171,49 @@ func resourceTerraformSpecTemplateRead(ctx context.Context, d *schema.ResourceDa
 	}
 	d.SetId(intToString(terraformSpecTemplate.Spectemplate.ID))
 	d.Set("name", terraformSpecTemplate.Spectemplate.Name)
-	d.Set("source_type", terraformSpecTemplate.Spectemplate.File.Sourcetype)
+	setSourceFile(d, terraformSpecTemplate.Spectemplate.File, "spec_content", "spec_path")
 
-	switch terraformSpecTemplate.Spectemplate.File.Sourcetype {
-	case "local":
-		d.Set("source_type", "local")
-		d.Set("spec_content", terraformSpecTemplate.Spectemplate.File.Content)
-	case "url":
-		d.Set("source_type", "url")
-		d.Set("spec_path", terraformSpecTemplate.Spectemplate.File.Contentpath)
-	case "git":
-		d.Set("source_type", "repository")
-		d.Set("spec_path", terraformSpecTemplate.Spectemplate.File.Contentpath)
-		d.Set("repository_id", terraformSpecTemplate.Spectemplate.File.Repository.ID)
-		d.Set("version_ref", terraformSpecTemplate.Spectemplate.File.Contentref)
+	d.Set("terraform_version", terraformSpecTemplate.Spectemplate.Config.Terraform.TfVersion)
+	if err := setSpecTemplateConfig(d, resp, "terraform"); err != nil {
+		return diag.FromErr(err)
 	}
+
 	return diags
 }
 
 func resourceTerraformSpecTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
-	sourceOptions := make(map[string]interface{})
-	if d.Get("spec_content") != "" {
-		sourceOptions["content"] = d.Get("spec_content")
-	}
-	if d.Get("spec_path") != "" {
-		sourceOptions["contentPath"] = d.Get("spec_path")
-	}
-	sourceOptions["contentRef"] = d.Get("version_ref")
-	sourceOptions["repository"] = map[string]interface{}{
-		"id": d.Get("repository_id"),
-	}
-	sourceOptions["sourceType"] = d.Get("source_type")
+	sourceOptions := sourceFilePayload(d, "spec_content", "spec_path")
 
 	specTemplateType := make(map[string]interface{})
 	specTemplateType["code"] = "terraform"
 
+	typedConfig := make(map[string]interface{})
+	if terraformVersion := d.Get("terraform_version").(string); terraformVersion != "" {
+		typedConfig["terraform"] = map[string]interface{}{
+			"tfVersion": terraformVersion,
+		}
+	}
+	config, err := specTemplateConfigPayload(d, typedConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
 			"specTemplate": map[string]interface{}{
-				"name": name,
-				"file": sourceOptions,
-				"type": specTemplateType,
+				"name":   name,
+				"file":   sourceOptions,
+				"type":   specTemplateType,
+				"config": config,
 			},
 		},
 	}
-	resp, err := client.UpdateSpecTemplate(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return specTemplateApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -226,14 +228,14 @@ func resourceTerraformSpecTemplateUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTerraformSpecTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteSpecTemplate(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return specTemplateApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -266,20 +268,13 @@ type TerraformSpecTemplate struct {
 		Externaltype interface{} `json:"externalType"`
 		Deploymentid interface{} `json:"deploymentId"`
 		Status       interface{} `json:"status"`
-		File         struct {
-			ID          int         `json:"id"`
-			Sourcetype  string      `json:"sourceType"`
-			Contentref  interface{} `json:"contentRef"`
-			Contentpath interface{} `json:"contentPath"`
-			Repository  struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-			} `json:"repository"`
-			Content string `json:"content"`
-		} `json:"file"`
-		Config struct {
+		File         SourceFile  `json:"file"`
+		Config       struct {
+			Terraform struct {
+				TfVersion string `json:"tfVersion"`
+			} `json:"terraform"`
 		} `json:"config"`
-		Createdby   string      `json:"createdBy"`
+		Createdby   JSONString  `json:"createdBy"`
 		Updatedby   interface{} `json:"updatedBy"`
 		Datecreated time.Time   `json:"dateCreated"`
 		Lastupdated time.Time   `json:"lastUpdated"`
@@ -8,6 +8,7 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceMorpheusUser() *schema.Resource {
@@ -93,6 +94,12 @@ func resourceMorpheusUser() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"default_persona": {
+				Description:  "The code of the persona the user lands on after logging in (standard, serviceCatalog, vdi), the default persona of the roles of the user applies when not set",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"standard", "serviceCatalog", "vdi"}, false),
+			},
 			"windows_username": {
 				Description: "The username assigned to windows instances for this user account",
 				Type:        schema.TypeString,
@@ -105,6 +112,7 @@ func resourceMorpheusUser() *schema.Resource {
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"keepers": keepersSchema("Arbitrary values that, when changed, send the passwords of the user account to the appliance again, to force their rotation", false),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -113,7 +121,7 @@ func resourceMorpheusUser() *schema.Resource {
 }
 
 func resourceMorpheusUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -159,6 +167,11 @@ func resourceMorpheusUserCreate(ctx context.Context, d *schema.ResourceData, met
 			},
 		},
 	}
+	if persona := d.Get("default_persona").(string); persona != "" {
+		req.Body["user"].(map[string]interface{})["defaultPersona"] = map[string]interface{}{
+			"code": persona,
+		}
+	}
 
 	resp, err := client.CreateUser(req)
 	if err != nil {
@@ -176,7 +189,7 @@ func resourceMorpheusUserCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceMorpheusUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -206,6 +219,7 @@ func resourceMorpheusUserRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetUserResult)
@@ -225,6 +239,11 @@ func resourceMorpheusUserRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("linux_keypair_id", user.LinuxKeyPairID)
 		d.Set("linux_username", user.LinuxUsername)
 		d.Set("windows_username", user.WindowsUsername)
+		if persona, ok := user.DefaultPersona.(map[string]interface{}); ok {
+			d.Set("default_persona", persona["code"])
+		} else {
+			d.Set("default_persona", "")
+		}
 	} else {
 		return diag.Errorf("User not found in response data.") // should not happen
 	}
@@ -233,7 +252,7 @@ func resourceMorpheusUserRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceMorpheusUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	// roles
@@ -267,8 +286,18 @@ func resourceMorpheusUserUpdate(ctx context.Context, d *schema.ResourceData, met
 			},
 		},
 	}
+	if persona := d.Get("default_persona").(string); persona != "" {
+		req.Body["user"].(map[string]interface{})["defaultPersona"] = map[string]interface{}{
+			"code": persona,
+		}
+	} else {
+		// reset the persona so the default persona of the roles applies again
+		req.Body["user"].(map[string]interface{})["defaultPersona"] = nil
+	}
 
-	resp, err := client.UpdateUser(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateUser(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -283,14 +312,16 @@ func resourceMorpheusUserUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceMorpheusUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteUserResult(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteUserResult(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -13,7 +13,7 @@ import (
 
 func resourceUserCreationPolicy() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus user creation policy resource",
+		Description:   "Provides a Morpheus user creation policy resource, enforcing or defaulting the creation of the user provisioning the instances on the Linux and Windows instances provisioned in its scope. The users of a user group are created along with the morpheus_user_group_creation_policy resource.",
 		CreateContext: resourceUserCreationPolicyCreate,
 		ReadContext:   resourceUserCreationPolicyRead,
 		UpdateContext: resourceUserCreationPolicyUpdate,
@@ -43,13 +43,14 @@ func resourceUserCreationPolicy() *schema.Resource {
 				Default:     true,
 			},
 			"enforcement_type": {
-				Type:        schema.TypeString,
-				Description: "The policy enforcement type (fixed or user)",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "The policy enforcement type, fixed or user configurable on the instance provisioning wizard (fixed, user)",
+				ValidateFunc: validation.StringInSlice([]string{"fixed", "user"}, false),
+				Required:     true,
 			},
 			"create_user": {
 				Type:        schema.TypeBool,
-				Description: "Whether to create a user",
+				Description: "Whether to create the user provisioning the instance on the instance, the default value of the provisioning wizard when the enforcement type is user",
 				Required:    true,
 			},
 			"scope": {
@@ -108,7 +109,7 @@ func resourceUserCreationPolicy() *schema.Resource {
 }
 
 func resourceUserCreationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -185,7 +186,7 @@ func resourceUserCreationPolicyCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceUserCreationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -215,6 +216,7 @@ func resourceUserCreationPolicyRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -225,7 +227,7 @@ func resourceUserCreationPolicyRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("description", userCreationPolicy.Description)
 	d.Set("enabled", userCreationPolicy.Enabled)
 	d.Set("enforcement_type", userCreationPolicy.Config.CreateUserType)
-	d.Set("create_user", userCreationPolicy.Config.CreateUser)
+	d.Set("create_user", jsonBoolValue(userCreationPolicy.Config.CreateUser))
 	switch userCreationPolicy.RefType {
 	case "ComputeSite":
 		d.Set("scope", "group")
@@ -257,7 +259,7 @@ func resourceUserCreationPolicyRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceUserCreationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -314,7 +316,9 @@ func resourceUserCreationPolicyUpdate(ctx context.Context, d *schema.ResourceDat
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -330,14 +334,16 @@ func resourceUserCreationPolicyUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceUserCreationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -62,7 +62,7 @@ func resourceUserGroup() *schema.Resource {
 }
 
 func resourceUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -97,7 +97,7 @@ func resourceUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceUserGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -127,6 +127,7 @@ func resourceUserGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 	// store resource data
 	result := resp.Result.(*morpheus.GetUserGroupResult)
 	userGroup := result.UserGroup
@@ -150,7 +151,7 @@ func resourceUserGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	userGroup := make(map[string]interface{})
@@ -166,7 +167,9 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			"userGroup": userGroup,
 		},
 	}
-	resp, err := client.UpdateUserGroup(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateUserGroup(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -182,14 +185,16 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteUserGroup(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteUserGroup(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -13,7 +13,7 @@ import (
 
 func resourceUserGroupCreationPolicy() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus user group creation policy resource",
+		Description:   "Provides a Morpheus user group creation policy resource, selecting the user group whose users are created on the Linux and Windows instances provisioned in its scope",
 		CreateContext: resourceUserGroupCreationPolicyCreate,
 		ReadContext:   resourceUserGroupCreationPolicyRead,
 		UpdateContext: resourceUserGroupCreationPolicyUpdate,
@@ -103,7 +103,7 @@ func resourceUserGroupCreationPolicy() *schema.Resource {
 }
 
 func resourceUserGroupCreationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -174,7 +174,7 @@ func resourceUserGroupCreationPolicyCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceUserGroupCreationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -204,6 +204,7 @@ func resourceUserGroupCreationPolicyRead(ctx context.Context, d *schema.Resource
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -213,7 +214,7 @@ func resourceUserGroupCreationPolicyRead(ctx context.Context, d *schema.Resource
 	d.Set("name", userGroupCreationPolicy.Name)
 	d.Set("description", userGroupCreationPolicy.Description)
 	d.Set("enabled", userGroupCreationPolicy.Enabled)
-	d.Set("user_group_id", userGroupCreationPolicy.Config.UserGroup)
+	d.Set("user_group_id", jsonInt64Value(userGroupCreationPolicy.Config.UserGroup))
 	switch userGroupCreationPolicy.RefType {
 	case "ComputeSite":
 		d.Set("scope", "group")
@@ -245,7 +246,7 @@ func resourceUserGroupCreationPolicyRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceUserGroupCreationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -297,7 +298,9 @@ func resourceUserGroupCreationPolicyUpdate(ctx context.Context, d *schema.Resour
 		},
 	}
 
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -313,14 +316,16 @@ func resourceUserGroupCreationPolicyUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceUserGroupCreationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -14,7 +14,7 @@ import (
 
 func resourceUserRole() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus user role resource (This resource requires Morpheus 6.0.4 or later).",
+		Description:   "Provides a Morpheus user role resource.",
 		CreateContext: resourceUserRoleCreate,
 		ReadContext:   resourceUserRoleRead,
 		UpdateContext: resourceUserRoleUpdate,
@@ -49,13 +49,17 @@ func resourceUserRole() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
-			"permission_set": {
-				Type:             schema.TypeString,
-				Description:      "The permission set JSON document",
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			"landing_url": {
+				Type:        schema.TypeString,
+				Description: "The relative url, such as /provisioning/instances, the users assigned the user role land on after logging in instead of the default landing page of their persona",
+				Optional:    true,
 			},
+			"permission_set": jsonStringSchema(&schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The permission set JSON document",
+				Optional:    true,
+				Computed:    true,
+			}),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -64,7 +68,7 @@ func resourceUserRole() *schema.Resource {
 }
 
 func resourceUserRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -77,6 +81,7 @@ func resourceUserRoleCreate(ctx context.Context, d *schema.ResourceData, meta in
 	var roleDefinition RolePermissionPayload
 	roleDefinition.Name = d.Get("name").(string)
 	roleDefinition.Description = d.Get("description").(string)
+	roleDefinition.LandingUrl = d.Get("landing_url").(string)
 	roleDefinition.RoleType = "user"
 	roleDefinition.Multitenant = d.Get("multitenant_role").(bool)
 	roleDefinition.MultitenantLocked = d.Get("multitenant_locked").(bool)
@@ -132,7 +137,7 @@ func resourceUserRoleCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceUserRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -162,6 +167,7 @@ func resourceUserRoleRead(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetRoleResult)
@@ -170,6 +176,7 @@ func resourceUserRoleRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.SetId(int64ToString(role.Role.ID))
 	d.Set("name", role.Role.Authority)
 	d.Set("description", role.Role.Description)
+	d.Set("landing_url", role.Role.LandingUrl)
 	d.Set("multitenant_role", role.Role.MultiTenant)
 	d.Set("multitenant_locked", role.Role.MultiTenantLocked)
 
@@ -396,7 +403,7 @@ func resourceUserRoleRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceUserRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	data := PermissionSet{}
@@ -407,6 +414,7 @@ func resourceUserRoleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	var roleDefinition RolePermissionPayload
 	roleDefinition.Name = d.Get("name").(string)
 	roleDefinition.Description = d.Get("description").(string)
+	roleDefinition.LandingUrl = d.Get("landing_url").(string)
 	roleDefinition.RoleType = "user"
 	roleDefinition.Multitenant = d.Get("multitenant_role").(bool)
 	roleDefinition.MultitenantLocked = d.Get("multitenant_locked").(bool)
@@ -439,7 +447,9 @@ func resourceUserRoleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		},
 	}
 
-	resp, err := client.UpdateRole(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateRole(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -457,14 +467,16 @@ func resourceUserRoleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceUserRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteRole(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteRole(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -509,6 +521,7 @@ type CreateRoleResult struct {
 type RolePermissionPayload struct {
 	Name              string `json:"authority"`
 	Description       string `json:"description"`
+	LandingUrl        string `json:"landingUrl"`
 	Owner             int64  `json:"owner"`
 	RoleType          string `json:"roleType"`
 	Multitenant       bool   `json:"multitenant"`
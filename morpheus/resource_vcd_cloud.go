@@ -0,0 +1,424 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceVcdCloud() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus VMware Cloud Director cloud resource.",
+		CreateContext: resourceVcdCloudCreate,
+		ReadContext:   resourceVcdCloudRead,
+		UpdateContext: resourceVcdCloudUpdate,
+		DeleteContext: resourceVcdCloudDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the cloud",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "A unique name scoped to your account for the cloud",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"code": {
+				Description: "Optional code for use with policies",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"location": {
+				Description: "Optional location for your cloud",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"enabled": {
+				Description: "Determines whether the cloud is active or not",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"automatically_power_on_vms": {
+				Description: "Determines whether to automatically power on cloud virtual machines",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"api_url": {
+				Type:        schema.TypeString,
+				Description: "The URL of the VMware Cloud Director API (https://vcd.morpheus.local)",
+				Required:    true,
+			},
+			"credential_id": {
+				Description:   "The ID of the credential store entry used for authentication",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"username", "password"},
+			},
+			"username": {
+				Type:          schema.TypeString,
+				Description:   "The username of the VMware Cloud Director account (user@org)",
+				Optional:      true,
+				ConflictsWith: []string{"credential_id"},
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Description:   "The password of the VMware Cloud Director account",
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"credential_id"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Description: "The version of the VMware Cloud Director API",
+				Optional:    true,
+				Computed:    true,
+			},
+			"org_id": {
+				Type:        schema.TypeString,
+				Description: "The name of the VMware Cloud Director organization",
+				Required:    true,
+			},
+			"vdc_id": {
+				Type:        schema.TypeString,
+				Description: "The name of the virtual datacenter of the organization",
+				Required:    true,
+			},
+			"catalog": {
+				Type:        schema.TypeString,
+				Description: "The catalog synchronized as virtual images and used to upload images",
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_storage_profile": {
+				Type:        schema.TypeString,
+				Description: "The storage profile used by default for virtual machines provisioned in the cloud",
+				Optional:    true,
+				Computed:    true,
+			},
+			"import_existing_vms": {
+				Type:        schema.TypeBool,
+				Description: "Whether to import existing virtual machines",
+				Optional:    true,
+				Default:     false,
+			},
+			"inventory_level": {
+				Type:         schema.TypeString,
+				Description:  "The level of detail synchronized from VMware Cloud Director on each cloud sync (off, basic, full)",
+				ValidateFunc: validation.StringInSlice([]string{"off", "basic", "full"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"enable_hypervisor_console": {
+				Type:        schema.TypeBool,
+				Description: "Whether to enable VNC access",
+				Optional:    true,
+				Default:     false,
+			},
+			"keyboard_layout": {
+				Type:        schema.TypeString,
+				Description: "The keyboard layout of the hypervisor console",
+				Optional:    true,
+				Default:     "us",
+			},
+			"appliance_url": {
+				Type:        schema.TypeString,
+				Description: "The URL used by workloads provisioned in the cloud for interacting with the Morpheus appliance",
+				Optional:    true,
+			},
+			"time_zone": {
+				Type:        schema.TypeString,
+				Description: "The time zone for the cloud",
+				Optional:    true,
+			},
+			"datacenter_id": {
+				Type:        schema.TypeString,
+				Description: "A custom id used to reference the datacenter for the cloud",
+				Optional:    true,
+			},
+			"guidance": {
+				Type:         schema.TypeString,
+				Description:  "Whether to enable guidance recommendations on the cloud (manual, off)",
+				ValidateFunc: validation.StringInSlice([]string{"manual", "off", ""}, false),
+				Optional:     true,
+				Default:      "off",
+			},
+			"costing": {
+				Type:         schema.TypeString,
+				Description:  "Whether to enable costing on the cloud (off, costing)",
+				ValidateFunc: validation.StringInSlice([]string{"off", "costing", ""}, false),
+				Optional:     true,
+				Default:      "off",
+			},
+			"agent_install_mode": {
+				Type:         schema.TypeString,
+				Description:  "The method used to install the Morpheus agent on virtual machines provisioned in the cloud (ssh, cloudInit)",
+				ValidateFunc: validation.StringInSlice([]string{"ssh", "cloudInit", ""}, false),
+				Optional:     true,
+				Default:      "cloudInit",
+			},
+			"visibility": {
+				Description:  "Determines whether the cloud is visible in sub-tenants or not",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"tenant_id": {
+				Description: "The id of the morpheus tenant the cloud is assigned to",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"wait_for_sync": cloudWaitForSyncSchema(),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importCloudState,
+		},
+	}
+}
+
+func resourceVcdCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	payload := map[string]interface{}{
+		"zone": vcdCloudPayload(d),
+	}
+	req := &morpheus.Request{Body: payload}
+
+	resp, err := client.CreateCloud(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.CreateCloudResult)
+	cloudOutput := result.Cloud
+
+	err = waitForCloud(ctx, client, cloudOutput.ID, d.Timeout(schema.TimeoutCreate), d.Get("wait_for_sync").(bool))
+	if err != nil {
+		return diag.Errorf("error creating cloud: %s", err)
+	}
+
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(cloudOutput.ID))
+	resourceVcdCloudRead(ctx, d, meta)
+	return diags
+}
+
+func resourceVcdCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindCloudByName(name)
+	} else if id != "" {
+		resp, err = client.GetCloud(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Cloud cannot be read without name or id")
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetCloudResult)
+	cloud := result.Cloud
+	if cloud == nil {
+		d.SetId("")
+		return diags
+	}
+	d.SetId(int64ToString(cloud.ID))
+	d.Set("name", cloud.Name)
+	d.Set("code", cloud.Code)
+	d.Set("location", cloud.Location)
+	d.Set("enabled", cloud.Enabled)
+	d.Set("automatically_power_on_vms", cloud.AutoRecoverPowerState)
+	d.Set("api_url", cloud.Config.APIUrl)
+	if cloud.Credential.ID == 0 {
+		d.Set("username", cloud.Config.Username)
+		d.Set("password", cloud.Config.PasswordHash)
+	} else {
+		d.Set("credential_id", cloud.Credential.ID)
+	}
+	d.Set("api_version", cloud.Config.VCDVersion)
+	d.Set("org_id", cloud.Config.OrgID)
+	d.Set("vdc_id", cloud.Config.VDCID)
+	d.Set("catalog", cloud.Config.Catalog)
+	d.Set("default_storage_profile", cloud.Config.DefaultStorageProfile)
+	d.Set("import_existing_vms", cloud.Config.ImportExisting == "on")
+	d.Set("inventory_level", cloud.InventoryLevel)
+	d.Set("enable_hypervisor_console", cloud.Config.EnableVNC == "on")
+	d.Set("keyboard_layout", cloud.ConsoleKeymap)
+	d.Set("appliance_url", cloud.Config.ApplianceUrl)
+	d.Set("time_zone", cloud.TimeZone)
+	d.Set("datacenter_id", cloud.Config.DatacenterName)
+	d.Set("guidance", cloud.GuidanceMode)
+	d.Set("costing", cloud.CostingMode)
+	d.Set("agent_install_mode", cloud.AgentMode)
+	d.Set("visibility", cloud.Visibility)
+	d.Set("tenant_id", strconv.Itoa(int(cloud.AccountID)))
+	return diags
+}
+
+func resourceVcdCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	payload := map[string]interface{}{
+		"zone": vcdCloudPayload(d),
+	}
+
+	req := &morpheus.Request{Body: payload}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateCloud(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateCloudResult)
+	cloudOutput := result.Cloud
+	// Successfully updated resource, now set id
+	d.SetId(int64ToString(cloudOutput.ID))
+	return resourceVcdCloudRead(ctx, d, meta)
+}
+
+func resourceVcdCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCloud(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func vcdCloudPayload(d *schema.ResourceData) map[string]interface{} {
+	cloud := make(map[string]interface{})
+	cloud["name"] = d.Get("name").(string)
+	cloud["code"] = d.Get("code").(string)
+	cloud["location"] = d.Get("location").(string)
+	cloud["visibility"] = d.Get("visibility").(string)
+	account := make(map[string]interface{})
+	account["id"] = d.Get("tenant_id").(string)
+	cloud["account"] = account
+	cloud["accountId"] = d.Get("tenant_id").(string)
+	cloud["enabled"] = d.Get("enabled").(bool)
+	cloud["autoRecoverPowerState"] = d.Get("automatically_power_on_vms").(bool)
+
+	config := make(map[string]interface{})
+	config["certificateProvider"] = "internal"
+	config["apiUrl"] = d.Get("api_url")
+
+	if d.Get("credential_id").(int) != 0 {
+		credential := make(map[string]interface{})
+		credential["type"] = "username-password"
+		credential["id"] = d.Get("credential_id").(int)
+		cloud["credential"] = credential
+	} else {
+		credential := make(map[string]interface{})
+		credential["type"] = "local"
+		cloud["credential"] = credential
+		// only send the credentials when set or changed, the api
+		// returns a hash of the password
+		if d.HasChange("username") {
+			config["username"] = d.Get("username")
+		}
+		if d.HasChange("password") {
+			config["password"] = d.Get("password")
+		}
+	}
+
+	config["vcdVersion"] = d.Get("api_version").(string)
+	config["orgId"] = d.Get("org_id").(string)
+	config["vdcId"] = d.Get("vdc_id").(string)
+	config["catalog"] = d.Get("catalog").(string)
+	config["defaultStorageProfile"] = d.Get("default_storage_profile").(string)
+	if d.Get("import_existing_vms").(bool) {
+		config["importExisting"] = "on"
+	} else {
+		config["importExisting"] = ""
+	}
+	if inventoryLevel, ok := d.GetOk("inventory_level"); ok {
+		cloud["inventoryLevel"] = inventoryLevel.(string)
+	}
+	if d.Get("enable_hypervisor_console").(bool) {
+		config["enableVnc"] = "on"
+	} else {
+		config["enableVnc"] = ""
+	}
+	cloud["consoleKeymap"] = d.Get("keyboard_layout").(string)
+	config["applianceUrl"] = d.Get("appliance_url")
+	cloud["timezone"] = d.Get("time_zone").(string)
+	config["datacenterName"] = d.Get("datacenter_id")
+	cloud["guidanceMode"] = d.Get("guidance").(string)
+	cloud["costingMode"] = d.Get("costing").(string)
+	cloud["agentMode"] = d.Get("agent_install_mode").(string)
+
+	cloudType := make(map[string]interface{})
+	cloudType["code"] = "vcd"
+	cloud["zoneType"] = cloudType
+
+	cloud["config"] = config
+	return cloud
+}
@@ -0,0 +1,441 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceVirtualImage() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus virtual image resource, uploading the image file from a url or from a local file",
+		CreateContext: resourceVirtualImageCreate,
+		ReadContext:   resourceVirtualImageRead,
+		UpdateContext: resourceVirtualImageUpdate,
+		DeleteContext: resourceVirtualImageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the virtual image",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the virtual image",
+				Required:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The organization labels associated with the virtual image",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"image_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the virtual image (qcow2, vmware, ova, iso, raw, vhd, ...)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"storage_provider_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the storage provider the virtual image file is uploaded to, the default storage provider is used when not set",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"url": {
+				Type:          schema.TypeString,
+				Description:   "The url the appliance downloads the virtual image file from",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"file_path"},
+				ValidateFunc:  validation.IsURLWithHTTPorHTTPS,
+			},
+			"file_path": {
+				Type:          schema.TypeString,
+				Description:   "The path of the local virtual image file to upload, the file is streamed to the appliance so large images are not loaded in memory",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"url"},
+			},
+			"file_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the uploaded virtual image file, defaults to the base name of the url or the file path",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"os_type_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the OS type of the virtual image",
+				Optional:    true,
+				Computed:    true,
+			},
+			"is_cloud_init": {
+				Type:        schema.TypeBool,
+				Description: "Whether the virtual image supports cloud-init",
+				Optional:    true,
+				Default:     false,
+			},
+			"user_data": {
+				Type:        schema.TypeString,
+				Description: "The cloud-init user data passed to the instances provisioned from the virtual image",
+				Optional:    true,
+			},
+			"install_agent": {
+				Type:        schema.TypeBool,
+				Description: "Whether to install the Morpheus agent on the instances provisioned from the virtual image",
+				Optional:    true,
+				Default:     true,
+			},
+			"ssh_username": {
+				Type:        schema.TypeString,
+				Description: "The username Morpheus uses to connect to the instances provisioned from the virtual image",
+				Optional:    true,
+			},
+			"ssh_password": {
+				Type:        schema.TypeString,
+				Description: "The password Morpheus uses to connect to the instances provisioned from the virtual image",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ssh_key": {
+				Type:        schema.TypeString,
+				Description: "The private key Morpheus uses to connect to the instances provisioned from the virtual image",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the virtual image (private or public)",
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+			},
+			"tenant_ids": {
+				Type:        schema.TypeList,
+				Description: "A list of tenant IDs the virtual image is shared with",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the virtual image",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceVirtualImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	virtualImage := virtualImagePayload(d, meta)
+	virtualImage["imageType"] = d.Get("image_type").(string)
+	if storageProviderId := d.Get("storage_provider_id").(int); storageProviderId != 0 {
+		virtualImage["storageProvider"] = map[string]interface{}{
+			"id": storageProviderId,
+		}
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"virtualImage": virtualImage,
+		},
+	}
+
+	resp, err := client.CreateVirtualImage(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateVirtualImageResult)
+	if !result.Success {
+		return diag.Errorf("error creating virtual image: %s", result.Message)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.VirtualImage.ID))
+
+	fileName := d.Get("file_name").(string)
+	if imageUrl, ok := d.GetOk("url"); ok {
+		if fileName == "" {
+			parsedUrl, _ := url.Parse(imageUrl.(string))
+			fileName = path.Base(parsedUrl.Path)
+		}
+		resp, err := client.Execute(&morpheus.Request{
+			Method: "POST",
+			Path:   fmt.Sprintf("%s/%d/upload", morpheus.VirtualImagesPath, result.VirtualImage.ID),
+			QueryParams: map[string]string{
+				"url":      imageUrl.(string),
+				"filename": fileName,
+			},
+			Result: &morpheus.UploadVirtualImageResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.Errorf("error uploading virtual image file: %s", err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	} else if filePath, ok := d.GetOk("file_path"); ok {
+		if fileName == "" {
+			fileName = filepath.Base(filePath.(string))
+		}
+		if err := uploadVirtualImageFile(ctx, meta, result.VirtualImage.ID, filePath.(string), fileName); err != nil {
+			return diag.Errorf("error uploading virtual image file: %s", err)
+		}
+	}
+	d.Set("file_name", fileName)
+
+	if fileName != "" {
+		if err := waitForVirtualImage(ctx, client, result.VirtualImage.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error uploading virtual image file: %s", err)
+		}
+	}
+
+	return resourceVirtualImageRead(ctx, d, meta)
+}
+
+func resourceVirtualImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetVirtualImage(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetVirtualImageResult)
+	virtualImage := result.VirtualImage
+	if virtualImage == nil {
+		return diag.Errorf("read operation: virtual image not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(virtualImage.ID))
+	d.Set("name", virtualImage.Name)
+	d.Set("labels", virtualImage.Labels)
+	d.Set("image_type", virtualImage.ImageType)
+	d.Set("storage_provider_id", virtualImage.StorageProvider.ID)
+	d.Set("os_type_id", virtualImage.OsType.ID)
+	d.Set("is_cloud_init", virtualImage.IsCloudInit)
+	d.Set("user_data", virtualImage.UserData)
+	d.Set("install_agent", virtualImage.InstallAgent)
+	d.Set("ssh_username", virtualImage.SshUsername)
+	// the ssh password and key are not returned by the api, keep the configured values
+	d.Set("visibility", virtualImage.Visibility)
+	var tenantIds []int64
+	// iterate over the array of accounts
+	for _, account := range virtualImage.Accounts {
+		tenantIds = append(tenantIds, account.ID)
+	}
+	d.Set("tenant_ids", tenantIds)
+	d.Set("status", virtualImage.Status)
+
+	return diags
+}
+
+func resourceVirtualImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"virtualImage": virtualImagePayload(d, meta),
+		},
+	}
+
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateVirtualImage(toInt64(id), req)
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.UpdateVirtualImageResult)
+	if !result.Success {
+		return diag.Errorf("error updating virtual image: %s", result.Message)
+	}
+	return resourceVirtualImageRead(ctx, d, meta)
+}
+
+func resourceVirtualImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	if USE_FORCE {
+		req.QueryParams = map[string]string{
+			"force": "true",
+		}
+	}
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteVirtualImage(toInt64(id), req)
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// virtualImagePayload builds the updatable settings of a virtual image
+func virtualImagePayload(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	labelsPayload := make([]string, 0)
+	if attr, ok := d.GetOk("labels"); ok {
+		for _, s := range attr.(*schema.Set).List() {
+			labelsPayload = append(labelsPayload, s.(string))
+		}
+	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
+
+	virtualImage := map[string]interface{}{
+		"name":         d.Get("name").(string),
+		"labels":       labelsPayload,
+		"isCloudInit":  d.Get("is_cloud_init").(bool),
+		"userData":     d.Get("user_data").(string),
+		"installAgent": d.Get("install_agent").(bool),
+		"sshUsername":  d.Get("ssh_username").(string),
+		"visibility":   d.Get("visibility").(string),
+		"accounts":     d.Get("tenant_ids"),
+	}
+	if osTypeId := d.Get("os_type_id").(int); osTypeId != 0 {
+		virtualImage["osType"] = map[string]interface{}{
+			"id": osTypeId,
+		}
+	}
+	if d.HasChange("ssh_password") {
+		virtualImage["sshPassword"] = d.Get("ssh_password").(string)
+	}
+	if d.HasChange("ssh_key") {
+		virtualImage["sshKey"] = d.Get("ssh_key").(string)
+	}
+	return virtualImage
+}
+
+// uploadVirtualImageFile streams a local file to a virtual image. The request
+// is sent with a chunked transfer encoding so the file is never loaded in memory
+func uploadVirtualImageFile(ctx context.Context, meta interface{}, id int64, filePath string, fileName string) error {
+	client := meta.(*providerMeta).client
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	uploadUrl := fmt.Sprintf("%s%s/%d/upload?filename=%s", strings.TrimRight(client.Url, "/"), morpheus.VirtualImagesPath, id, url.QueryEscape(fileName))
+	req, err := http.NewRequestWithContext(ctx, "POST", uploadUrl, file)
+	if err != nil {
+		return err
+	}
+	// an unknown length makes the request use the chunked transfer encoding
+	req.ContentLength = -1
+	req.Header.Set("Authorization", "Bearer "+client.AccessToken)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Accept", "application/json")
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: meta.(*providerMeta).insecure}
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s", err)
+		return err
+	}
+	defer resp.Body.Close()
+	log.Printf("API RESPONSE: %s", resp.Status)
+
+	var result morpheus.UploadVirtualImageResult
+	decodeErr := json.NewDecoder(resp.Body).Decode(&result)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if decodeErr == nil && result.Message != "" {
+			return fmt.Errorf("%s", result.Message)
+		}
+		return fmt.Errorf("API returned HTTP %d", resp.StatusCode)
+	}
+	if decodeErr == nil && !result.Success && result.Message != "" {
+		return fmt.Errorf("%s", result.Message)
+	}
+	return nil
+}
+
+// waitForVirtualImage waits for the file of a virtual image to be processed
+func waitForVirtualImage(ctx context.Context, client *apiClient, id int64, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"Active"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetVirtualImage(id, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			virtualImage := resp.Result.(*morpheus.GetVirtualImageResult).VirtualImage
+			switch virtualImage.Status {
+			case "Active":
+				return virtualImage, virtualImage.Status, nil
+			case "Failed":
+				return virtualImage, virtualImage.Status, fmt.Errorf("virtual image is %s", virtualImage.Status)
+			}
+			// saving, queued, converting, ...
+			return virtualImage, "pending", nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   10 * time.Second,
+		Delay:        10 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+
+	// Wait, catching any errors
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
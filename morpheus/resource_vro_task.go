@@ -61,12 +61,11 @@ func resourceVrealizeOrchestratorTask() *schema.Resource {
 				Description: "The value of the vRO workflow",
 				Required:    true,
 			},
-			"body": {
-				Type:             schema.TypeString,
-				Description:      "The JSON body to send to vRO",
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentJsonDiffs,
-			},
+			"body": jsonStringSchema(&schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The JSON body to send to vRO",
+				Optional:    true,
+			}),
 			"execute_target": {
 				Type:        schema.TypeString,
 				Description: "The target that the vRO workflow will be executed on",
@@ -90,22 +89,29 @@ func resourceVrealizeOrchestratorTask() *schema.Resource {
 				Optional:    true,
 				Default:     10,
 			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
 			"allow_custom_config": {
 				Type:        schema.TypeBool,
 				Description: "Custom configuration data to pass during the execution of the vRO workflow task",
 				Optional:    true,
 				Default:     false,
 			},
+			"tenant_id": tenantIdSchema("The id of the tenant the vro task is created in, the tenant_id argument of the provider or the tenant of the user when not set"),
 		},
+		CustomizeDiff: tenantIdCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceVrealizeOrchestratorTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -125,6 +131,7 @@ func resourceVrealizeOrchestratorTaskCreate(ctx context.Context, d *schema.Resou
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -140,10 +147,11 @@ func resourceVrealizeOrchestratorTaskCreate(ctx context.Context, d *schema.Resou
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
-	resp, err := client.CreateTask(req)
+	resp, err := taskApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -160,8 +168,6 @@ func resourceVrealizeOrchestratorTaskCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceVrealizeOrchestratorTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -172,9 +178,9 @@ func resourceVrealizeOrchestratorTaskRead(ctx context.Context, d *schema.Resourc
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindTaskByName(name)
+		resp, err = taskApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+		resp, err = taskApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Task cannot be read without name or id")
 	}
@@ -192,6 +198,8 @@ func resourceVrealizeOrchestratorTaskRead(ctx context.Context, d *schema.Resourc
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetTaskResult)
@@ -213,11 +221,11 @@ func resourceVrealizeOrchestratorTaskRead(ctx context.Context, d *schema.Resourc
 	d.Set("retry_count", workflowTask.RetryCount)
 	d.Set("retry_delay_seconds", workflowTask.RetryDelaySeconds)
 	d.Set("allow_custom_config", workflowTask.AllowCustomConfig)
+	d.Set("visibility", workflowTask.Visibility)
 	return diags
 }
 
 func resourceVrealizeOrchestratorTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -241,6 +249,7 @@ func resourceVrealizeOrchestratorTaskUpdate(ctx context.Context, d *schema.Resou
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -256,11 +265,14 @@ func resourceVrealizeOrchestratorTaskUpdate(ctx context.Context, d *schema.Resou
 				"retryCount":        d.Get("retry_count"),
 				"retryDelaySeconds": d.Get("retry_delay_seconds"),
 				"allowCustomConfig": d.Get("allow_custom_config"),
+				"visibility":        d.Get("visibility"),
 			},
 		},
 	}
 	log.Printf("API REQUEST: %s", req)
-	resp, err := client.UpdateTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -275,14 +287,14 @@ func resourceVrealizeOrchestratorTaskUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceVrealizeOrchestratorTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteTask(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return taskApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
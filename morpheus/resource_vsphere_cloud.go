@@ -11,7 +11,6 @@ import (
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -23,6 +22,9 @@ func resourceVsphereCloud() *schema.Resource {
 		ReadContext:   resourceVsphereCloudRead,
 		UpdateContext: resourceVsphereCloudUpdate,
 		DeleteContext: resourceVsphereCloudDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -128,6 +130,20 @@ func resourceVsphereCloud() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"inventory_level": {
+				Type:         schema.TypeString,
+				Description:  "The level of detail synchronized from vCenter on each cloud sync (off, basic, full)",
+				ValidateFunc: validation.StringInSlice([]string{"off", "basic", "full"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"sync_interval": {
+				Type:         schema.TypeInt,
+				Description:  "The interval in seconds between the inventory syncs of the cloud, the default cloud sync interval of the appliance applying when not set",
+				ValidateFunc: validation.IntAtLeast(60),
+				Optional:     true,
+				Computed:     true,
+			},
 			"enable_hypervisor_console": {
 				Type:        schema.TypeBool,
 				Description: "Whether to enable VNC access",
@@ -220,15 +236,16 @@ func resourceVsphereCloud() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"wait_for_sync": cloudWaitForSyncSchema(),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importCloudState,
 		},
 	}
 }
 
 func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -301,6 +318,14 @@ func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, met
 	} else {
 		config["importExisting"] = ""
 	}
+	// Inventory Level
+	if inventoryLevel, ok := d.GetOk("inventory_level"); ok {
+		cloud["inventoryLevel"] = inventoryLevel.(string)
+	}
+	// Sync Interval
+	if syncInterval, ok := d.GetOk("sync_interval"); ok {
+		config["syncInterval"] = strconv.Itoa(syncInterval.(int))
+	}
 	// Enable Hypervisor Console
 	if d.Get("enable_hypervisor_console").(bool) {
 		config["enableVnc"] = "on"
@@ -372,25 +397,7 @@ func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, met
 	result := resp.Result.(*morpheus.CreateCloudResult)
 	cloudOutput := result.Cloud
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"initializing", "syncing"},
-		Target:  []string{"ok"},
-		Refresh: func() (interface{}, string, error) {
-			cloudDetails, err := client.GetCloud(cloudOutput.ID, &morpheus.Request{})
-			if err != nil {
-				return "", "", err
-			}
-			result := cloudDetails.Result.(*morpheus.GetCloudResult)
-			cloudStatus := result.Cloud
-			return result, cloudStatus.Status, nil
-		},
-		Timeout:      1 * time.Hour,
-		MinTimeout:   1 * time.Minute,
-		PollInterval: 1 * time.Minute,
-	}
-
-	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	err = waitForCloud(ctx, client, cloudOutput.ID, d.Timeout(schema.TimeoutCreate), d.Get("wait_for_sync").(bool))
 	if err != nil {
 		return diag.Errorf("error creating cloud: %s", err)
 	}
@@ -402,7 +409,7 @@ func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceVsphereCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -429,6 +436,7 @@ func resourceVsphereCloudRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetCloudResult)
@@ -475,6 +483,15 @@ func resourceVsphereCloudRead(ctx context.Context, d *schema.ResourceData, meta
 		} else {
 			d.Set("import_existing_vms", false)
 		}
+		d.Set("inventory_level", cloud.InventoryLevel)
+		// the sync interval is not part of the sdk cloud config
+		if data, ok := resp.JsonData.(map[string]interface{}); ok {
+			if zone, ok := data["zone"].(map[string]interface{}); ok {
+				if config, ok := zone["config"].(map[string]interface{}); ok {
+					d.Set("sync_interval", jsonInt64Value(config["syncInterval"]))
+				}
+			}
+		}
 
 		if cloud.Config.EnableVNC == "on" {
 			d.Set("enable_hypervisor_console", true)
@@ -515,7 +532,7 @@ func resourceVsphereCloudRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceVsphereCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	cloud := make(map[string]interface{})
 	// Name
@@ -589,6 +606,14 @@ func resourceVsphereCloudUpdate(ctx context.Context, d *schema.ResourceData, met
 	} else {
 		config["importExisting"] = ""
 	}
+	// Inventory Level
+	if inventoryLevel, ok := d.GetOk("inventory_level"); ok {
+		cloud["inventoryLevel"] = inventoryLevel.(string)
+	}
+	// Sync Interval
+	if syncInterval, ok := d.GetOk("sync_interval"); ok {
+		config["syncInterval"] = strconv.Itoa(syncInterval.(int))
+	}
 	// Enable Hypervisor Console
 	if d.Get("enable_hypervisor_console").(bool) {
 		config["enableVnc"] = "on"
@@ -653,7 +678,9 @@ func resourceVsphereCloudUpdate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	req := &morpheus.Request{Body: payload}
-	resp, err := client.UpdateCloud(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateCloud(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -667,14 +694,16 @@ func resourceVsphereCloudUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceVsphereCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteCloud(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCloud(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -3,6 +3,8 @@ package morpheus
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"log"
 
@@ -87,13 +89,13 @@ func resourceVSphereCloudDatastoreConfiguration() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceVSphereCloudDatastoreConfigurationImport,
 		},
 	}
 }
 
 func resourceVSphereCloudDatastoreConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -169,12 +171,14 @@ func resourceVSphereCloudDatastoreConfigurationCreate(ctx context.Context, d *sc
 		},
 	}
 
-	resp, err = client.Execute(&morpheus.Request{
-		Method:      "PUT",
-		Path:        fmt.Sprintf("/api/zones/%d/data-stores/%d", int64(cloudId), datastoreId),
-		QueryParams: map[string]string{},
-		Body:        req.Body,
-		Result:      &morpheus.UpdateCloudDatastoreResult{},
+	resp, err = retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method:      "PUT",
+			Path:        fmt.Sprintf("/api/zones/%d/data-stores/%d", int64(cloudId), datastoreId),
+			QueryParams: map[string]string{},
+			Body:        req.Body,
+			Result:      &morpheus.UpdateCloudDatastoreResult{},
+		})
 	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
@@ -192,7 +196,7 @@ func resourceVSphereCloudDatastoreConfigurationCreate(ctx context.Context, d *sc
 }
 
 func resourceVSphereCloudDatastoreConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -219,6 +223,15 @@ func resourceVSphereCloudDatastoreConfigurationRead(ctx context.Context, d *sche
 	}
 	datastore := (*datastoreResult.Datastores)[0]
 
+	// the datastore is read from a list, its audit details are those of the first item
+	if data, ok := resp.JsonData.(map[string]interface{}); ok {
+		if datastores, ok := data["datastores"].([]interface{}); ok && len(datastores) > 0 {
+			if object, ok := datastores[0].(map[string]interface{}); ok {
+				setObjectAuditAttributes(d, object)
+			}
+		}
+	}
+
 	d.SetId(int64ToString(datastore.ID))
 	d.Set("name", datastore.Name)
 	d.Set("active", datastore.Active)
@@ -235,7 +248,7 @@ func resourceVSphereCloudDatastoreConfigurationRead(ctx context.Context, d *sche
 }
 
 func resourceVSphereCloudDatastoreConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	id := d.Id()
 	cloudId := d.Get("cloud_id").(int)
@@ -293,12 +306,14 @@ func resourceVSphereCloudDatastoreConfigurationUpdate(ctx context.Context, d *sc
 		},
 	}
 
-	resp, err := client.Execute(&morpheus.Request{
-		Method:      "PUT",
-		Path:        fmt.Sprintf("/api/zones/%d/data-stores/%d", int64(cloudId), toInt64(id)),
-		QueryParams: map[string]string{},
-		Body:        req.Body,
-		Result:      &morpheus.UpdateCloudDatastoreResult{},
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.Execute(&morpheus.Request{
+			Method:      "PUT",
+			Path:        fmt.Sprintf("/api/zones/%d/data-stores/%d", int64(cloudId), toInt64(id)),
+			QueryParams: map[string]string{},
+			Body:        req.Body,
+			Result:      &morpheus.UpdateCloudDatastoreResult{},
+		})
 	})
 
 	if err != nil {
@@ -349,3 +364,20 @@ type TenantPermission struct {
 	Defaulttarget []int `json:"defaultTarget"`
 	Defaultstore  []int `json:"defaultStore"`
 }
+
+// resourceVSphereCloudDatastoreConfigurationImport imports a datastore using
+// the <cloud_id>:<datastore_name> format since datastores are looked up by
+// name within their cloud
+func resourceVSphereCloudDatastoreConfigurationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importData := strings.SplitN(d.Id(), ":", 2)
+	if len(importData) != 2 || importData[0] == "" || importData[1] == "" {
+		return nil, fmt.Errorf("unexpected format of import id (%s), expected <cloud_id>:<datastore_name>", d.Id())
+	}
+	cloudId, err := strconv.Atoi(importData[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cloud id %s: %s", importData[0], err)
+	}
+	d.Set("cloud_id", cloudId)
+	d.Set("name", importData[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -100,6 +100,7 @@ func resourceVsphereInstance() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"locked": instanceLockedSchema(),
 			"labels": {
 				Type:        schema.TypeList,
 				Description: "The list of labels to add to the instance",
@@ -136,6 +137,23 @@ func resourceVsphereInstance() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"workflow_id"},
 			},
+			"provision_workflow_id": {
+				Description: "The ID of an operational workflow to execute against the instance once it has been provisioned, the apply waits for the workflow to succeed",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"teardown_workflow_id": {
+				Description:   "The ID of an operational workflow to execute against the instance before it is deleted, the destroy waits for the workflow to succeed (`teardown_workflow_name` can be used alternatively, only one is needed)",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"teardown_workflow_name"},
+			},
+			"teardown_workflow_name": {
+				Description:   "The name of an operational workflow to execute against the instance before it is deleted, the destroy waits for the workflow to succeed (`teardown_workflow_id` can be used alternatively, only one is needed)",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"teardown_workflow_id"},
+			},
 			"create_user": {
 				Description: "Whether to create a user account on the instance that is associated with the provisioning user account",
 				Type:        schema.TypeBool,
@@ -274,16 +292,18 @@ func resourceVsphereInstance() *schema.Resource {
 							Computed:    true,
 						},
 						"ip_address": {
-							Description: "",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
+							Description:  "The static ip address of the network interface, required by the static ip mode and checked against the cidr of the network",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.Any(validation.IsIPAddress, validation.StringIsEmpty),
 						},
 						"ip_mode": {
-							Description: "",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
+							Description:  "How the ip address of the network interface is assigned (dhcp, pool for the next address of the ip pool of the network, static for the ip_address)",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"dhcp", "pool", "static"}, false),
 						},
 						"network_interface_type_id": {
 							Description: "The network interface type",
@@ -294,33 +314,12 @@ func resourceVsphereInstance() *schema.Resource {
 					},
 				},
 			},
-			"connection_info": {
-				Description: "Connection information for the instance, a list - this is returned by the API",
-				Type:        schema.TypeList,
-				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"ip": {
-							Description: "The IP address to connect to",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"port": {
-							Description: "The port to connect to",
-							Type:        schema.TypeInt,
-							Computed:    true,
-						},
-						"name": {
-							Description: "The name of the connection protocol",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-					},
-				},
-			},
+			"connection_info": instanceConnectionInfoSchema(),
+			"containers":      instanceContainersSchema(),
 		},
 		CustomizeDiff: customdiff.All(
 			volumesCustomizeDiff,
+			interfacesCustomizeDiff("interfaces"),
 			customdiff.ForceNewIfChange("instance_type_code", func(ctx context.Context, old, new, meta interface{}) bool {
 				// We will force a new instance if instance_type_code has a non-zero value, which means that it has been
 				// set by the user
@@ -358,7 +357,7 @@ func volumesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta inte
 }
 
 func resourceVsphereInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -503,9 +502,7 @@ func resourceVsphereInstanceCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Labels
-	if d.Get("labels") != nil {
-		payload["labels"] = d.Get("labels")
-	}
+	payload["labels"] = managedLabelsPayload(d, meta)
 
 	// Provisioning Workflow ID
 	if d.Get("workflow_id") != nil {
@@ -541,6 +538,7 @@ func resourceVsphereInstanceCreate(ctx context.Context, d *schema.ResourceData,
 	log.Printf("API RESPONSE: %s", resp)
 	result := resp.Result.(*morpheus.CreateInstanceResult)
 	instance := result.Instance
+	instanceStatus := "provisioning"
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"provisioning", "starting", "stopping", "pending"},
@@ -552,6 +550,7 @@ func resourceVsphereInstanceCreate(ctx context.Context, d *schema.ResourceData,
 			}
 			result := instanceDetails.Result.(*morpheus.GetInstanceResult)
 			instance := result.Instance
+			instanceStatus = instance.Status
 			return result, instance.Status, nil
 		},
 		Timeout:      3 * time.Hour,
@@ -568,12 +567,30 @@ func resourceVsphereInstanceCreate(ctx context.Context, d *schema.ResourceData,
 
 	// Successfully created resource, now set id
 	d.SetId(int64ToString(instance.ID))
+
+	// Run the provision workflow and wait for it to succeed,
+	// unless the instance did not provision
+	if workflowId, ok := d.GetOk("provision_workflow_id"); ok {
+		if instanceStatus == "failed" || instanceStatus == "denied" || instanceStatus == "cancelled" {
+			resourceVsphereInstanceRead(ctx, d, meta)
+			return diag.Errorf("error executing provision workflow: instance is %s", instanceStatus)
+		}
+		path := fmt.Sprintf("%s/%d/workflow", morpheus.InstancesPath, instance.ID)
+		if _, err := executeWorkflow(ctx, client, path, workflowId.(int), nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error executing provision workflow: %s", err)
+		}
+	}
+
+	if err := setInstanceLock(ctx, client, d, true); err != nil {
+		return diag.FromErr(err)
+	}
+
 	resourceVsphereInstanceRead(ctx, d, meta)
 	return diags
 }
 
 func resourceVsphereInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -603,6 +620,7 @@ func resourceVsphereInstanceRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetInstanceResult)
@@ -633,6 +651,7 @@ func resourceVsphereInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("resource_pool_id", instance.Config["resourcePoolId"])
 	d.Set("environment", instance.Environment)
 	d.Set("labels", instance.Labels)
+	d.Set("locked", instance.Locked)
 	d.Set("evar", instance.EnvironmentVariables)
 	// Tags
 	tags := make(map[string]interface{})
@@ -677,24 +696,18 @@ func resourceVsphereInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	}
 	d.Set("interfaces", networkInterfaces)
 
-	var connectionInfo []map[string]interface{}
-	// Iterate over the array of connection info
-	for i := 0; i < len(instance.ConnectionInfo); i++ {
-		row := make(map[string]interface{})
-		connection := instance.ConnectionInfo[i]
-		row["ip"] = connection.Ip
-		row["port"] = connection.Port
-		row["name"] = connection.Name
-		connectionInfo = append(connectionInfo, row)
-	}
-	d.Set("connection_info", connectionInfo)
+	setInstanceConnectionAttributes(client, d, instance)
 
 	return diags
 }
 
 func resourceVsphereInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
+
+	if err := setInstanceLock(ctx, client, d, false); err != nil {
+		return diag.FromErr(err)
+	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 
@@ -724,7 +737,7 @@ func resourceVsphereInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 	instancePayload := map[string]interface{}{
 		"name":            name,
 		"description":     description,
-		"labels":          d.Get("labels"),
+		"labels":          managedLabelsPayload(d, meta),
 		"tags":            tags,
 		"instanceContext": d.Get("environment"),
 		"config":          config,
@@ -733,7 +746,9 @@ func resourceVsphereInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		"instance": instancePayload,
 	}
 	req := &morpheus.Request{Body: payload}
-	resp, err := client.UpdateInstance(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateInstance(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -743,23 +758,37 @@ func resourceVsphereInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 	instance := result.Instance
 	// Successfully updated resource, now set id
 	d.SetId(int64ToString(instance.ID))
+	if err := setInstanceLock(ctx, client, d, true); err != nil {
+		return diag.FromErr(err)
+	}
 	return resourceVsphereInstanceRead(ctx, d, meta)
 }
 
 func resourceVsphereInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	if diags := checkInstanceUnlocked(d); diags.HasError() {
+		return diags
+	}
+
 	id := d.Id()
+
+	// Run the teardown workflow and wait for it to succeed before deleting the instance
+	if err := executeTeardownWorkflow(ctx, client, d, toInt64(id)); err != nil {
+		return diag.Errorf("error executing teardown workflow: %s", err)
+	}
 	req := &morpheus.Request{
 		QueryParams: map[string]string{},
 	}
 	if USE_FORCE {
 		req.QueryParams["force"] = "true"
 	}
-	resp, err := client.DeleteInstance(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteInstance(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -390,7 +390,7 @@ func resourceVsphereMKSCluster() *schema.Resource {
 	}
 }
 
-func getClusterWorkers(client *morpheus.Client, clusterId int64) ([]morpheus.ClusterWorker, error) {
+func getClusterWorkers(client *apiClient, clusterId int64) ([]morpheus.ClusterWorker, error) {
 	resp, err := client.ListClusterWorkers(clusterId, &morpheus.Request{})
 	if err != nil {
 		log.Printf("API FAILURE - Error in listing cluster worker nodes: %s - %s", resp, err)
@@ -435,7 +435,7 @@ func filterOutClusterWorkersByStatus(workers []morpheus.ClusterWorker, status st
 }
 
 func resourceVsphereMKSClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -604,7 +604,7 @@ func resourceVsphereMKSClusterCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceVsphereMKSClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -633,6 +633,7 @@ func resourceVsphereMKSClusterRead(ctx context.Context, d *schema.ResourceData,
 			return diag.FromErr(err)
 		}
 	}
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetClusterResult)
@@ -701,7 +702,8 @@ func resourceVsphereMKSClusterRead(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func doClusterWorkerAdd(ctx context.Context, client *morpheus.Client, clusterId int64, nodeCount int, d *schema.ResourceData) error {
+func doClusterWorkerAdd(ctx context.Context, meta interface{}, clusterId int64, nodeCount int, d *schema.ResourceData) error {
+	client := meta.(*providerMeta).client
 	workerpool := d.Get("worker_node_pool").([]interface{})[0].(map[string]interface{})
 
 	workers, err := getClusterWorkers(client, clusterId)
@@ -737,13 +739,8 @@ func doClusterWorkerAdd(ctx context.Context, client *morpheus.Client, clusterId
 	serverPayload["nodeCount"] = nodeCount
 	serverPayload["tags"] = parseTags(workerpool["tags"].(map[string]interface{}))
 
-	// NOTE: Not needed from Morpheus 8.05 onward
-	serverPayload["server"] = map[string]interface{}{
-		"network": map[string]interface{}{},
-	}
-
 	req := &morpheus.Request{Body: map[string]interface{}{
-		"server": serverPayload,
+		"server": clusterWorkerPayload(meta, serverPayload),
 	}}
 
 	resp, err := client.AddClusterWorker(clusterId, req)
@@ -753,6 +750,11 @@ func doClusterWorkerAdd(ctx context.Context, client *morpheus.Client, clusterId
 		return err
 	}
 
+	return waitForClusterWorkers(ctx, client, clusterId, desiredWorkerCount)
+}
+
+// waitForClusterWorkers waits for the cluster to have desiredWorkerCount provisioned worker nodes
+func waitForClusterWorkers(ctx context.Context, client *apiClient, clusterId int64, desiredWorkerCount int) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{statusProvisioning},
 		Target:  []string{statusProvisioned},
@@ -783,7 +785,7 @@ func doClusterWorkerAdd(ctx context.Context, client *morpheus.Client, clusterId
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -791,7 +793,7 @@ func doClusterWorkerAdd(ctx context.Context, client *morpheus.Client, clusterId
 	return nil
 }
 
-func doClusterWorkerDelete(ctx context.Context, client *morpheus.Client, clusterId int64, nodeCount int) error {
+func doClusterWorkerDelete(ctx context.Context, client *apiClient, clusterId int64, nodeCount int) error {
 	workers, err := getClusterWorkers(client, clusterId)
 	if err != nil {
 		return err
@@ -800,7 +802,9 @@ func doClusterWorkerDelete(ctx context.Context, client *morpheus.Client, cluster
 
 	deleteWorkers := workers[len(workers)+nodeCount:]
 	for _, worker := range deleteWorkers {
-		resp, err := client.DeleteClusterWorker(clusterId, worker.ID, &morpheus.Request{})
+		resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+			return client.DeleteClusterWorker(clusterId, worker.ID, &morpheus.Request{})
+		})
 		if err != nil {
 			log.Printf("API FAILURE - Error in deleting cluster worker node: %s - %s", resp, err)
 
@@ -842,7 +846,7 @@ func doClusterWorkerDelete(ctx context.Context, client *morpheus.Client, cluster
 }
 
 func resourceVsphereMKSClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	clusterId := toInt64(d.Id())
 
 	// First check for changes in worker node pool
@@ -872,7 +876,7 @@ func resourceVsphereMKSClusterUpdate(ctx context.Context, d *schema.ResourceData
 			countDelta := newCount - oldCount
 
 			if countDelta > 0 {
-				err := doClusterWorkerAdd(ctx, client, clusterId, countDelta, d)
+				err := doClusterWorkerAdd(ctx, meta, clusterId, countDelta, d)
 				if err != nil {
 					return diag.Errorf("error adding cluster worker node(s): %s", err)
 				}
@@ -900,7 +904,9 @@ func resourceVsphereMKSClusterUpdate(ctx context.Context, d *schema.ResourceData
 			"cluster": clusterPayload,
 		}}
 
-		resp, err := client.UpdateCluster(clusterId, req)
+		resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+			return client.UpdateCluster(clusterId, req)
+		})
 		if err != nil {
 			log.Printf("API FAILURE: %s - %s", resp, err)
 			return diag.FromErr(err)
@@ -912,7 +918,7 @@ func resourceVsphereMKSClusterUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceVsphereMKSClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -927,7 +933,9 @@ func resourceVsphereMKSClusterDelete(ctx context.Context, d *schema.ResourceData
 	if USE_FORCE {
 		req.QueryParams["force"] = "true"
 	}
-	resp, err := client.DeleteCluster(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteCluster(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -9,6 +9,7 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceWikiPage() *schema.Resource {
@@ -31,10 +32,21 @@ func resourceWikiPage() *schema.Resource {
 				Required:    true,
 			},
 			"category": {
-				Type:        schema.TypeString,
-				Description: "The category of the wiki page",
-				Optional:    true,
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "The category of the wiki page",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"category_path"},
+			},
+			"category_path": {
+				Type:          schema.TypeList,
+				Description:   "The hierarchy of categories of the wiki page, from the top category down, joined with / into the category of the page. The wiki pages of a category and its subcategories are listed by the morpheus_wiki_pages data source",
+				Optional:      true,
+				ConflictsWith: []string{"category"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringDoesNotContainAny(wikiCategorySeparator),
+				},
 			},
 			"content": {
 				Type:        schema.TypeString,
@@ -46,14 +58,48 @@ func resourceWikiPage() *schema.Resource {
 				},
 			},
 		},
+		CustomizeDiff: resourceWikiPageCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
+// wikiCategorySeparator separates the categories of the hierarchy of a wiki
+// page in its category
+const wikiCategorySeparator = "/"
+
+// wikiPageCategory returns the category of a wiki page, the categories of
+// its category_path joined when set
+func wikiPageCategory(d *schema.ResourceData) string {
+	if categoryPath := d.Get("category_path").([]interface{}); len(categoryPath) > 0 {
+		var categories []string
+		for _, category := range categoryPath {
+			categories = append(categories, category.(string))
+		}
+		return strings.Join(categories, wikiCategorySeparator)
+	}
+	return d.Get("category").(string)
+}
+
+// resourceWikiPageCustomizeDiff plans the category of a wiki page set by its
+// category_path
+func resourceWikiPageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("category_path") || !d.NewValueKnown("category_path") {
+		return nil
+	}
+	var categories []string
+	for _, category := range d.Get("category_path").([]interface{}) {
+		categories = append(categories, category.(string))
+	}
+	if len(categories) == 0 {
+		return nil
+	}
+	return d.SetNew("category", strings.Join(categories, wikiCategorySeparator))
+}
+
 func resourceWikiPageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -61,7 +107,7 @@ func resourceWikiPageCreate(ctx context.Context, d *schema.ResourceData, meta in
 	wikiPage := make(map[string]interface{})
 
 	wikiPage["name"] = d.Get("name").(string)
-	wikiPage["category"] = d.Get("category").(string)
+	wikiPage["category"] = wikiPageCategory(d)
 	wikiPage["content"] = d.Get("content").(string)
 
 	req := &morpheus.Request{
@@ -86,7 +132,7 @@ func resourceWikiPageCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceWikiPageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -116,6 +162,7 @@ func resourceWikiPageRead(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 	// store resource data
 	result := resp.Result.(*morpheus.GetWikiResult)
 	wikiPage := result.Wiki
@@ -123,19 +170,22 @@ func resourceWikiPageRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.SetId(intToString(int(wikiPage.ID)))
 	d.Set("name", wikiPage.Name)
 	d.Set("category", wikiPage.Category)
+	if len(d.Get("category_path").([]interface{})) > 0 {
+		d.Set("category_path", strings.Split(wikiPage.Category, wikiCategorySeparator))
+	}
 	d.Set("content", wikiPage.Content)
 
 	return diags
 }
 
 func resourceWikiPageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	wikiPage := make(map[string]interface{})
 
 	wikiPage["name"] = d.Get("name").(string)
-	wikiPage["category"] = d.Get("category").(string)
+	wikiPage["category"] = wikiPageCategory(d)
 	wikiPage["content"] = d.Get("content").(string)
 
 	req := &morpheus.Request{
@@ -143,7 +193,9 @@ func resourceWikiPageUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			"page": wikiPage,
 		},
 	}
-	resp, err := client.UpdateWiki(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateWiki(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -159,14 +211,16 @@ func resourceWikiPageUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceWikiPageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteWiki(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteWiki(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
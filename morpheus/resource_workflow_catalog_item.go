@@ -2,13 +2,13 @@ package morpheus
 
 import (
 	"context"
-	"os"
 	"strings"
 
 	"log"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -102,11 +102,13 @@ func resourceWorkflowCatalogItem() *schema.Resource {
 				Computed:    true,
 			},
 			"logo_image_path": {
-				Type:        schema.TypeString,
-				Description: "The file path of the workflow catalog item logo image including the file name",
-				Optional:    true,
-				Computed:    true,
+				Type:             schema.TypeString,
+				Description:      "The file path of the workflow catalog item logo image including the file name",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressImportedLogoPath("logo_image_name"),
 			},
+			"logo_image_content": catalogItemLogoContentSchema("The content of the workflow catalog item logo image, base64 encoded as returned by filebase64() or raw, instead of the file path", "logo_image_name", "logo_image_path", "logo_image_content"),
 			"dark_logo_image_name": {
 				Type:        schema.TypeString,
 				Description: "The file name of the workflow catalog item dark mode logo image",
@@ -114,11 +116,15 @@ func resourceWorkflowCatalogItem() *schema.Resource {
 				Computed:    true,
 			},
 			"dark_logo_image_path": {
-				Type:        schema.TypeString,
-				Description: "The file path of the workflow catalog item dark mode logo image including the file name",
-				Optional:    true,
-				Computed:    true,
+				Type:             schema.TypeString,
+				Description:      "The file path of the workflow catalog item dark mode logo image including the file name",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressImportedLogoPath("dark_logo_image_name"),
 			},
+			"dark_logo_image_content":  catalogItemLogoContentSchema("The content of the workflow catalog item dark mode logo image, base64 encoded as returned by filebase64() or raw, instead of the file path", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content"),
+			"logo_image_checksum":      logoChecksumSchema("The checksum of the uploaded workflow catalog item logo image, a change of the content of the image uploads it again"),
+			"dark_logo_image_checksum": logoChecksumSchema("The checksum of the uploaded workflow catalog item dark mode logo image, a change of the content of the image uploads it again"),
 			"visibility": {
 				Type:         schema.TypeString,
 				Description:  "The visibility of the workflow catalog item (public or private)",
@@ -131,19 +137,31 @@ func resourceWorkflowCatalogItem() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"option_type_ids"},
 			},
+			"option_type":         catalogItemOptionTypeSchema(),
+			"form_field_override": catalogItemFormFieldOverrideSchema(),
+			"tenant_id":           tenantIdSchema("The id of the tenant the workflow catalog item is created in, the tenant_id argument of the provider or the tenant of the user when not set. The option types and forms referenced by the catalog item must be visible to the tenant"),
 		},
+		CustomizeDiff: customdiff.All(
+			catalogItemReferencesCustomizeDiff("option_type_ids", "form_id", "workflow_id"),
+			logoChecksumCustomizeDiff("logo_image_checksum", "logo_image_name", "logo_image_path", "logo_image_content"),
+			logoChecksumCustomizeDiff("dark_logo_image_checksum", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content"),
+			tenantIdCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: tenantResourceImport,
 		},
 	}
 }
 
 func resourceWorkflowCatalogItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	ownedOptionTypeIds, _, err := syncCatalogItemOptionTypes(ctx, meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	catalogItem := make(map[string]interface{})
 
 	catalogItem["name"] = d.Get("name").(string)
@@ -154,7 +172,7 @@ func resourceWorkflowCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 	catalogItem["type"] = "workflow"
 	catalogItem["iconPath"] = "custom"
 	catalogItem["context"] = d.Get("context_type").(string)
-	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	catalogItem["optionTypes"] = catalogItemOptionTypeIds(d, ownedOptionTypeIds)
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -168,6 +186,7 @@ func resourceWorkflowCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	catalogItem["labels"] = labelsPayload
 
 	if d.Get("form_id").(int) > 0 {
@@ -175,6 +194,7 @@ func resourceWorkflowCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 		catalogItem["form"] = map[string]interface{}{
 			"id": d.Get("form_id").(int),
 		}
+		catalogItem["formConfig"] = catalogItemFormConfigPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -182,9 +202,12 @@ func resourceWorkflowCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 			"catalogItemType": catalogItem,
 		},
 	}
-	resp, err := client.CreateCatalogItem(req)
+	resp, err := catalogItemApi.create(meta, d, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
+		if err := deleteCatalogItemOptionTypes(ctx, meta, d, ownedOptionTypeIds); err != nil {
+			log.Printf("unable to delete the option types of the catalog item: %s", err)
+		}
 		return diag.FromErr(err)
 	}
 	log.Printf("API RESPONSE: %s", resp)
@@ -194,34 +217,24 @@ func resourceWorkflowCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 
 	var filePayloads []*morpheus.FilePayload
 
-	if d.Get("logo_image_path") != "" && d.Get("logo_image_name") != "" {
-		data, err := os.ReadFile(d.Get("logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("logo_image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
+	logo, err := catalogItemLogoFile(d, "logo", "logo_image_name", "logo_image_path", "logo_image_content")
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if d.Get("dark_logo_image_path") != "" && d.Get("dark_logo_image_name") != "" {
-		darkLogoData, err := os.ReadFile(d.Get("dark_logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		darkLogoPayload := &morpheus.FilePayload{
-			ParameterName: "darkLogo",
-			FileName:      d.Get("dark_logo_image_name").(string),
-			FileContent:   darkLogoData,
-		}
-		filePayloads = append(filePayloads, darkLogoPayload)
+	if logo != nil {
+		filePayloads = append(filePayloads, logo)
+	}
+	darkLogo, err := catalogItemLogoFile(d, "darkLogo", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if darkLogo != nil {
+		filePayloads = append(filePayloads, darkLogo)
 	}
 
-	response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
+	response, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return catalogItemApi.updateLogo(meta, d, catalogItemResult.ID, filePayloads)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", response, err)
 	}
@@ -235,7 +248,7 @@ func resourceWorkflowCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceWorkflowCatalogItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -246,9 +259,9 @@ func resourceWorkflowCatalogItemRead(ctx context.Context, d *schema.ResourceData
 	var resp *morpheus.Response
 	var err error
 	if id == "" && name != "" {
-		resp, err = client.FindCatalogItemByName(name)
+		resp, err = catalogItemApi.findByName(meta, d, name)
 	} else if id != "" {
-		resp, err = client.GetCatalogItem(toInt64(id), &morpheus.Request{})
+		resp, err = catalogItemApi.get(meta, d, toInt64(id))
 	} else {
 		return diag.Errorf("Catalog Item cannot be read without name or id")
 	}
@@ -265,6 +278,8 @@ func resourceWorkflowCatalogItemRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
+	setResourceTenantId(meta, d)
 	// store resource data
 	result := resp.Result.(*morpheus.GetCatalogItemResult)
 	catalogItem := result.CatalogItem
@@ -277,34 +292,36 @@ func resourceWorkflowCatalogItemRead(ctx context.Context, d *schema.ResourceData
 	d.Set("enabled", catalogItem.Enabled)
 	d.Set("featured", catalogItem.Featured)
 	// option types
-	var optionTypes []int64
-	if catalogItem.OptionTypes != nil {
-		// iterate over the array of tasks
-		for i := 0; i < len(catalogItem.OptionTypes); i++ {
-			option := catalogItem.OptionTypes[i].(map[string]interface{})
-			optionID := int64(option["id"].(float64))
-			optionTypes = append(optionTypes, optionID)
-		}
+	optionTypes, err := readCatalogItemOptionTypes(meta, d, catalogItemAttachedOptionTypeIds(catalogItem))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	d.Set("option_type_ids", optionTypes)
 	d.Set("content", catalogItem.Content)
 	d.Set("context_type", catalogItem.Context)
 	d.Set("visibility", catalogItem.Visibility)
 	d.Set("form_id", catalogItem.Form.ID)
+	d.Set("form_field_override", parseCatalogItemFormConfig(catalogItem.FormConfig))
 	d.Set("workflow_id", catalogItem.Workflow.ID)
 	imagePath := strings.Split(catalogItem.ImagePath, "/")
 	opt := strings.Replace(imagePath[len(imagePath)-1], "_original", "", 1)
 	d.Set("logo_image_name", opt)
+	readLogoChecksum(client, d, "logo_image_checksum", catalogItem.ImagePath)
 	darkImagePath := strings.Split(catalogItem.DarkImagePath, "/")
 	darkOpt := strings.Replace(darkImagePath[len(darkImagePath)-1], "_original", "", 1)
 	d.Set("dark_logo_image_name", darkOpt)
+	readLogoChecksum(client, d, "dark_logo_image_checksum", catalogItem.DarkImagePath)
 	return diags
 }
 
 func resourceWorkflowCatalogItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
 	id := d.Id()
 
+	ownedOptionTypeIds, removedOptionTypeIds, err := syncCatalogItemOptionTypes(ctx, meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	catalogItem := make(map[string]interface{})
 
 	catalogItem["name"] = d.Get("name").(string)
@@ -314,6 +331,7 @@ func resourceWorkflowCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	catalogItem["labels"] = labelsPayload
 	catalogItem["description"] = d.Get("description").(string)
 	catalogItem["category"] = d.Get("category").(string)
@@ -321,7 +339,7 @@ func resourceWorkflowCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 	catalogItem["featured"] = d.Get("featured").(bool)
 	catalogItem["type"] = "workflow"
 	catalogItem["context"] = d.Get("context_type").(string)
-	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	catalogItem["optionTypes"] = catalogItemOptionTypeIds(d, ownedOptionTypeIds)
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -334,6 +352,7 @@ func resourceWorkflowCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 		catalogItem["form"] = map[string]interface{}{
 			"id": d.Get("form_id").(int),
 		}
+		catalogItem["formConfig"] = catalogItemFormConfigPayload(d)
 	}
 
 	req := &morpheus.Request{
@@ -342,45 +361,46 @@ func resourceWorkflowCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 		},
 	}
 
-	resp, err := client.UpdateCatalogItem(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return catalogItemApi.update(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
 	}
 	log.Printf("API RESPONSE: %s", resp)
+
+	// the option types of the removed blocks are no longer used by the catalog item
+	if err := deleteCatalogItemOptionTypes(ctx, meta, d, removedOptionTypeIds); err != nil {
+		return diag.FromErr(err)
+	}
 	result := resp.Result.(*morpheus.UpdateCatalogItemResult)
 	catalogItemResult := result.CatalogItem
 
 	var filePayloads []*morpheus.FilePayload
 
-	if d.HasChange("logo_image_path") || d.HasChange("logo_image_name") {
-		data, err := os.ReadFile(d.Get("logo_image_path").(string))
+	if d.HasChange("logo_image_path") || d.HasChange("logo_image_name") || d.HasChange("logo_image_content") || d.HasChange("logo_image_checksum") {
+		logo, err := catalogItemLogoFile(d, "logo", "logo_image_name", "logo_image_path", "logo_image_content")
 		if err != nil {
 			return diag.FromErr(err)
 		}
-
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("logo_image_name").(string),
-			FileContent:   data,
+		if logo != nil {
+			filePayloads = append(filePayloads, logo)
 		}
-		filePayloads = append(filePayloads, filePayload)
 	}
-	if d.HasChange("dark_logo_image_path") || d.HasChange("dark_logo_image_name") {
-		darkLogoData, err := os.ReadFile(d.Get("dark_logo_image_path").(string))
+	if d.HasChange("dark_logo_image_path") || d.HasChange("dark_logo_image_name") || d.HasChange("dark_logo_image_content") || d.HasChange("dark_logo_image_checksum") {
+		darkLogo, err := catalogItemLogoFile(d, "darkLogo", "dark_logo_image_name", "dark_logo_image_path", "dark_logo_image_content")
 		if err != nil {
 			return diag.FromErr(err)
 		}
-
-		darkLogoPayload := &morpheus.FilePayload{
-			ParameterName: "darkLogo",
-			FileName:      d.Get("dark_logo_image_name").(string),
-			FileContent:   darkLogoData,
+		if darkLogo != nil {
+			filePayloads = append(filePayloads, darkLogo)
 		}
-		filePayloads = append(filePayloads, darkLogoPayload)
 	}
 
-	response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
+	response, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return catalogItemApi.updateLogo(meta, d, catalogItemResult.ID, filePayloads)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", response, err)
 	}
@@ -393,14 +413,14 @@ func resourceWorkflowCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceWorkflowCatalogItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
-
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteCatalogItem(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, meta.(*providerMeta).client, func() (*morpheus.Response, error) {
+		return catalogItemApi.delete(meta, d, toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -411,6 +431,9 @@ func resourceWorkflowCatalogItemDelete(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	if err := deleteCatalogItemOptionTypes(ctx, meta, d, ownedCatalogItemOptionTypeIds(d)); err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId("")
 	return diags
 }
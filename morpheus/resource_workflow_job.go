@@ -81,7 +81,7 @@ func resourceWorkflowJob() *schema.Resource {
 			},
 			"server_label": {
 				Type:          schema.TypeString,
-				Description:   "The server label used for dynamic automation targeting",
+				Description:   "The server label used for dynamic automation targeting, the servers carrying the label are resolved each time the job runs",
 				Optional:      true,
 				ConflictsWith: []string{"instance_ids", "server_ids", "instance_label"},
 			},
@@ -94,7 +94,7 @@ func resourceWorkflowJob() *schema.Resource {
 			},
 			"instance_label": {
 				Type:          schema.TypeString,
-				Description:   "The instance label used for dynamic automation targeting",
+				Description:   "The instance label used for dynamic automation targeting, the instances carrying the label are resolved each time the job runs",
 				Optional:      true,
 				ConflictsWith: []string{"instance_ids", "server_ids", "server_label"},
 			},
@@ -104,7 +104,13 @@ func resourceWorkflowJob() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"custom_config": jsonStringSchema(&schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The custom configuration passed to the tasks of the workflow, a JSON document",
+				Optional:    true,
+			}),
 		},
+		CustomizeDiff: jobTargetCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -112,7 +118,7 @@ func resourceWorkflowJob() *schema.Resource {
 }
 
 func resourceWorkflowJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -126,6 +132,7 @@ func resourceWorkflowJobCreate(ctx context.Context, d *schema.ResourceData, meta
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	job["labels"] = labelsPayload
 	job["enabled"] = d.Get("enabled").(bool)
 	job["workflow"] = map[string]int{
@@ -185,6 +192,7 @@ func resourceWorkflowJobCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 		job["customOptions"] = customOptions
 	}
+	job["customConfig"] = d.Get("custom_config").(string)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -209,7 +217,7 @@ func resourceWorkflowJobCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceWorkflowJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -239,6 +247,7 @@ func resourceWorkflowJobRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetJobResult)
@@ -292,17 +301,24 @@ func resourceWorkflowJobRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 		d.Set("server_ids", serverIds)
 	case "instance-label":
-		d.Set("instance_label", workflowJob.Targets[0].Name)
+		if len(workflowJob.Targets) > 0 {
+			d.Set("instance_label", workflowJob.Targets[0].Name)
+		}
 	case "server-label":
-		d.Set("server_label", workflowJob.Targets[0].Name)
+		if len(workflowJob.Targets) > 0 {
+			d.Set("server_label", workflowJob.Targets[0].Name)
+		}
 	}
 	d.Set("custom_options", workflowJob.CustomOptions)
+	if workflowJob.CustomConfig != "" {
+		d.Set("custom_config", workflowJob.CustomConfig)
+	}
 
 	return diags
 }
 
 func resourceWorkflowJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	job := make(map[string]interface{})
@@ -314,6 +330,7 @@ func resourceWorkflowJobUpdate(ctx context.Context, d *schema.ResourceData, meta
 			labelsPayload = append(labelsPayload, s.(string))
 		}
 	}
+	labelsPayload = appendManagedLabel(labelsPayload, meta)
 	job["labels"] = labelsPayload
 	job["enabled"] = d.Get("enabled").(bool)
 	job["workflow"] = map[string]int{
@@ -372,6 +389,7 @@ func resourceWorkflowJobUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 		job["customOptions"] = customOptions
 	}
+	job["customConfig"] = d.Get("custom_config").(string)
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -379,7 +397,9 @@ func resourceWorkflowJobUpdate(ctx context.Context, d *schema.ResourceData, meta
 		},
 	}
 
-	resp, err := client.UpdateJob(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdateJob(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -395,14 +415,16 @@ func resourceWorkflowJobUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceWorkflowJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeleteJob(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeleteJob(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -2,7 +2,7 @@ package morpheus
 
 import (
 	"context"
-
+	"fmt"
 	"log"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
@@ -13,11 +13,12 @@ import (
 
 func resourceWorkflowPolicy() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Morpheus workflow policy resource",
+		Description:   "Provides a Morpheus workflow policy resource, mandating a provisioning workflow on the instances provisioned in its scope. The tasks of the workflow run at their phase of the lifecycle of the instances, such as provision or post provision at provision time and teardown at delete time.",
 		CreateContext: resourceWorkflowPolicyCreate,
 		ReadContext:   resourceWorkflowPolicyRead,
 		UpdateContext: resourceWorkflowPolicyUpdate,
 		DeleteContext: resourceWorkflowPolicyDelete,
+		CustomizeDiff: workflowPolicyCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -44,7 +45,7 @@ func resourceWorkflowPolicy() *schema.Resource {
 			},
 			"workflow_id": {
 				Type:        schema.TypeInt,
-				Description: "The id of the workflow associated with the policy",
+				Description: "The id of the provisioning workflow mandated by the policy, operational workflows cannot be mandated by a policy",
 				Required:    true,
 			},
 			"scope": {
@@ -103,7 +104,7 @@ func resourceWorkflowPolicy() *schema.Resource {
 }
 
 func resourceWorkflowPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -174,7 +175,7 @@ func resourceWorkflowPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceWorkflowPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -204,6 +205,7 @@ func resourceWorkflowPolicyRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	log.Printf("API RESPONSE: %s", resp)
+	setResourceAuditAttributes(d, resp)
 
 	// store resource data
 	result := resp.Result.(*morpheus.GetPolicyResult)
@@ -245,7 +247,7 @@ func resourceWorkflowPolicyRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceWorkflowPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -299,7 +301,9 @@ func resourceWorkflowPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 			"policy": policy,
 		},
 	}
-	resp, err := client.UpdatePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.UpdatePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -315,14 +319,16 @@ func resourceWorkflowPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceWorkflowPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	id := d.Id()
 	req := &morpheus.Request{}
-	resp, err := client.DeletePolicy(toInt64(id), req)
+	resp, err := retryWhileLocked(ctx, client, func() (*morpheus.Response, error) {
+		return client.DeletePolicy(toInt64(id), req)
+	})
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -336,3 +342,31 @@ func resourceWorkflowPolicyDelete(ctx context.Context, d *schema.ResourceData, m
 	d.SetId("")
 	return diags
 }
+
+// workflowPolicyCustomizeDiff checks at plan time that the workflow mandated
+// by the policy is a provisioning workflow. The workflow policies of the
+// appliance only run the tasks of a provisioning workflow at their phase of
+// the lifecycle of the instances, an operational workflow has no phase and
+// is never run by the policy.
+func workflowPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("workflow_id") || !d.NewValueKnown("workflow_id") {
+		return nil
+	}
+	client, ok := metaClient(meta)
+	if !ok {
+		return nil
+	}
+
+	workflowId := int64(d.Get("workflow_id").(int))
+	resp, err := client.GetTaskSet(workflowId, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	taskSet := resp.Result.(*morpheus.GetTaskSetResult).TaskSet
+	if taskSet != nil && taskSet.Type == "operation" {
+		return fmt.Errorf("workflow %d is an operational workflow, a workflow policy requires a provisioning workflow", workflowId)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package morpheus
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SourceFile is the file holding the content of the spec templates and the
+// script tasks, written inline, fetched from a url or pulled from a git
+// repository. It matches the file of the tasks of the sdk.
+type SourceFile struct {
+	ID          int64  `json:"id"`
+	SourceType  string `json:"sourceType"`
+	ContentRef  string `json:"contentRef"`
+	ContentPath string `json:"contentPath"`
+	Repository  struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"repository"`
+	Content string `json:"content"`
+}
+
+// sourceFileAttributes names the attributes a source file is built from and
+// stored in, the url and the path in the repository sharing one attribute
+// in most resources
+type sourceFileAttributes struct {
+	sourceType string
+	content    string
+	url        string
+	path       string
+}
+
+// sourceFilePayload builds the file of the payload from the source_type,
+// repository_id and version_ref attributes and the given content and path
+// attributes, only sending the settings of the source type
+func sourceFilePayload(d *schema.ResourceData, contentKey string, pathKey string) map[string]interface{} {
+	return sourceFileAttributes{"source_type", contentKey, pathKey, pathKey}.payload(d)
+}
+
+// setSourceFile stores the file of the response in the attributes the
+// payload is built from
+func setSourceFile(d *schema.ResourceData, file SourceFile, contentKey string, pathKey string) {
+	sourceFileAttributes{"source_type", contentKey, pathKey, pathKey}.set(d, file)
+}
+
+func (a sourceFileAttributes) payload(d *schema.ResourceData) map[string]interface{} {
+	sourceOptions := make(map[string]interface{})
+	sourceOptions["sourceType"] = d.Get(a.sourceType)
+
+	switch d.Get(a.sourceType) {
+	case "local":
+		sourceOptions["content"] = d.Get(a.content)
+	case "url":
+		sourceOptions["contentPath"] = d.Get(a.url)
+	case "repository":
+		sourceOptions["contentPath"] = d.Get(a.path)
+		sourceOptions["contentRef"] = d.Get("version_ref")
+		sourceOptions["repository"] = map[string]interface{}{
+			"id": d.Get("repository_id"),
+		}
+	}
+	return sourceOptions
+}
+
+// set stores the file of the response in the attributes. The appliance
+// reports the repository source type as git, it is stored as repository for
+// the configuration to round trip.
+func (a sourceFileAttributes) set(d *schema.ResourceData, file SourceFile) {
+	switch file.SourceType {
+	case "local":
+		d.Set(a.sourceType, "local")
+		d.Set(a.content, file.Content)
+	case "url":
+		d.Set(a.sourceType, "url")
+		d.Set(a.url, file.ContentPath)
+	case "git", "repository":
+		d.Set(a.sourceType, "repository")
+		d.Set(a.path, file.ContentPath)
+		d.Set("repository_id", file.Repository.ID)
+		d.Set("version_ref", file.ContentRef)
+	default:
+		d.Set(a.sourceType, file.SourceType)
+	}
+}
@@ -0,0 +1,71 @@
+package morpheus
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// specTemplateConfigSchema is the schema of the config attribute shared by the
+// spec template resources, passing through the settings without a typed attribute
+func specTemplateConfigSchema() *schema.Schema {
+	return jsonStringSchema(&schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The additional settings of the spec template config in JSON format, the sections managed by typed attributes cannot be set",
+		Optional:    true,
+		Default:     "{}",
+	})
+}
+
+// specTemplateConfigPayload builds the config of a spec template from the
+// config attribute and the sections managed by typed attributes
+func specTemplateConfigPayload(d *schema.ResourceData, typed map[string]interface{}) (map[string]interface{}, error) {
+	config := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(d.Get("config").(string)), &config); err != nil {
+		return nil, err
+	}
+	for key, value := range typed {
+		if _, ok := config[key]; ok {
+			return nil, fmt.Errorf("the %s section of the spec template config is managed by typed attributes", key)
+		}
+		config[key] = value
+	}
+	return config, nil
+}
+
+// setSpecTemplateConfig stores the config of a spec template, leaving out the
+// sections managed by typed attributes
+func setSpecTemplateConfig(d *schema.ResourceData, resp *morpheus.Response, typedKeys ...string) error {
+	var config map[string]interface{}
+	if data, ok := resp.JsonData.(map[string]interface{}); ok {
+		if specTemplateData, ok := data["specTemplate"].(map[string]interface{}); ok {
+			config, _ = specTemplateData["config"].(map[string]interface{})
+		}
+	}
+	stateConfig := make(map[string]interface{})
+	var configured map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("config").(string)), &configured); err == nil {
+		// only the configured keys are tracked, the appliance adds defaults
+		for key := range configured {
+			if value, ok := config[key]; ok {
+				stateConfig[key] = value
+			}
+		}
+	} else {
+		// the whole config is stored after an import
+		for key, value := range config {
+			stateConfig[key] = value
+		}
+		for _, key := range typedKeys {
+			delete(stateConfig, key)
+		}
+	}
+	configJson, err := json.Marshal(stateConfig)
+	if err != nil {
+		return err
+	}
+	d.Set("config", string(configJson))
+	return nil
+}
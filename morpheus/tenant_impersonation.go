@@ -0,0 +1,91 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tenantImpersonationHeader is the header of the requests sent by a master
+// tenant user on behalf of a subtenant
+const tenantImpersonationHeader = "X-Morpheus-Tenant"
+
+// tenantIdSchema is the schema of the tenant an object is created in, the
+// object is managed through the impersonation header so a single provider
+// can stamp out the same object in several tenants. The tenant_id argument of
+// the provider applies to the objects without one and is recorded in the
+// state, so changing it recreates them in the new tenant.
+func tenantIdSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+	}
+}
+
+// tenantIdCustomizeDiff plans the tenant_id argument of the provider as the
+// tenant of the objects without one
+func tenantIdCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	m, ok := meta.(*providerMeta)
+	if !ok || !d.GetRawConfig().GetAttr("tenant_id").IsNull() {
+		return nil
+	}
+	tenantId := m.defaultTenantId
+	if d.Id() != "" && d.Get("tenant_id").(int) == tenantId {
+		return nil
+	}
+	return d.SetNew("tenant_id", tenantId)
+}
+
+// setResourceTenantId records the tenant an object is managed in, including
+// the tenant_id argument of the provider it defaulted to
+func setResourceTenantId(meta interface{}, d *schema.ResourceData) {
+	d.Set("tenant_id", resourceTenantId(meta, d))
+}
+
+// tenantHeaders returns the headers of the requests managing an object in
+// the given tenant, nil for the tenant of the provider
+func tenantHeaders(tenantId int) map[string]string {
+	if tenantId == 0 {
+		return nil
+	}
+	return map[string]string{
+		tenantImpersonationHeader: strconv.Itoa(tenantId),
+	}
+}
+
+// resourceTenantId returns the tenant an object is managed in, 0 for the
+// tenant the provider is authenticated in
+func resourceTenantId(meta interface{}, d *schema.ResourceData) int {
+	if tenantId := d.Get("tenant_id").(int); tenantId != 0 {
+		return tenantId
+	}
+	return meta.(*providerMeta).defaultTenantId
+}
+
+// tenantResourceImport imports an object of the tenant of the provider by
+// its id, or an object of another tenant by <tenant_id>:<id>
+func tenantResourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importData := strings.SplitN(d.Id(), ":", 2)
+	if len(importData) == 1 {
+		return []*schema.ResourceData{d}, nil
+	}
+	if importData[0] == "" || importData[1] == "" {
+		return nil, fmt.Errorf("unexpected format of import id (%s), expected <id> or <tenant_id>:<id>", d.Id())
+	}
+	tenantId, err := strconv.Atoi(importData[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid tenant id %s: %s", importData[0], err)
+	}
+	if _, err := strconv.Atoi(importData[1]); err != nil {
+		return nil, fmt.Errorf("invalid id %s: %s", importData[1], err)
+	}
+	d.Set("tenant_id", tenantId)
+	d.SetId(importData[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,191 @@
+package morpheus
+
+import (
+	"fmt"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The group, task, workflow, option type, template and catalog item api calls
+// of the sdk do not forward the request headers, these objects are managed
+// with the requests below instead so they can be created in another tenant.
+
+// tenantObjectApi is the api of a kind of object managed in a tenant
+type tenantObjectApi struct {
+	name         string
+	path         string
+	listKey      string
+	getResult    func() interface{}
+	createResult func() interface{}
+	updateResult func() interface{}
+	deleteResult func() interface{}
+}
+
+var (
+	groupApi = tenantObjectApi{
+		name:         "Groups",
+		path:         morpheus.GroupsPath,
+		listKey:      "groups",
+		getResult:    func() interface{} { return &morpheus.GetGroupResult{} },
+		createResult: func() interface{} { return &morpheus.CreateGroupResult{} },
+		updateResult: func() interface{} { return &morpheus.UpdateGroupResult{} },
+		deleteResult: func() interface{} { return &morpheus.DeleteGroupResult{} },
+	}
+	taskApi = tenantObjectApi{
+		name:         "Tasks",
+		path:         morpheus.TasksPath,
+		listKey:      "tasks",
+		getResult:    func() interface{} { return &morpheus.GetTaskResult{} },
+		createResult: func() interface{} { return &morpheus.CreateTaskResult{} },
+		updateResult: func() interface{} { return &morpheus.UpdateTaskResult{} },
+		deleteResult: func() interface{} { return &morpheus.DeleteTaskResult{} },
+	}
+	taskSetApi = tenantObjectApi{
+		name:         "Task Sets",
+		path:         morpheus.TaskSetsPath,
+		listKey:      "taskSets",
+		getResult:    func() interface{} { return &morpheus.GetTaskSetResult{} },
+		createResult: func() interface{} { return &morpheus.CreateTaskSetResult{} },
+		updateResult: func() interface{} { return &morpheus.UpdateTaskSetResult{} },
+		deleteResult: func() interface{} { return &morpheus.DeleteTaskSetResult{} },
+	}
+	optionTypeApi = tenantObjectApi{
+		name:         "Option Types",
+		path:         morpheus.OptionTypesPath,
+		listKey:      "optionTypes",
+		getResult:    func() interface{} { return &morpheus.GetOptionTypeResult{} },
+		createResult: func() interface{} { return &morpheus.CreateOptionTypeResult{} },
+		updateResult: func() interface{} { return &morpheus.UpdateOptionTypeResult{} },
+		deleteResult: func() interface{} { return &morpheus.DeleteOptionTypeResult{} },
+	}
+	fileTemplateApi = tenantObjectApi{
+		name:         "File Templates",
+		path:         morpheus.FileTemplatesPath,
+		listKey:      "containerTemplates",
+		getResult:    func() interface{} { return &morpheus.GetFileTemplateResult{} },
+		createResult: func() interface{} { return &morpheus.CreateFileTemplateResult{} },
+		updateResult: func() interface{} { return &morpheus.UpdateFileTemplateResult{} },
+		deleteResult: func() interface{} { return &morpheus.DeleteFileTemplateResult{} },
+	}
+	scriptTemplateApi = tenantObjectApi{
+		name:         "Script Templates",
+		path:         morpheus.ScriptTemplatesPath,
+		listKey:      "containerScripts",
+		getResult:    func() interface{} { return &morpheus.GetScriptTemplateResult{} },
+		createResult: func() interface{} { return &morpheus.CreateScriptTemplateResult{} },
+		updateResult: func() interface{} { return &morpheus.UpdateScriptTemplateResult{} },
+		deleteResult: func() interface{} { return &morpheus.DeleteScriptTemplateResult{} },
+	}
+	specTemplateApi = tenantObjectApi{
+		name:         "Spec Templates",
+		path:         morpheus.SpecTemplatesPath,
+		listKey:      "specTemplates",
+		getResult:    func() interface{} { return &morpheus.GetSpecTemplateResult{} },
+		createResult: func() interface{} { return &morpheus.CreateSpecTemplateResult{} },
+		updateResult: func() interface{} { return &morpheus.UpdateSpecTemplateResult{} },
+		deleteResult: func() interface{} { return &morpheus.DeleteSpecTemplateResult{} },
+	}
+	catalogItemApi = tenantObjectApi{
+		name:         "Catalog Items",
+		path:         morpheus.CatalogItemsPath,
+		listKey:      "catalogItemTypes",
+		getResult:    func() interface{} { return &morpheus.GetCatalogItemResult{} },
+		createResult: func() interface{} { return &morpheus.CreateCatalogItemResult{} },
+		updateResult: func() interface{} { return &morpheus.UpdateCatalogItemResult{} },
+		deleteResult: func() interface{} { return &morpheus.DeleteCatalogItemResult{} },
+	}
+)
+
+func (api tenantObjectApi) create(meta interface{}, d *schema.ResourceData, req *morpheus.Request) (*morpheus.Response, error) {
+	return meta.(*providerMeta).client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        api.path,
+		QueryParams: req.QueryParams,
+		Headers:     tenantHeaders(resourceTenantId(meta, d)),
+		Body:        req.Body,
+		Result:      api.createResult(),
+	})
+}
+
+func (api tenantObjectApi) get(meta interface{}, d *schema.ResourceData, id int64) (*morpheus.Response, error) {
+	return api.getInTenant(meta, resourceTenantId(meta, d), id)
+}
+
+// getInTenant gets an object of the given tenant, 0 for the tenant of the
+// provider, when no resource data is at hand
+func (api tenantObjectApi) getInTenant(meta interface{}, tenantId int, id int64) (*morpheus.Response, error) {
+	return meta.(*providerMeta).client.Execute(&morpheus.Request{
+		Method:  "GET",
+		Path:    fmt.Sprintf("%s/%d", api.path, id),
+		Headers: tenantHeaders(tenantId),
+		Result:  api.getResult(),
+	})
+}
+
+func (api tenantObjectApi) findByName(meta interface{}, d *schema.ResourceData, name string) (*morpheus.Response, error) {
+	resp, err := meta.(*providerMeta).client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   api.path,
+		QueryParams: map[string]string{
+			"name": name,
+		},
+		Headers: tenantHeaders(resourceTenantId(meta, d)),
+	})
+	if err != nil {
+		return resp, err
+	}
+	var ids []int64
+	if data, ok := resp.JsonData.(map[string]interface{}); ok {
+		items, _ := data[api.listKey].([]interface{})
+		for _, item := range items {
+			if object, ok := item.(map[string]interface{}); ok {
+				ids = append(ids, jsonInt64Value(object["id"]))
+			}
+		}
+	}
+	if len(ids) != 1 {
+		return resp, fmt.Errorf("found %d %s for %v", len(ids), api.name, name)
+	}
+	return api.get(meta, d, ids[0])
+}
+
+func (api tenantObjectApi) update(meta interface{}, d *schema.ResourceData, id int64, req *morpheus.Request) (*morpheus.Response, error) {
+	return meta.(*providerMeta).client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%d", api.path, id),
+		QueryParams: req.QueryParams,
+		Headers:     tenantHeaders(resourceTenantId(meta, d)),
+		Body:        req.Body,
+		Result:      api.updateResult(),
+	})
+}
+
+func (api tenantObjectApi) delete(meta interface{}, d *schema.ResourceData, id int64, req *morpheus.Request) (*morpheus.Response, error) {
+	return meta.(*providerMeta).client.Execute(&morpheus.Request{
+		Method:      "DELETE",
+		Path:        fmt.Sprintf("%s/%d", api.path, id),
+		QueryParams: req.QueryParams,
+		Headers:     tenantHeaders(resourceTenantId(meta, d)),
+		Body:        req.Body,
+		Result:      api.deleteResult(),
+	})
+}
+
+// updateLogo uploads the logo of an object, for the kinds of objects having
+// an update-logo endpoint
+func (api tenantObjectApi) updateLogo(meta interface{}, d *schema.ResourceData, id int64, filePayloads []*morpheus.FilePayload) (*morpheus.Response, error) {
+	headers := tenantHeaders(resourceTenantId(meta, d))
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	headers["Content-Type"] = "application/x-www-form-urlencoded"
+	return meta.(*providerMeta).client.Execute(&morpheus.Request{
+		Method:         "POST",
+		Path:           fmt.Sprintf("%s/%d/update-logo", api.path, id),
+		IsMultiPart:    true,
+		MultiPartFiles: filePayloads,
+		Headers:        headers,
+		Result:         api.updateResult(),
+	})
+}
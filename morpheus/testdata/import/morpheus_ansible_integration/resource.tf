@@ -0,0 +1,16 @@
+resource "morpheus_key_pair" "tfacc_key_pair" {
+  name       = "tfacc-key-pair"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAICYTSLyde8vml8pPQlqlJLby7NrTq6kiJSZ+xelR1mkw tfacc"
+}
+
+resource "morpheus_ansible_integration" "tfacc_ansible_integration" {
+  name                 = "tfacc-ansible"
+  enabled              = true
+  url                  = "https://github.com/gomorpheus/morpheus-ansible.git"
+  default_branch       = "master"
+  playbooks_path       = "/"
+  roles_path           = "/roles"
+  group_variables_path = "/vars"
+  host_variables_path  = "/vars"
+  key_pair_id          = morpheus_key_pair.tfacc_key_pair.id
+}
@@ -0,0 +1,3 @@
+resource "morpheus_appliance_maintenance_mode" "tfacc_maintenance_mode" {
+  enabled = true
+}
@@ -0,0 +1,12 @@
+resource "morpheus_key_pair" "tfacc_key_pair" {
+  name       = "tfacc-key-pair"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAICYTSLyde8vml8pPQlqlJLby7NrTq6kiJSZ+xelR1mkw tfacc"
+}
+
+resource "morpheus_git_integration" "tfacc_git_integration" {
+  name           = "tfacc-git"
+  enabled        = true
+  url            = "https://github.com/gomorpheus/tfdemo.git"
+  default_branch = "main"
+  key_pair_id    = morpheus_key_pair.tfacc_key_pair.id
+}
@@ -0,0 +1,14 @@
+resource "morpheus_script_template" "tfacc_script_template" {
+  name           = "tfacc-script-template"
+  script_type    = "bash"
+  script_phase   = "provision"
+  script_content = "echo testing"
+}
+
+resource "morpheus_library_script_task" "tfacc_library_script_task" {
+  name               = "tfacc-library-script-task"
+  code               = "tfacc-library-script-task"
+  execute_target     = "resource"
+  script_template    = morpheus_script_template.tfacc_script_template.name
+  script_template_id = morpheus_script_template.tfacc_script_template.id
+}
@@ -0,0 +1,15 @@
+resource "morpheus_file_template" "tfacc_file_template" {
+  name         = "tfacc-file-template"
+  file_name    = "tfacc.cnf"
+  file_path    = "/etc/my.cnf.d"
+  phase        = "preProvision"
+  file_content = "[mysqld]"
+}
+
+resource "morpheus_library_template_task" "tfacc_library_template_task" {
+  name             = "tfacc-library-template-task"
+  code             = "tfacc-library-template-task"
+  execute_target   = "resource"
+  file_template    = morpheus_file_template.tfacc_file_template.name
+  file_template_id = morpheus_file_template.tfacc_file_template.id
+}
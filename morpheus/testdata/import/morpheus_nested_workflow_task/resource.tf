@@ -0,0 +1,19 @@
+resource "morpheus_shell_script_task" "tfacc_shell_script_task" {
+  name           = "tfacc-shell-script-task"
+  code           = "tfacc-shell-script-task"
+  source_type    = "local"
+  script_content = "echo testing"
+}
+
+resource "morpheus_operational_workflow" "tfacc_operational_workflow" {
+  name     = "tfacc-operational-workflow"
+  platform = "all"
+  task_ids = [morpheus_shell_script_task.tfacc_shell_script_task.id]
+}
+
+resource "morpheus_nested_workflow_task" "tfacc_nested_workflow_task" {
+  name                      = "tfacc-nested-workflow-task"
+  code                      = "tfacc-nested-workflow-task"
+  operational_workflow_id   = morpheus_operational_workflow.tfacc_operational_workflow.id
+  operational_workflow_name = morpheus_operational_workflow.tfacc_operational_workflow.name
+}
@@ -0,0 +1,25 @@
+resource "morpheus_tenant_role" "tfacc_tenant_role" {
+  name        = "tfacc-tenant-role"
+  description = "Acceptance test tenant role"
+}
+
+resource "morpheus_tenant" "tfacc_tenant" {
+  name         = "tfacc-tenant"
+  enabled      = true
+  subdomain    = "tfacc"
+  base_role_id = morpheus_tenant_role.tfacc_tenant_role.id
+}
+
+resource "morpheus_user_role" "tfacc_user_role" {
+  name = "tfacc-saml-user-role"
+}
+
+resource "morpheus_saml_identity_source" "tfacc_saml_identity_source" {
+  tenant_id                    = morpheus_tenant.tfacc_tenant.id
+  name                         = "tfacc-saml"
+  login_redirect_url           = "https://tfaccsaml.test.local:8443/realms/master/protocol/saml"
+  logout_redirect_url          = "https://tfaccsaml.test.local:8443/realms/master/protocol/saml"
+  saml_request                 = "SelfSigned"
+  validate_assertion_signature = false
+  default_account_role_id      = morpheus_user_role.tfacc_user_role.id
+}
@@ -0,0 +1,33 @@
+resource "morpheus_shell_script_task" "tfacc_shell_script_task" {
+  name           = "tfacc-shell-script-task"
+  code           = "tfacc-shell-script-task"
+  source_type    = "local"
+  script_content = "echo testing"
+}
+
+resource "morpheus_execute_schedule" "tfacc_execute_schedule" {
+  name      = "tfacc-execute-schedule"
+  enabled   = false
+  time_zone = "America/Denver"
+  schedule  = "0 22 * * 1-5"
+}
+
+resource "morpheus_task_job" "tfacc_task_job_manual" {
+  name           = "tfacc-task-job-manual"
+  enabled        = true
+  task_id        = morpheus_shell_script_task.tfacc_shell_script_task.id
+  schedule_mode  = "manual"
+  context_type   = "instance-label"
+  instance_label = "tfacc"
+}
+
+resource "morpheus_task_job" "tfacc_task_job_schedule" {
+  name                  = "tfacc-task-job-schedule"
+  enabled               = true
+  task_id               = morpheus_shell_script_task.tfacc_shell_script_task.id
+  schedule_mode         = "scheduled"
+  execution_schedule_id = morpheus_execute_schedule.tfacc_execute_schedule.id
+  context_type          = "server-label"
+  server_label          = "tfacc"
+  custom_config         = jsonencode({ test = "new" })
+}
@@ -0,0 +1,11 @@
+resource "morpheus_tenant_role" "tfacc_tenant_role" {
+  name        = "tfacc-tenant-role"
+  description = "Acceptance test tenant role"
+}
+
+resource "morpheus_tenant" "tfacc_tenant" {
+  name         = "tfacc-tenant"
+  enabled      = true
+  subdomain    = "tfacc"
+  base_role_id = morpheus_tenant_role.tfacc_tenant_role.id
+}
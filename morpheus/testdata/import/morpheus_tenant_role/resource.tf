@@ -0,0 +1,4 @@
+resource "morpheus_tenant_role" "tfacc_tenant_role" {
+  name        = "tfacc-tenant-role"
+  description = "Acceptance test tenant role"
+}
@@ -0,0 +1,6 @@
+resource "morpheus_user_role" "tfacc_user_role" {
+  name               = "tfacc-user-role"
+  description        = "Acceptance test user role"
+  multitenant_role   = false
+  multitenant_locked = false
+}
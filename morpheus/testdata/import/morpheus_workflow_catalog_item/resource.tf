@@ -0,0 +1,21 @@
+resource "morpheus_shell_script_task" "tfacc_shell_script_task" {
+  name           = "tfacc-shell-script-task"
+  code           = "tfacc-shell-script-task"
+  source_type    = "local"
+  script_content = "echo testing"
+}
+
+resource "morpheus_operational_workflow" "tfacc_operational_workflow" {
+  name     = "tfacc-operational-workflow"
+  platform = "all"
+  task_ids = [morpheus_shell_script_task.tfacc_shell_script_task.id]
+}
+
+resource "morpheus_workflow_catalog_item" "tfacc_workflow_catalog_item" {
+  name         = "tfacc-workflow-catalog-item"
+  description  = "Acceptance test workflow catalog item"
+  enabled      = true
+  workflow_id  = morpheus_operational_workflow.tfacc_operational_workflow.id
+  context_type = "appliance"
+  visibility   = "private"
+}
@@ -0,0 +1,39 @@
+resource "morpheus_shell_script_task" "tfacc_shell_script_task" {
+  name           = "tfacc-shell-script-task"
+  code           = "tfacc-shell-script-task"
+  source_type    = "local"
+  script_content = "echo testing"
+}
+
+resource "morpheus_operational_workflow" "tfacc_operational_workflow" {
+  name     = "tfacc-operational-workflow"
+  platform = "all"
+  task_ids = [morpheus_shell_script_task.tfacc_shell_script_task.id]
+}
+
+resource "morpheus_execute_schedule" "tfacc_execute_schedule" {
+  name      = "tfacc-execute-schedule"
+  enabled   = false
+  time_zone = "America/Denver"
+  schedule  = "0 22 * * 1-5"
+}
+
+resource "morpheus_workflow_job" "tfacc_workflow_job_manual" {
+  name           = "tfacc-workflow-job-manual"
+  enabled        = true
+  workflow_id    = morpheus_operational_workflow.tfacc_operational_workflow.id
+  schedule_mode  = "manual"
+  context_type   = "instance-label"
+  instance_label = "tfacc"
+}
+
+resource "morpheus_workflow_job" "tfacc_workflow_job_schedule" {
+  name                  = "tfacc-workflow-job-schedule"
+  enabled               = true
+  workflow_id           = morpheus_operational_workflow.tfacc_operational_workflow.id
+  schedule_mode         = "scheduled"
+  execution_schedule_id = morpheus_execute_schedule.tfacc_execute_schedule.id
+  context_type          = "server-label"
+  server_label          = "tfacc"
+  custom_config         = jsonencode({ environment = "staging" })
+}
@@ -3,6 +3,10 @@ package morpheus
 import (
 	"encoding/json"
 	"reflect"
+	"sort"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func jsonBytesEqual(b1, b2 []byte) bool {
@@ -42,3 +46,93 @@ func parseEnvironmentVariables(variables []interface{}) []map[string]interface{}
 	}
 	return evars
 }
+
+// resourceAuditSchema returns the read-only attributes describing when and
+// by whom an object was created, these are added to every resource.
+func resourceAuditSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"date_created": {
+			Type:        schema.TypeString,
+			Description: "The date and time the object was created",
+			Computed:    true,
+		},
+		"last_updated": {
+			Type:        schema.TypeString,
+			Description: "The date and time the object was last updated",
+			Computed:    true,
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Description: "The username of the user that created the object",
+			Computed:    true,
+		},
+		"owner_id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the tenant that owns the object",
+			Computed:    true,
+		},
+	}
+}
+
+// setResourceAuditAttributes stores the creation and ownership details of the
+// object found in the API response payload. The payload is inspected
+// generically since not every SDK type exposes these properties.
+func setResourceAuditAttributes(d *schema.ResourceData, resp *morpheus.Response) {
+	if resp == nil {
+		return
+	}
+	payload, ok := resp.JsonData.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	// the object is the top level property that has an id
+	keys := make([]string, 0, len(payload))
+	for key := range payload {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var object map[string]interface{}
+	for _, key := range keys {
+		if value, ok := payload[key].(map[string]interface{}); ok {
+			if _, ok := value["id"]; ok {
+				object = value
+				break
+			}
+		}
+	}
+	if object == nil {
+		return
+	}
+	setObjectAuditAttributes(d, object)
+}
+
+// setObjectAuditAttributes stores the creation and ownership details of an
+// object of an API response payload, such as an item of a list
+func setObjectAuditAttributes(d *schema.ResourceData, object map[string]interface{}) {
+	if value, ok := object["dateCreated"].(string); ok {
+		d.Set("date_created", value)
+	}
+	if value, ok := object["lastUpdated"].(string); ok {
+		d.Set("last_updated", value)
+	}
+	switch createdBy := object["createdBy"].(type) {
+	case string:
+		d.Set("created_by", createdBy)
+	case map[string]interface{}:
+		if username, ok := createdBy["username"].(string); ok {
+			d.Set("created_by", username)
+		}
+	}
+	for _, key := range []string{"owner", "account"} {
+		if owner, ok := object[key].(map[string]interface{}); ok {
+			if id, ok := owner["id"].(float64); ok {
+				d.Set("owner_id", int(id))
+				return
+			}
+		}
+	}
+	if id, ok := object["accountId"].(float64); ok {
+		d.Set("owner_id", int(id))
+	}
+}
@@ -0,0 +1,114 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// versionConstraint is the range of appliance versions supporting an object,
+// the maximum version is the first one that no longer supports it
+type versionConstraint struct {
+	min string
+	max string
+}
+
+func (c versionConstraint) String() string {
+	switch {
+	case c.min != "" && c.max != "":
+		return fmt.Sprintf("Morpheus %s or later and before %s", c.min, c.max)
+	case c.max != "":
+		return fmt.Sprintf("Morpheus before %s", c.max)
+	default:
+		return fmt.Sprintf("Morpheus %s or later", c.min)
+	}
+}
+
+// short is the form of the constraint used in the plan errors
+func (c versionConstraint) short() string {
+	switch {
+	case c.min != "" && c.max != "":
+		return fmt.Sprintf("Morpheus %s+ and before %s", c.min, c.max)
+	case c.max != "":
+		return fmt.Sprintf("Morpheus before %s", c.max)
+	default:
+		return fmt.Sprintf("Morpheus %s+", c.min)
+	}
+}
+
+func (c versionConstraint) allows(version applianceVersion) bool {
+	if c.min != "" && !version.atLeast(c.min) {
+		return false
+	}
+	if c.max != "" && version.atLeast(c.max) {
+		return false
+	}
+	return true
+}
+
+// resourceVersionConstraints lists the appliance versions supporting each resource
+var resourceVersionConstraints = map[string]versionConstraint{
+	"morpheus_form":         {min: "6.0.3"},
+	"morpheus_mvm_instance": {min: "8.0.0"},
+	"morpheus_tenant_role":  {min: "6.0.4"},
+	"morpheus_user_role":    {min: "6.0.4"},
+}
+
+// attributeVersionConstraints lists the appliance versions supporting the
+// attributes shared by several resources
+var attributeVersionConstraints = map[string]versionConstraint{
+	"form_field_override": {min: "6.0.3"},
+	"form_id":             {min: "6.0.3"},
+	"labels":              {min: "5.5.3"},
+}
+
+// withVersionConstraints documents the appliance versions supporting the
+// resource and checks at plan time that the detected version of the appliance
+// supports the resource and the attributes set in its configuration. Nothing
+// is checked when the version of the appliance could not be detected.
+func withVersionConstraints(name string, resource *schema.Resource) {
+	constraint, constrained := resourceVersionConstraints[name]
+	if constrained {
+		description := strings.TrimSuffix(resource.Description, ".")
+		period := strings.TrimPrefix(resource.Description, description)
+		resource.Description = fmt.Sprintf("%s (This resource requires %s)%s", description, constraint, period)
+	}
+
+	var attributes []string
+	for key, attributeConstraint := range attributeVersionConstraints {
+		if attribute, ok := resource.Schema[key]; ok {
+			attributes = append(attributes, key)
+			if !strings.Contains(attribute.Description, "Morpheus") {
+				attribute.Description = fmt.Sprintf("%s (This attribute requires %s)", attribute.Description, attributeConstraint)
+			}
+		}
+	}
+	if !constrained && len(attributes) == 0 {
+		return
+	}
+
+	check := func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		version, known := getApplianceVersion(meta)
+		if !known {
+			return nil
+		}
+		if constrained && !constraint.allows(version) {
+			return fmt.Errorf("%s requires %s, the appliance is running Morpheus %s", name, constraint.short(), version)
+		}
+		for _, key := range attributes {
+			attributeConstraint := attributeVersionConstraints[key]
+			if _, ok := d.GetOk(key); ok && d.HasChange(key) && !attributeConstraint.allows(version) {
+				return fmt.Errorf("the %s attribute of %s requires %s, the appliance is running Morpheus %s", key, name, attributeConstraint.short(), version)
+			}
+		}
+		return nil
+	}
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = customdiff.Sequence(check, resource.CustomizeDiff)
+	} else {
+		resource.CustomizeDiff = check
+	}
+}
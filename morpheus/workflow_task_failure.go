@@ -0,0 +1,74 @@
+package morpheus
+
+import (
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// workflowTaskFailureSchema adds the settings handling the failure of a task
+// to the schema of a task of a workflow
+func workflowTaskFailureSchema(taskSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	taskSchema["continue_on_failure"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether the workflow continues with the next task when the task fails",
+		Optional:    true,
+		Default:     false,
+	}
+	taskSchema["allow_retry"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether the task can be retried from the workflow execution when it fails",
+		Optional:    true,
+		Default:     false,
+	}
+	taskSchema["failure_workflow_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "The id of the operational workflow executed when the task fails",
+		Optional:    true,
+	}
+	return taskSchema
+}
+
+// workflowTaskFailurePayload adds the failure handling settings of a task to
+// the payload of the task
+func workflowTaskFailurePayload(row map[string]interface{}, taskConfig map[string]interface{}) {
+	row["continueOnError"] = taskConfig["continue_on_failure"]
+	row["allowRetry"] = taskConfig["allow_retry"]
+	if failureWorkflowId := taskConfig["failure_workflow_id"].(int); failureWorkflowId != 0 {
+		row["failureTaskSetId"] = failureWorkflowId
+	}
+}
+
+// workflowTaskFailureSettings returns the failure handling settings of the
+// tasks of a workflow keyed by the id of the task in the workflow, the sdk
+// does not decode them
+func workflowTaskFailureSettings(resp *morpheus.Response) map[int64]map[string]interface{} {
+	settings := make(map[int64]map[string]interface{})
+	data, ok := resp.JsonData.(map[string]interface{})
+	if !ok {
+		return settings
+	}
+	taskSet, ok := data["taskSet"].(map[string]interface{})
+	if !ok {
+		return settings
+	}
+	taskSetTasks, _ := taskSet["taskSetTasks"].([]interface{})
+	for _, item := range taskSetTasks {
+		taskSetTask, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := taskSetTask["id"].(float64)
+		continueOnFailure, _ := taskSetTask["continueOnError"].(bool)
+		allowRetry, _ := taskSetTask["allowRetry"].(bool)
+		failureWorkflowId, _ := taskSetTask["failureTaskSetId"].(float64)
+		if failureTaskSet, ok := taskSetTask["failureTaskSet"].(map[string]interface{}); ok {
+			failureWorkflowId, _ = failureTaskSet["id"].(float64)
+		}
+		settings[int64(id)] = map[string]interface{}{
+			"continue_on_failure": continueOnFailure,
+			"allow_retry":         allowRetry,
+			"failure_workflow_id": int(failureWorkflowId),
+		}
+	}
+	return settings
+}
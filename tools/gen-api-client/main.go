@@ -0,0 +1,56 @@
+// Command gen-api-client generates the methods of the apiClient of the
+// provider wrapping the api calls of the morpheus sdk client, so every
+// response goes through the apiClient and is recorded in the api summary.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+)
+
+func main() {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen-api-client; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package morpheus\n\n")
+	fmt.Fprintf(&b, "import \"github.com/gomorpheus/morpheus-go-sdk\"\n")
+
+	responseType := reflect.TypeOf(&morpheus.Response{})
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	clientType := reflect.TypeOf(&morpheus.Client{})
+	for i := 0; i < clientType.NumMethod(); i++ {
+		method := clientType.Method(i)
+		t := method.Type
+		if t.NumOut() != 2 || t.Out(0) != responseType || t.Out(1) != errorType {
+			continue
+		}
+		var params, args []string
+		for j := 1; j < t.NumIn(); j++ {
+			name := fmt.Sprintf("a%d", j-1)
+			typ := t.In(j).String()
+			arg := name
+			if t.IsVariadic() && j == t.NumIn()-1 {
+				typ = "..." + strings.TrimPrefix(typ, "[]")
+				arg += "..."
+			}
+			params = append(params, name+" "+typ)
+			args = append(args, arg)
+		}
+		fmt.Fprintf(&b, "\nfunc (c *apiClient) %s(%s) (*morpheus.Response, error) {\n", method.Name, strings.Join(params, ", "))
+		fmt.Fprintf(&b, "\treturn c.record(c.Client.%s(%s))\n}\n", method.Name, strings.Join(args, ", "))
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("api_client_gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}